@@ -45,6 +45,9 @@ type AppRepositorySpec struct {
 	PassCredentials bool `json:"passCredentials,omitempty"`
 	// Interval is the time between resyncs of the repository
 	Interval string `json:"interval,omitempty"`
+	// Verification configures the verification of the charts before they are
+	// installed or upgraded
+	Verification *AppRepositoryVerification `json:"verification,omitempty"`
 }
 
 // AppRepositoryAuth is the auth for an AppRepository resource
@@ -65,6 +68,26 @@ type AppRepositoryCustomCA struct {
 	SecretKeyRef corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
+// Supported verification policies of an AppRepository.
+const (
+	// VerificationPolicyEnforce refuses the charts failing the verification.
+	VerificationPolicyEnforce = "enforce"
+	// VerificationPolicyWarn only logs the charts failing the verification.
+	VerificationPolicyWarn = "warn"
+)
+
+// AppRepositoryVerification configures the verification of the charts of an
+// AppRepository: the Helm provenance files for a Helm repository or the cosign
+// signatures for an OCI registry.
+type AppRepositoryVerification struct {
+	// Policy is either "enforce" (the default) or "warn"
+	Policy string `json:"policy,omitempty"`
+	// Selects a key of a secret in the namespace of the repository with the
+	// public keys: a GPG keyring for a Helm repository or a PEM public key for
+	// an OCI registry
+	SecretKeyRef corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
 // FilterRuleSpec defines a set of rules and aggreagation logic
 type FilterRuleSpec struct {
 	JQ        string            `json:"jq"`
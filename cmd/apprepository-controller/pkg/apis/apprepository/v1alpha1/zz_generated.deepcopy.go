@@ -143,6 +143,11 @@ func (in *AppRepositorySpec) DeepCopyInto(out *AppRepositorySpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(AppRepositoryVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -171,3 +176,20 @@ func (in *AppRepositoryStatus) DeepCopy() *AppRepositoryStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRepositoryVerification) DeepCopyInto(out *AppRepositoryVerification) {
+	*out = *in
+	in.SecretKeyRef.DeepCopyInto(&out.SecretKeyRef)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRepositoryVerification.
+func (in *AppRepositoryVerification) DeepCopy() *AppRepositoryVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRepositoryVerification)
+	in.DeepCopyInto(out)
+	return out
+}
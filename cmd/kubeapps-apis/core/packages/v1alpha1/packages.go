@@ -287,8 +287,14 @@ func (s packagesServer) CreateInstalledPackage(ctx context.Context, request *con
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the plugin %v", request.Msg.AvailablePackageRef.Plugin))
 	}
 
-	// Get the response from the requested plugin
+	// Validate the values against the values schema of the package, if any,
+	// before requesting the plugin to install it.
 	ctxForPlugin := updateContextWithAuthz(ctx, request.Header())
+	if err := validateCreatedValues(ctxForPlugin, *pluginWithServer, request.Header(), request.Msg); err != nil {
+		return nil, err
+	}
+
+	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.CreateInstalledPackage(ctxForPlugin, request)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to create the installed package for the package %q using the plugin %q: %w", request.Msg.AvailablePackageRef.Identifier, request.Msg.AvailablePackageRef.Plugin.Name, err))
@@ -316,8 +322,14 @@ func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *con
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the plugin %v", request.Msg.InstalledPackageRef.Plugin))
 	}
 
-	// Get the response from the requested plugin
+	// Validate the values against the values schema of the package, if any,
+	// before requesting the plugin to update it.
 	ctxForPlugin := updateContextWithAuthz(ctx, request.Header())
+	if err := validateUpdatedValues(ctxForPlugin, *pluginWithServer, request.Header(), request.Msg); err != nil {
+		return nil, err
+	}
+
+	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.UpdateInstalledPackage(ctxForPlugin, request)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to update the installed package for the package %q using the plugin %q: %w", request.Msg.InstalledPackageRef.Identifier, request.Msg.InstalledPackageRef.Plugin.Name, err))
@@ -331,6 +343,151 @@ func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *con
 	return response, nil
 }
 
+// ValidateInstalledPackageValues validates the values for an available package
+// against its values schema, returning the violations found.
+func (s packagesServer) ValidateInstalledPackageValues(ctx context.Context, request *connect.Request[packages.ValidateInstalledPackageValuesRequest]) (*connect.Response[packages.ValidateInstalledPackageValuesResponse], error) {
+	log.InfoS("+core ValidateInstalledPackageValues", "cluster", request.Msg.GetAvailablePackageRef().GetContext().GetCluster(), "namespace", request.Msg.GetAvailablePackageRef().GetContext().GetNamespace(), "id", request.Msg.GetAvailablePackageRef().GetIdentifier())
+
+	if request.Msg.GetAvailablePackageRef().GetPlugin() == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)"))
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.Msg.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the plugin %v", request.Msg.AvailablePackageRef.Plugin))
+	}
+
+	ctxForPlugin := updateContextWithAuthz(ctx, request.Header())
+	violations, err := valuesSchemaViolations(ctxForPlugin, *pluginWithServer, request.Header(), request.Msg.AvailablePackageRef, request.Msg.GetPkgVersionReference().GetVersion(), request.Msg.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&packages.ValidateInstalledPackageValuesResponse{
+		Valid:      len(violations) == 0,
+		Violations: violations,
+	}), nil
+}
+
+// BulkUpdateInstalledPackages updates each installed package matching the request
+// to the latest version allowed by the requested upgrade policy, reporting the
+// result for each installed package, including failures.
+func (s packagesServer) BulkUpdateInstalledPackages(ctx context.Context, request *connect.Request[packages.BulkUpdateInstalledPackagesRequest]) (*connect.Response[packages.BulkUpdateInstalledPackagesResponse], error) {
+	cluster := request.Msg.GetContext().GetCluster()
+	log.InfoS("+core BulkUpdateInstalledPackages", "cluster", cluster, "namespace", request.Msg.GetContext().GetNamespace(), "policy", request.Msg.GetUpgradePolicy())
+
+	policy, err := upgradePolicyFromRequest(request.Msg.GetUpgradePolicy())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to use the requested upgrade policy: %w", err))
+	}
+
+	pluginsWithServers := s.pluginsWithServers
+	if request.Msg.GetPlugin() != nil {
+		pluginWithServer := s.getPluginWithServer(request.Msg.GetPlugin())
+		if pluginWithServer == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to retrieve the plugin %v", request.Msg.GetPlugin()))
+		}
+		pluginsWithServers = []pkgPluginWithServer{*pluginWithServer}
+	}
+
+	namespaces := request.Msg.GetNamespaces()
+	if len(namespaces) == 0 {
+		namespaces = []string{request.Msg.GetContext().GetNamespace()}
+	}
+
+	results := []*packages.BulkUpdateInstalledPackageResult{}
+	for _, pluginWithServer := range pluginsWithServers {
+		for _, namespace := range namespaces {
+			summaries, err := getAllInstalledPackageSummaries(ctx, pluginWithServer, request.Header(), &packages.Context{
+				Cluster:   cluster,
+				Namespace: namespace,
+			})
+			if err != nil {
+				if connect.CodeOf(err) == connect.CodeUnimplemented {
+					log.Infof("+core BulkUpdateInstalledPackages not supported by the plugin %q: %v", pluginWithServer.plugin.Name, err)
+					continue
+				}
+				return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to get the installed packages using the plugin %q: %w", pluginWithServer.plugin.Name, err))
+			}
+			for _, summary := range summaries {
+				result := bulkUpdateInstalledPackage(ctx, pluginWithServer, request.Header(), summary.GetInstalledPackageRef(), request.Msg.GetAvailablePackageIdentifier(), policy)
+				if result != nil {
+					results = append(results, result)
+				}
+			}
+		}
+	}
+
+	return connect.NewResponse(&packages.BulkUpdateInstalledPackagesResponse{
+		Results: results,
+	}), nil
+}
+
+// ExportInstalledPackages exports the installed packages of every configured
+// plugin in the requested context as a single manifest.
+func (s packagesServer) ExportInstalledPackages(ctx context.Context, request *connect.Request[packages.ExportInstalledPackagesRequest]) (*connect.Response[packages.ExportInstalledPackagesResponse], error) {
+	log.InfoS("+core ExportInstalledPackages", "cluster", request.Msg.GetContext().GetCluster(), "namespace", request.Msg.GetContext().GetNamespace())
+
+	manifest := &packages.InstalledPackagesManifest{
+		ApiVersion:        installedPackagesManifestAPIVersion,
+		InstalledPackages: []*packages.InstalledPackagesManifestEntry{},
+	}
+	ctxForPlugin := updateContextWithAuthz(ctx, request.Header())
+	for _, pluginWithServer := range s.pluginsWithServers {
+		summaries, err := getAllInstalledPackageSummaries(ctx, pluginWithServer, request.Header(), request.Msg.GetContext())
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeUnimplemented {
+				log.Infof("+core ExportInstalledPackages not supported by the plugin %q: %v", pluginWithServer.plugin.Name, err)
+				continue
+			}
+			return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to get the installed packages using the plugin %q: %w", pluginWithServer.plugin.Name, err))
+		}
+		for _, summary := range summaries {
+			detailRequest := connect.NewRequest(&packages.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: summary.GetInstalledPackageRef(),
+			})
+			detailRequest.Header().Set("Authorization", request.Header().Get("Authorization"))
+			detailResponse, err := pluginWithServer.server.GetInstalledPackageDetail(ctxForPlugin, detailRequest)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to get the installed package detail for the package %q using the plugin %q: %w", summary.GetInstalledPackageRef().GetIdentifier(), pluginWithServer.plugin.Name, err))
+			}
+			manifest.InstalledPackages = append(manifest.InstalledPackages, manifestEntryFromInstalledPackageDetail(detailResponse.Msg.GetInstalledPackageDetail()))
+		}
+	}
+
+	manifestStr, err := marshalInstalledPackagesManifest(manifest, request.Msg.GetFormat())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to export the installed packages: %w", err))
+	}
+	return connect.NewResponse(&packages.ExportInstalledPackagesResponse{
+		Manifest: manifestStr,
+	}), nil
+}
+
+// ImportInstalledPackages creates each installed package of a manifest in the
+// target context using the configured plugins.
+func (s packagesServer) ImportInstalledPackages(ctx context.Context, request *connect.Request[packages.ImportInstalledPackagesRequest]) (*connect.Response[packages.ImportInstalledPackagesResponse], error) {
+	log.InfoS("+core ImportInstalledPackages", "cluster", request.Msg.GetTargetContext().GetCluster(), "namespace", request.Msg.GetTargetContext().GetNamespace(), "policy", request.Msg.GetConflictPolicy())
+
+	if _, ok := packages.ImportInstalledPackagesRequest_ConflictPolicy_name[int32(request.Msg.GetConflictPolicy())]; !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to use the requested conflict policy: [%s]", request.Msg.GetConflictPolicy()))
+	}
+	manifest, err := unmarshalInstalledPackagesManifest(request.Msg.GetManifest())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to import the installed packages: %w", err))
+	}
+
+	results := []*packages.ImportInstalledPackageResult{}
+	for _, entry := range manifest.GetInstalledPackages() {
+		results = append(results, s.importInstalledPackage(ctx, request.Header(), entry, request.Msg.GetTargetContext(), request.Msg.GetConflictPolicy()))
+	}
+
+	return connect.NewResponse(&packages.ImportInstalledPackagesResponse{
+		Results: results,
+	}), nil
+}
+
 // DeleteInstalledPackage deletes an installed package using configured plugins.
 func (s packagesServer) DeleteInstalledPackage(ctx context.Context, request *connect.Request[packages.DeleteInstalledPackageRequest]) (*connect.Response[packages.DeleteInstalledPackageResponse], error) {
 	log.InfoS("+core DeleteInstalledPackage", "cluster", request.Msg.GetInstalledPackageRef().GetContext().GetCluster(), "namespace", request.Msg.GetInstalledPackageRef().GetContext().GetNamespace())
@@ -381,6 +538,20 @@ func (s packagesServer) GetAvailablePackageMetadatas(ctx context.Context, reques
 	}), nil
 }
 
+// WatchInstalledPackages streams the changes of the installed packages of the
+// configured plugins, sending an event each time an installed package is added,
+// deleted or its status changes.
+func (s packagesServer) WatchInstalledPackages(ctx context.Context, request *connect.Request[packages.WatchInstalledPackagesRequest], stream *connect.ServerStream[packages.WatchInstalledPackagesResponse]) error {
+	log.InfoS("+core WatchInstalledPackages", "cluster", request.Msg.GetContext().GetCluster(), "namespace", request.Msg.GetContext().GetNamespace())
+
+	// Cancelling the context stops the plugin watchers once the stream ends.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := fanInInstalledPackageEvents(ctx, s.pluginsWithServers, request)
+	return sendInstalledPackageDeltas(events, stream.Send)
+}
+
 // getPluginWithServer returns the *pkgPluginsWithServer from a given packagesServer
 // matching the plugin name
 func (s packagesServer) getPluginWithServer(plugin *v1alpha1.Plugin) *pkgPluginWithServer {
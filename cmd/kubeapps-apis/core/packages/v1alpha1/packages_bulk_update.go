@@ -0,0 +1,145 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/bufbuild/connect-go"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	log "k8s.io/klog/v2"
+)
+
+// upgradePolicyFromRequest returns the upgrade policy matching the one requested.
+func upgradePolicyFromRequest(policy packages.BulkUpdateInstalledPackagesRequest_UpgradePolicy) (pkgutils.UpgradePolicy, error) {
+	switch policy {
+	case packages.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_PATCH:
+		return pkgutils.UpgradePolicyPatch, nil
+	case packages.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MINOR:
+		return pkgutils.UpgradePolicyMinor, nil
+	case packages.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MAJOR:
+		return pkgutils.UpgradePolicyMajor, nil
+	default:
+		return pkgutils.UpgradePolicyNone, fmt.Errorf("unsupported upgrade policy: [%s]", policy)
+	}
+}
+
+// getAllInstalledPackageSummaries returns every installed package summary of
+// the plugin for the given context.
+func getAllInstalledPackageSummaries(ctx context.Context, pluginWithServer pkgPluginWithServer, headers http.Header, pkgContext *packages.Context) ([]*packages.InstalledPackageSummary, error) {
+	request := connect.NewRequest(&packages.GetInstalledPackageSummariesRequest{
+		Context: pkgContext,
+	})
+	request.Header().Set("Authorization", headers.Get("Authorization"))
+
+	response, err := pluginWithServer.server.GetInstalledPackageSummaries(updateContextWithAuthz(ctx, headers), request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Msg.GetInstalledPackageSummaries(), nil
+}
+
+// bulkUpdateInstalledPackage updates an installed package to the latest
+// available version allowed by the upgrade policy, reusing its current values.
+// A nil result is returned when the installed package is not for the requested
+// available package.
+func bulkUpdateInstalledPackage(ctx context.Context, pluginWithServer pkgPluginWithServer, headers http.Header, installedPackageRef *packages.InstalledPackageReference, availablePackageIdentifier string, policy pkgutils.UpgradePolicy) *packages.BulkUpdateInstalledPackageResult {
+	ctxForPlugin := updateContextWithAuthz(ctx, headers)
+	result := &packages.BulkUpdateInstalledPackageResult{
+		InstalledPackageRef: installedPackageRef,
+	}
+	failed := func(err error) *packages.BulkUpdateInstalledPackageResult {
+		log.Errorf("+core BulkUpdateInstalledPackages unable to update the installed package %q: %v", installedPackageRef.GetIdentifier(), err)
+		result.Result = packages.BulkUpdateInstalledPackageResult_RESULT_FAILED
+		result.ErrorMessage = err.Error()
+		return result
+	}
+
+	detailRequest := connect.NewRequest(&packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: installedPackageRef,
+	})
+	detailRequest.Header().Set("Authorization", headers.Get("Authorization"))
+	detailResponse, err := pluginWithServer.server.GetInstalledPackageDetail(ctxForPlugin, detailRequest)
+	if err != nil {
+		return failed(fmt.Errorf("Unable to get the installed package detail: %w", err))
+	}
+	detail := detailResponse.Msg.GetInstalledPackageDetail()
+	if availablePackageIdentifier != "" && detail.GetAvailablePackageRef().GetIdentifier() != availablePackageIdentifier {
+		return nil
+	}
+	result.PreviousVersion = detail.GetCurrentVersion()
+
+	versionsRequest := connect.NewRequest(&packages.GetAvailablePackageVersionsRequest{
+		AvailablePackageRef: detail.GetAvailablePackageRef(),
+	})
+	versionsRequest.Header().Set("Authorization", headers.Get("Authorization"))
+	versionsResponse, err := pluginWithServer.server.GetAvailablePackageVersions(ctxForPlugin, versionsRequest)
+	if err != nil {
+		return failed(fmt.Errorf("Unable to get the available package versions: %w", err))
+	}
+
+	targetVersion, err := latestVersionWithUpgradePolicy(detail.GetCurrentVersion().GetPkgVersion(), versionsResponse.Msg.GetPackageAppVersions(), policy)
+	if err != nil {
+		return failed(err)
+	}
+	if targetVersion == nil {
+		result.Result = packages.BulkUpdateInstalledPackageResult_RESULT_UP_TO_DATE
+		return result
+	}
+	result.TargetVersion = targetVersion
+
+	updateRequest := connect.NewRequest(&packages.UpdateInstalledPackageRequest{
+		InstalledPackageRef: installedPackageRef,
+		PkgVersionReference: &packages.VersionReference{
+			Version: targetVersion.GetPkgVersion(),
+		},
+		Values:                detail.GetValuesApplied(),
+		ReconciliationOptions: detail.GetReconciliationOptions(),
+	})
+	updateRequest.Header().Set("Authorization", headers.Get("Authorization"))
+	if _, err := pluginWithServer.server.UpdateInstalledPackage(ctxForPlugin, updateRequest); err != nil {
+		return failed(fmt.Errorf("Unable to update the installed package: %w", err))
+	}
+	result.Result = packages.BulkUpdateInstalledPackageResult_RESULT_UPDATED
+	return result
+}
+
+// latestVersionWithUpgradePolicy returns the latest of the given versions which
+// is newer than the current version and allowed by the upgrade policy, or nil
+// if there is none.
+func latestVersionWithUpgradePolicy(currentVersion string, versions []*packages.PackageAppVersion, policy pkgutils.UpgradePolicy) (*packages.PackageAppVersion, error) {
+	current, err := semver.NewVersion(currentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse the current version %q: %w", currentVersion, err)
+	}
+	constraintStr, err := pkgutils.VersionConstraintWithUpgradePolicy(currentVersion, policy)
+	if err != nil {
+		return nil, err
+	}
+	constraint, err := semver.NewConstraint(constraintStr)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse the version constraint %q: %w", constraintStr, err)
+	}
+
+	var latest *packages.PackageAppVersion
+	var latestSemver *semver.Version
+	for _, version := range versions {
+		v, err := semver.NewVersion(version.GetPkgVersion())
+		if err != nil {
+			log.Warningf("+core ignoring the invalid package version %q: %v", version.GetPkgVersion(), err)
+			continue
+		}
+		if !v.GreaterThan(current) || !constraint.Check(v) {
+			continue
+		}
+		if latestSemver == nil || v.GreaterThan(latestSemver) {
+			latest, latestSemver = version, v
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// installedPackagesManifestAPIVersion is the version of the format of the
+	// manifests exported and imported by the core packages service.
+	installedPackagesManifestAPIVersion = "kubeapps.dev/v1alpha1"
+
+	// maxImportRenameAttempts is the maximum number of suffixes tried when
+	// renaming an installed package which already exists in the target context.
+	maxImportRenameAttempts = 100
+)
+
+// manifestEntryFromInstalledPackageDetail returns the manifest entry with the
+// details required to install the same package elsewhere.
+func manifestEntryFromInstalledPackageDetail(detail *packages.InstalledPackageDetail) *packages.InstalledPackagesManifestEntry {
+	pkgVersionReference := detail.GetPkgVersionReference()
+	if pkgVersionReference.GetVersion() == "" {
+		pkgVersionReference = &packages.VersionReference{
+			Version: detail.GetCurrentVersion().GetPkgVersion(),
+		}
+	}
+	return &packages.InstalledPackagesManifestEntry{
+		Name:                  detail.GetName(),
+		Namespace:             detail.GetInstalledPackageRef().GetContext().GetNamespace(),
+		AvailablePackageRef:   detail.GetAvailablePackageRef(),
+		PkgVersionReference:   pkgVersionReference,
+		Values:                detail.GetValuesApplied(),
+		ReconciliationOptions: detail.GetReconciliationOptions(),
+	}
+}
+
+// marshalInstalledPackagesManifest serializes the manifest in the requested
+// format, yaml by default.
+func marshalInstalledPackagesManifest(manifest *packages.InstalledPackagesManifest, format packages.ExportInstalledPackagesRequest_ManifestFormat) (string, error) {
+	manifestJSON, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(manifest)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal the manifest: %w", err)
+	}
+	switch format {
+	case packages.ExportInstalledPackagesRequest_MANIFEST_FORMAT_JSON:
+		return string(manifestJSON), nil
+	case packages.ExportInstalledPackagesRequest_MANIFEST_FORMAT_UNSPECIFIED, packages.ExportInstalledPackagesRequest_MANIFEST_FORMAT_YAML:
+		manifestYAML, err := yaml.JSONToYAML(manifestJSON)
+		if err != nil {
+			return "", fmt.Errorf("unable to convert the manifest to yaml: %w", err)
+		}
+		return string(manifestYAML), nil
+	default:
+		return "", fmt.Errorf("unsupported manifest format: [%s]", format)
+	}
+}
+
+// unmarshalInstalledPackagesManifest parses a manifest in either yaml or json,
+// checking that its format version is supported.
+func unmarshalInstalledPackagesManifest(manifestStr string) (*packages.InstalledPackagesManifest, error) {
+	manifestJSON, err := yaml.YAMLToJSON([]byte(manifestStr))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the manifest: %w", err)
+	}
+	manifest := &packages.InstalledPackagesManifest{}
+	if err := protojson.Unmarshal(manifestJSON, manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse the manifest: %w", err)
+	}
+	if manifest.GetApiVersion() != installedPackagesManifestAPIVersion {
+		return nil, fmt.Errorf("unsupported manifest version %q, expected %q", manifest.GetApiVersion(), installedPackagesManifestAPIVersion)
+	}
+	return manifest, nil
+}
+
+// installedPackageExists returns whether the installed package exists,
+// according to the plugin.
+func installedPackageExists(ctx context.Context, pluginWithServer pkgPluginWithServer, headers http.Header, installedPackageRef *packages.InstalledPackageReference) (bool, error) {
+	request := connect.NewRequest(&packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: installedPackageRef,
+	})
+	request.Header().Set("Authorization", headers.Get("Authorization"))
+	if _, err := pluginWithServer.server.GetInstalledPackageDetail(updateContextWithAuthz(ctx, headers), request); err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// importInstalledPackage installs a manifest entry in the target context,
+// handling an existing installed package with the same name according to the
+// conflict policy. The entry is installed in its own namespace unless the
+// target context has one.
+func (s packagesServer) importInstalledPackage(ctx context.Context, headers http.Header, entry *packages.InstalledPackagesManifestEntry, requestContext *packages.Context, policy packages.ImportInstalledPackagesRequest_ConflictPolicy) *packages.ImportInstalledPackageResult {
+	result := &packages.ImportInstalledPackageResult{
+		Name: entry.GetName(),
+	}
+	failed := func(err error) *packages.ImportInstalledPackageResult {
+		log.Errorf("+core ImportInstalledPackages unable to import the installed package %q: %v", entry.GetName(), err)
+		result.Result = packages.ImportInstalledPackageResult_RESULT_FAILED
+		result.ErrorMessage = err.Error()
+		return result
+	}
+
+	if entry.GetName() == "" || entry.GetAvailablePackageRef().GetPlugin() == nil {
+		return failed(fmt.Errorf("Invalid manifest entry (missing name or AvailablePackageRef.Plugin)"))
+	}
+	targetContext := &packages.Context{
+		Cluster:   requestContext.GetCluster(),
+		Namespace: requestContext.GetNamespace(),
+	}
+	if targetContext.Namespace == "" {
+		targetContext.Namespace = entry.GetNamespace()
+	}
+	if targetContext.Namespace == "" {
+		return failed(fmt.Errorf("Invalid manifest entry (missing namespace and no target namespace requested)"))
+	}
+	pluginWithServer := s.getPluginWithServer(entry.GetAvailablePackageRef().GetPlugin())
+	if pluginWithServer == nil {
+		return failed(fmt.Errorf("Unable to get the plugin %q", entry.GetAvailablePackageRef().GetPlugin().GetName()))
+	}
+
+	installedPackageRef := &packages.InstalledPackageReference{
+		Context:    targetContext,
+		Identifier: entry.GetName(),
+		Plugin:     pluginWithServer.plugin,
+	}
+	exists, err := installedPackageExists(ctx, *pluginWithServer, headers, installedPackageRef)
+	if err != nil {
+		return failed(fmt.Errorf("Unable to check for an existing installed package: %w", err))
+	}
+
+	name := entry.GetName()
+	if exists {
+		switch policy {
+		case packages.ImportInstalledPackagesRequest_CONFLICT_POLICY_UNSPECIFIED, packages.ImportInstalledPackagesRequest_CONFLICT_POLICY_SKIP:
+			result.InstalledPackageRef = installedPackageRef
+			result.Result = packages.ImportInstalledPackageResult_RESULT_SKIPPED
+			return result
+		case packages.ImportInstalledPackagesRequest_CONFLICT_POLICY_OVERWRITE:
+			updateRequest := connect.NewRequest(&packages.UpdateInstalledPackageRequest{
+				InstalledPackageRef:   installedPackageRef,
+				PkgVersionReference:   entry.GetPkgVersionReference(),
+				Values:                entry.GetValues(),
+				ReconciliationOptions: entry.GetReconciliationOptions(),
+			})
+			updateRequest.Header().Set("Authorization", headers.Get("Authorization"))
+			updateResponse, err := s.UpdateInstalledPackage(ctx, updateRequest)
+			if err != nil {
+				return failed(err)
+			}
+			result.InstalledPackageRef = updateResponse.Msg.GetInstalledPackageRef()
+			result.Result = packages.ImportInstalledPackageResult_RESULT_UPDATED
+			return result
+		case packages.ImportInstalledPackagesRequest_CONFLICT_POLICY_RENAME:
+			name = ""
+			for i := 1; i <= maxImportRenameAttempts && name == ""; i++ {
+				candidate := fmt.Sprintf("%s-%d", entry.GetName(), i)
+				exists, err := installedPackageExists(ctx, *pluginWithServer, headers, &packages.InstalledPackageReference{
+					Context:    targetContext,
+					Identifier: candidate,
+					Plugin:     pluginWithServer.plugin,
+				})
+				if err != nil {
+					return failed(fmt.Errorf("Unable to check for an existing installed package: %w", err))
+				}
+				if !exists {
+					name = candidate
+				}
+			}
+			if name == "" {
+				return failed(fmt.Errorf("Unable to find an available name for the installed package after %d attempts", maxImportRenameAttempts))
+			}
+		default:
+			return failed(fmt.Errorf("unsupported conflict policy: [%s]", policy))
+		}
+	}
+
+	createRequest := connect.NewRequest(&packages.CreateInstalledPackageRequest{
+		AvailablePackageRef:   entry.GetAvailablePackageRef(),
+		TargetContext:         targetContext,
+		Name:                  name,
+		PkgVersionReference:   entry.GetPkgVersionReference(),
+		Values:                entry.GetValues(),
+		ReconciliationOptions: entry.GetReconciliationOptions(),
+	})
+	createRequest.Header().Set("Authorization", headers.Get("Authorization"))
+	createResponse, err := s.CreateInstalledPackage(ctx, createRequest)
+	if err != nil {
+		return failed(err)
+	}
+	result.InstalledPackageRef = createResponse.Msg.GetInstalledPackageRef()
+	result.Result = packages.ImportInstalledPackageResult_RESULT_CREATED
+	return result
+}
@@ -6,10 +6,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/bufbuild/connect-go"
 	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"google.golang.org/protobuf/proto"
+	log "k8s.io/klog/v2"
 )
 
 const CompleteToken = -1
@@ -417,3 +422,112 @@ func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 
 	return summaryCh, nil
 }
+
+// installedPackagesWatcher is implemented by the packaging plugins able to
+// watch their installed packages. A connect.ServerStream can only be created by
+// connect itself, so the core server fans in the events of each plugin via this
+// interface rather than via the plugin's WatchInstalledPackages handler.
+type installedPackagesWatcher interface {
+	WatchInstalledPackageEvents(ctx context.Context, headers http.Header, request *packages.WatchInstalledPackagesRequest, send func(*packages.WatchInstalledPackagesResponse) error) error
+}
+
+// installedPackageEvent is the channel type for a single event, or error, from
+// a single plugin.
+type installedPackageEvent struct {
+	response *packages.WatchInstalledPackagesResponse
+	err      error
+}
+
+// fanInInstalledPackageEvents fans in the installed package events sent by
+// each plugin to the return channel, which is closed once every plugin has
+// stopped watching.
+//
+// Plugins which do not support watching, or which return an unimplemented
+// error for the request, are skipped so that the remaining plugins can still
+// be watched.
+func fanInInstalledPackageEvents(ctx context.Context, pkgPlugins []pkgPluginWithServer, request *connect.Request[packages.WatchInstalledPackagesRequest]) <-chan installedPackageEvent {
+	eventsCh := make(chan installedPackageEvent)
+	// the headers are lazily initialized by the request, so they are read once
+	// rather than concurrently by each plugin goroutine
+	headers := request.Header()
+
+	var wg sync.WaitGroup
+	for _, pluginWithSrv := range pkgPlugins {
+		watcher, ok := pluginWithSrv.server.(installedPackagesWatcher)
+		if !ok {
+			log.Infof("Plugin %v does not support watching installed packages", pluginWithSrv.plugin)
+			continue
+		}
+		wg.Add(1)
+		go func(plugin *v1alpha1.Plugin, watcher installedPackagesWatcher) {
+			defer wg.Done()
+			send := func(response *packages.WatchInstalledPackagesResponse) error {
+				select {
+				case eventsCh <- installedPackageEvent{response: response}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			err := watcher.WatchInstalledPackageEvents(ctx, headers, request.Msg, send)
+			if err == nil || ctx.Err() != nil {
+				return
+			}
+			if connect.CodeOf(err) == connect.CodeUnimplemented {
+				log.Infof("Not watching the installed packages of plugin %v: %v", plugin, err)
+				return
+			}
+			select {
+			case eventsCh <- installedPackageEvent{err: connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to watch the installed packages using the plugin %q: %w", plugin.GetName(), err))}:
+			case <-ctx.Done():
+			}
+		}(pluginWithSrv.plugin, watcher)
+	}
+
+	go func() {
+		wg.Wait()
+		close(eventsCh)
+	}()
+
+	return eventsCh
+}
+
+// sendInstalledPackageDeltas sends the events which add or delete an installed
+// package, or change its status or version, together with the previous status
+// known on the stream. Events without any such change are dropped.
+func sendInstalledPackageDeltas(events <-chan installedPackageEvent, send func(*packages.WatchInstalledPackagesResponse) error) error {
+	lastSummaries := map[string]*packages.InstalledPackageSummary{}
+	for event := range events {
+		if event.err != nil {
+			return event.err
+		}
+		summary := event.response.GetInstalledPackageSummary()
+		ref := summary.GetInstalledPackageRef()
+		key := fmt.Sprintf("%s/%s/%s/%s", ref.GetPlugin().GetName(), ref.GetContext().GetCluster(), ref.GetContext().GetNamespace(), ref.GetIdentifier())
+
+		lastSummary, known := lastSummaries[key]
+		response := &packages.WatchInstalledPackagesResponse{
+			EventType:               event.response.GetEventType(),
+			InstalledPackageSummary: summary,
+			PreviousStatus:          lastSummary.GetStatus(),
+		}
+		if event.response.GetEventType() == packages.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED {
+			delete(lastSummaries, key)
+		} else {
+			if known {
+				if proto.Equal(lastSummary.GetStatus(), summary.GetStatus()) && proto.Equal(lastSummary.GetCurrentVersion(), summary.GetCurrentVersion()) {
+					continue
+				}
+				response.EventType = packages.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED
+			} else {
+				response.EventType = packages.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED
+			}
+			lastSummaries[key] = summary
+		}
+
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
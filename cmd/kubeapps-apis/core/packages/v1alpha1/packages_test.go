@@ -5,6 +5,8 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/bufbuild/connect-go"
@@ -13,6 +15,8 @@ import (
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 const (
@@ -996,3 +1000,940 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchInstalledPackages(t *testing.T) {
+	watchingPlugin := makeDefaultTestPackagingPlugin("mock1")
+
+	installed := plugin_test.MakeInstalledPackageSummary("pkg-1", watchingPlugin.plugin)
+	pending := plugin_test.MakeInstalledPackageSummary("pkg-1", watchingPlugin.plugin)
+	pending.Status = &corev1.InstalledPackageStatus{
+		Ready:      false,
+		Reason:     corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+		UserReason: "Reconciling",
+	}
+
+	watchingPlugin.server.(*plugin_test.TestPackagingPluginServer).InstalledPackageEvents = []*corev1.WatchInstalledPackagesResponse{
+		{
+			EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: pending,
+		},
+		{
+			// Without a status or version change, the event is dropped.
+			EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+			InstalledPackageSummary: pending,
+		},
+		{
+			// An event adding an already known package is a modification.
+			EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: installed,
+		},
+		{
+			EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: installed,
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		statusCode        connect.Code
+		expectedResponses []*corev1.WatchInstalledPackagesResponse
+	}{
+		{
+			name: "it should send the installed package status transitions",
+			configuredPlugins: []pkgPluginWithServer{
+				watchingPlugin,
+			},
+			expectedResponses: []*corev1.WatchInstalledPackagesResponse{
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: pending,
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: installed,
+					PreviousStatus:          pending.Status,
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED,
+					InstalledPackageSummary: installed,
+					PreviousStatus:          installed.Status,
+				},
+			},
+		},
+		{
+			name: "it should skip the plugins which do not implement the watch",
+			configuredPlugins: []pkgPluginWithServer{
+				watchingPlugin,
+				makeOnlyStatusTestPackagingPlugin("unimplemented-plugin", connect.CodeUnimplemented),
+			},
+			expectedResponses: []*corev1.WatchInstalledPackagesResponse{
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: pending,
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: installed,
+					PreviousStatus:          pending.Status,
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED,
+					InstalledPackageSummary: installed,
+					PreviousStatus:          installed.Status,
+				},
+			},
+		},
+		{
+			name: "it should fail when a plugin fails to watch",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedNotFoundPackagingPlugin,
+			},
+			statusCode: connect.CodeNotFound,
+		},
+	}
+
+	ignoreUnexported := cmpopts.IgnoreUnexported(corev1.WatchInstalledPackagesResponse{})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			request := connect.NewRequest(&corev1.WatchInstalledPackagesRequest{
+				Context: &corev1.Context{
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			events := fanInInstalledPackageEvents(ctx, tc.configuredPlugins, request)
+
+			responses := []*corev1.WatchInstalledPackagesResponse{}
+			err := sendInstalledPackageDeltas(events, func(response *corev1.WatchInstalledPackagesResponse) error {
+				responses = append(responses, response)
+				return nil
+			})
+
+			if got, want := connect.CodeOf(err), tc.statusCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == 0 {
+				if got, want := responses, tc.expectedResponses; !cmp.Equal(got, want, ignoreUnexported, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+// updateFailingPackagingPluginServer is a test packaging plugin whose updates fail.
+type updateFailingPackagingPluginServer struct {
+	*plugin_test.TestPackagingPluginServer
+}
+
+func (s updateFailingPackagingPluginServer) UpdateInstalledPackage(ctx context.Context, request *connect.Request[corev1.UpdateInstalledPackageRequest]) (*connect.Response[corev1.UpdateInstalledPackageResponse], error) {
+	return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("Non-OK response"))
+}
+
+func TestBulkUpdateInstalledPackages(t *testing.T) {
+	updateFailingPlugin := makeDefaultTestPackagingPlugin("mock-failing")
+	updateFailingPlugin.server = updateFailingPackagingPluginServer{updateFailingPlugin.server.(*plugin_test.TestPackagingPluginServer)}
+
+	result := func(name string, plugin *plugins.Plugin, result corev1.BulkUpdateInstalledPackageResult_Result) *corev1.BulkUpdateInstalledPackageResult {
+		r := &corev1.BulkUpdateInstalledPackageResult{
+			InstalledPackageRef: plugin_test.MakeInstalledPackageSummary(name, plugin).InstalledPackageRef,
+			PreviousVersion:     plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultReleaseVersion),
+			Result:              result,
+		}
+		if result != corev1.BulkUpdateInstalledPackageResult_RESULT_UP_TO_DATE {
+			r.TargetVersion = plugin_test.MakePackageAppVersion(plugin_test.DefaultAppVersion, plugin_test.DefaultPkgUpdateVersion)
+		}
+		if result == corev1.BulkUpdateInstalledPackageResult_RESULT_FAILED {
+			r.ErrorMessage = "Unable to update the installed package: failed_precondition: Non-OK response"
+		}
+		return r
+	}
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		statusCode        connect.Code
+		request           *corev1.BulkUpdateInstalledPackagesRequest
+		expectedResponse  *corev1.BulkUpdateInstalledPackagesResponse
+	}{
+		{
+			name: "it should update the installed packages of all the plugins",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context:       &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				UpgradePolicy: corev1.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MAJOR,
+			},
+			expectedResponse: &corev1.BulkUpdateInstalledPackagesResponse{
+				Results: []*corev1.BulkUpdateInstalledPackageResult{
+					result("pkg-1", mockedPackagingPlugin1.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UPDATED),
+					result("pkg-2", mockedPackagingPlugin1.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UPDATED),
+					result("pkg-1", mockedPackagingPlugin2.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UPDATED),
+					result("pkg-2", mockedPackagingPlugin2.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UPDATED),
+				},
+			},
+		},
+		{
+			name: "it should only update the installed packages of the requested plugin",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context:       &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				Plugin:        mockedPackagingPlugin2.plugin,
+				UpgradePolicy: corev1.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MAJOR,
+			},
+			expectedResponse: &corev1.BulkUpdateInstalledPackagesResponse{
+				Results: []*corev1.BulkUpdateInstalledPackageResult{
+					result("pkg-1", mockedPackagingPlugin2.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UPDATED),
+					result("pkg-2", mockedPackagingPlugin2.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UPDATED),
+				},
+			},
+		},
+		{
+			name: "it should not update the installed packages without a newer version allowed by the policy",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context:       &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				UpgradePolicy: corev1.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MINOR,
+			},
+			expectedResponse: &corev1.BulkUpdateInstalledPackagesResponse{
+				Results: []*corev1.BulkUpdateInstalledPackageResult{
+					result("pkg-1", mockedPackagingPlugin1.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UP_TO_DATE),
+					result("pkg-2", mockedPackagingPlugin1.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_UP_TO_DATE),
+				},
+			},
+		},
+		{
+			name: "it should skip the installed packages of other available packages",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context:                    &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				AvailablePackageIdentifier: "repo-1/other-package",
+				UpgradePolicy:              corev1.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MAJOR,
+			},
+			expectedResponse: &corev1.BulkUpdateInstalledPackagesResponse{
+				Results: []*corev1.BulkUpdateInstalledPackageResult{},
+			},
+		},
+		{
+			name: "it should report the installed packages which fail to update",
+			configuredPlugins: []pkgPluginWithServer{
+				updateFailingPlugin,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context:                    &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				AvailablePackageIdentifier: plugin_test.DefaultId,
+				UpgradePolicy:              corev1.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MAJOR,
+			},
+			expectedResponse: &corev1.BulkUpdateInstalledPackagesResponse{
+				Results: []*corev1.BulkUpdateInstalledPackageResult{
+					result("pkg-1", updateFailingPlugin.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_FAILED),
+					result("pkg-2", updateFailingPlugin.plugin, corev1.BulkUpdateInstalledPackageResult_RESULT_FAILED),
+				},
+			},
+		},
+		{
+			name: "it should return an invalid argument error without an upgrade policy",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context: &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "it should return an invalid argument error for an unknown plugin",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context:       &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				Plugin:        &plugins.Plugin{Name: "unknown-plugin", Version: "v1alpha1"},
+				UpgradePolicy: corev1.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MAJOR,
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "it should return the error of a plugin unable to get its installed packages",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.BulkUpdateInstalledPackagesRequest{
+				Context:       &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				UpgradePolicy: corev1.BulkUpdateInstalledPackagesRequest_UPGRADE_POLICY_MAJOR,
+			},
+			statusCode: connect.CodeNotFound,
+		},
+	}
+
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		corev1.BulkUpdateInstalledPackagesResponse{},
+		corev1.BulkUpdateInstalledPackageResult{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		corev1.PackageAppVersion{},
+		plugins.Plugin{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}
+
+			response, err := server.BulkUpdateInstalledPackages(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.statusCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != 0 {
+				return
+			}
+
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
+
+func TestLatestVersionWithUpgradePolicy(t *testing.T) {
+	versions := []*corev1.PackageAppVersion{
+		{PkgVersion: "2.0.0"},
+		{PkgVersion: "1.3.0"},
+		{PkgVersion: "1.2.5"},
+		{PkgVersion: "1.2.4"},
+		{PkgVersion: "not-a-version"},
+		{PkgVersion: "1.2.3"},
+	}
+
+	testCases := []struct {
+		name            string
+		currentVersion  string
+		policy          pkgutils.UpgradePolicy
+		expectedVersion *corev1.PackageAppVersion
+		expectedErr     bool
+	}{
+		{
+			name:            "it returns the latest patch version",
+			currentVersion:  "1.2.3",
+			policy:          pkgutils.UpgradePolicyPatch,
+			expectedVersion: &corev1.PackageAppVersion{PkgVersion: "1.2.5"},
+		},
+		{
+			name:            "it returns the latest minor version",
+			currentVersion:  "1.2.3",
+			policy:          pkgutils.UpgradePolicyMinor,
+			expectedVersion: &corev1.PackageAppVersion{PkgVersion: "1.3.0"},
+		},
+		{
+			name:            "it returns the latest major version",
+			currentVersion:  "1.2.3",
+			policy:          pkgutils.UpgradePolicyMajor,
+			expectedVersion: &corev1.PackageAppVersion{PkgVersion: "2.0.0"},
+		},
+		{
+			name:           "it returns nil when already on the latest version",
+			currentVersion: "2.0.0",
+			policy:         pkgutils.UpgradePolicyMajor,
+		},
+		{
+			name:           "it returns an error for an invalid current version",
+			currentVersion: "latest",
+			policy:         pkgutils.UpgradePolicyMajor,
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := latestVersionWithUpgradePolicy(tc.currentVersion, versions, tc.policy)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+
+			if got, want := version, tc.expectedVersion; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+			}
+		})
+	}
+}
+
+func makeValuesSchemaTestPackagingPlugin(pluginName string) pkgPluginWithServer {
+	pluginWithServer := makeDefaultTestPackagingPlugin(pluginName)
+	pluginWithServer.server.(*plugin_test.TestPackagingPluginServer).AvailablePackageDetail.ValuesSchema = `{"type": "object", "properties": {"replicaCount": {"type": "integer"}}}`
+	return pluginWithServer
+}
+
+func TestValidateInstalledPackageValues(t *testing.T) {
+	schemaPlugin := makeValuesSchemaTestPackagingPlugin("mock1")
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		statusCode        connect.Code
+		request           *corev1.ValidateInstalledPackageValuesRequest
+		expectedResponse  *corev1.ValidateInstalledPackageValuesResponse
+	}{
+		{
+			name:              "it should return valid for values conforming to the values schema",
+			configuredPlugins: []pkgPluginWithServer{schemaPlugin},
+			request: &corev1.ValidateInstalledPackageValuesRequest{
+				AvailablePackageRef: schemaPlugin.server.(*plugin_test.TestPackagingPluginServer).AvailablePackageDetail.AvailablePackageRef,
+				Values:              "replicaCount: 2",
+			},
+			expectedResponse: &corev1.ValidateInstalledPackageValuesResponse{
+				Valid:      true,
+				Violations: []*corev1.ValuesSchemaViolation{},
+			},
+		},
+		{
+			name:              "it should return the violations of the values schema",
+			configuredPlugins: []pkgPluginWithServer{schemaPlugin},
+			request: &corev1.ValidateInstalledPackageValuesRequest{
+				AvailablePackageRef: schemaPlugin.server.(*plugin_test.TestPackagingPluginServer).AvailablePackageDetail.AvailablePackageRef,
+				Values:              "replicaCount: two",
+			},
+			expectedResponse: &corev1.ValidateInstalledPackageValuesResponse{
+				Valid: false,
+				Violations: []*corev1.ValuesSchemaViolation{
+					{Path: "replicaCount", Description: "Invalid type. Expected: integer, given: string"},
+				},
+			},
+		},
+		{
+			name:              "it should return an invalid argument error for values which cannot be parsed",
+			configuredPlugins: []pkgPluginWithServer{schemaPlugin},
+			request: &corev1.ValidateInstalledPackageValuesRequest{
+				AvailablePackageRef: schemaPlugin.server.(*plugin_test.TestPackagingPluginServer).AvailablePackageDetail.AvailablePackageRef,
+				Values:              "replicaCount: [",
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:              "it should return the error of the plugin",
+			configuredPlugins: []pkgPluginWithServer{mockedNotFoundPackagingPlugin},
+			request: &corev1.ValidateInstalledPackageValuesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "pkg-1",
+					Plugin:     mockedNotFoundPackagingPlugin.plugin,
+				},
+			},
+			statusCode: connect.CodeNotFound,
+		},
+		{
+			name: "it should return invalid argument if plugin not specified in request",
+			request: &corev1.ValidateInstalledPackageValuesRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "pkg-1",
+				},
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		corev1.ValidateInstalledPackageValuesResponse{},
+		corev1.ValuesSchemaViolation{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}
+
+			response, err := server.ValidateInstalledPackageValues(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.statusCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != 0 {
+				return
+			}
+
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
+
+func TestInstalledPackageValuesValidation(t *testing.T) {
+	schemaPlugin := makeValuesSchemaTestPackagingPlugin("mock1")
+	availablePackageRef := schemaPlugin.server.(*plugin_test.TestPackagingPluginServer).AvailablePackageDetail.AvailablePackageRef
+	installedPackageRef := plugin_test.MakeInstalledPackageSummary("pkg-1", schemaPlugin.plugin).InstalledPackageRef
+
+	customDetail, err := anypb.New(&corev1.Context{Namespace: "custom"})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name               string
+		values             string
+		customDetail       *anypb.Any
+		statusCode         connect.Code
+		expectedViolations []*corev1.ValuesSchemaViolation
+	}{
+		{
+			name:   "it should accept values conforming to the values schema",
+			values: "replicaCount: 2",
+		},
+		{
+			name:       "it should reject values violating the values schema",
+			values:     "replicaCount: two",
+			statusCode: connect.CodeInvalidArgument,
+			expectedViolations: []*corev1.ValuesSchemaViolation{
+				{Path: "replicaCount", Description: "Invalid type. Expected: integer, given: string"},
+			},
+		},
+		{
+			name:         "it should reject values violating the values schema with a custom detail not merging server side values",
+			values:       "replicaCount: two",
+			customDetail: customDetail,
+			statusCode:   connect.CodeInvalidArgument,
+			expectedViolations: []*corev1.ValuesSchemaViolation{
+				{Path: "replicaCount", Description: "Invalid type. Expected: integer, given: string"},
+			},
+		},
+	}
+
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		corev1.ValidateInstalledPackageValuesResponse{},
+		corev1.ValuesSchemaViolation{},
+	)
+
+	checkErr := func(t *testing.T, err error, statusCode connect.Code, expectedViolations []*corev1.ValuesSchemaViolation) {
+		if got, want := connect.CodeOf(err), statusCode; err != nil && got != want {
+			t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+		}
+		if statusCode == 0 {
+			return
+		}
+		var connectErr *connect.Error
+		if !errors.As(err, &connectErr) || len(connectErr.Details()) != 1 {
+			t.Fatalf("expected a single error detail, got: %+v", err)
+		}
+		detail, err := connectErr.Details()[0].Value()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		response, ok := detail.(*corev1.ValidateInstalledPackageValuesResponse)
+		if !ok {
+			t.Fatalf("unexpected error detail: %+v", detail)
+		}
+		if got, want := response.Violations, expectedViolations; !cmp.Equal(got, want, ignoreUnexported) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+		}
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{schemaPlugin},
+			}
+
+			_, err := server.CreateInstalledPackage(context.Background(), connect.NewRequest(&corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: availablePackageRef,
+				TargetContext:       installedPackageRef.Context,
+				Name:                installedPackageRef.Identifier,
+				Values:              tc.values,
+				CustomDetail:        tc.customDetail,
+			}))
+			checkErr(t, err, tc.statusCode, tc.expectedViolations)
+
+			_, err = server.UpdateInstalledPackage(context.Background(), connect.NewRequest(&corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				Values:              tc.values,
+				CustomDetail:        tc.customDetail,
+			}))
+			checkErr(t, err, tc.statusCode, tc.expectedViolations)
+		})
+	}
+}
+
+func TestInstalledPackageValuesValidationLeftToPlugin(t *testing.T) {
+	customDetail, err := anypb.New(&corev1.Context{Namespace: "custom"})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	invalidValues := "replicaCount: two"
+
+	testCases := []struct {
+		name                     string
+		customDetail             *anypb.Any
+		mergesCustomDetailValues bool
+		serverSideValues         bool
+	}{
+		{
+			name:                     "it should not validate the values of a request with a custom detail merging server side values",
+			customDetail:             customDetail,
+			mergesCustomDetailValues: true,
+		},
+		{
+			name:             "it should not validate the values of an update merging server side values",
+			serverSideValues: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schemaPlugin := makeValuesSchemaTestPackagingPlugin("mock1")
+			pluginServer := schemaPlugin.server.(*plugin_test.TestPackagingPluginServer)
+			pluginServer.InstalledPackageDetail.ServerSideValues = tc.serverSideValues
+			pluginServer.MergesCustomDetailValues = tc.mergesCustomDetailValues
+			installedPackageRef := plugin_test.MakeInstalledPackageSummary("pkg-1", schemaPlugin.plugin).InstalledPackageRef
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{schemaPlugin},
+			}
+
+			if tc.customDetail != nil {
+				_, err := server.CreateInstalledPackage(context.Background(), connect.NewRequest(&corev1.CreateInstalledPackageRequest{
+					AvailablePackageRef: pluginServer.AvailablePackageDetail.AvailablePackageRef,
+					TargetContext:       installedPackageRef.Context,
+					Name:                installedPackageRef.Identifier,
+					Values:              invalidValues,
+					CustomDetail:        tc.customDetail,
+				}))
+				if err != nil {
+					t.Errorf("%+v", err)
+				}
+			}
+
+			_, err := server.UpdateInstalledPackage(context.Background(), connect.NewRequest(&corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				Values:              invalidValues,
+				CustomDetail:        tc.customDetail,
+			}))
+			if err != nil {
+				t.Errorf("%+v", err)
+			}
+		})
+	}
+}
+
+func TestExportInstalledPackages(t *testing.T) {
+	entry := func(plugin *plugins.Plugin) *corev1.InstalledPackagesManifestEntry {
+		detail := plugin_test.MakeInstalledPackageDetail("pkg-1", plugin)
+		return &corev1.InstalledPackagesManifestEntry{
+			Name:                detail.Name,
+			Namespace:           plugin_test.DefaultReleaseNamespace,
+			AvailablePackageRef: detail.AvailablePackageRef,
+			PkgVersionReference: detail.PkgVersionReference,
+			Values:              detail.ValuesApplied,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		statusCode        connect.Code
+		request           *corev1.ExportInstalledPackagesRequest
+		expectedManifest  *corev1.InstalledPackagesManifest
+	}{
+		{
+			name: "it should export the installed packages of every plugin as yaml",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.ExportInstalledPackagesRequest{
+				Context: &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+			},
+			expectedManifest: &corev1.InstalledPackagesManifest{
+				ApiVersion: installedPackagesManifestAPIVersion,
+				InstalledPackages: []*corev1.InstalledPackagesManifestEntry{
+					entry(mockedPackagingPlugin1.plugin),
+					entry(mockedPackagingPlugin1.plugin),
+					entry(mockedPackagingPlugin2.plugin),
+					entry(mockedPackagingPlugin2.plugin),
+				},
+			},
+		},
+		{
+			name: "it should export the installed packages as json",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.ExportInstalledPackagesRequest{
+				Context: &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				Format:  corev1.ExportInstalledPackagesRequest_MANIFEST_FORMAT_JSON,
+			},
+			expectedManifest: &corev1.InstalledPackagesManifest{
+				ApiVersion: installedPackagesManifestAPIVersion,
+				InstalledPackages: []*corev1.InstalledPackagesManifestEntry{
+					entry(mockedPackagingPlugin1.plugin),
+					entry(mockedPackagingPlugin1.plugin),
+				},
+			},
+		},
+		{
+			name: "it should return an invalid argument error for an unknown format",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+			},
+			request: &corev1.ExportInstalledPackagesRequest{
+				Context: &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+				Format:  corev1.ExportInstalledPackagesRequest_ManifestFormat(10),
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "it should return the error of a plugin unable to get its installed packages",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.ExportInstalledPackagesRequest{
+				Context: &corev1.Context{Namespace: plugin_test.DefaultNamespace},
+			},
+			statusCode: connect.CodeNotFound,
+		},
+	}
+
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		corev1.InstalledPackagesManifest{},
+		corev1.InstalledPackagesManifestEntry{},
+		corev1.AvailablePackageReference{},
+		corev1.VersionReference{},
+		corev1.Context{},
+		plugins.Plugin{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}
+
+			response, err := server.ExportInstalledPackages(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.statusCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != 0 {
+				return
+			}
+
+			manifest, err := unmarshalInstalledPackagesManifest(response.Msg.GetManifest())
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := manifest, tc.expectedManifest; !cmp.Equal(got, want, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
+
+// existingPackagesPluginServer is a test packaging plugin for which only the
+// installed packages with the given names exist.
+type existingPackagesPluginServer struct {
+	*plugin_test.TestPackagingPluginServer
+	existing map[string]bool
+}
+
+func (s existingPackagesPluginServer) GetInstalledPackageDetail(ctx context.Context, request *connect.Request[corev1.GetInstalledPackageDetailRequest]) (*connect.Response[corev1.GetInstalledPackageDetailResponse], error) {
+	if !s.existing[request.Msg.GetInstalledPackageRef().GetIdentifier()] {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Not found"))
+	}
+	return s.TestPackagingPluginServer.GetInstalledPackageDetail(ctx, request)
+}
+
+func TestImportInstalledPackages(t *testing.T) {
+	existingPlugin := makeDefaultTestPackagingPlugin("mock-existing")
+	existingPlugin.server = existingPackagesPluginServer{
+		TestPackagingPluginServer: existingPlugin.server.(*plugin_test.TestPackagingPluginServer),
+		existing:                  map[string]bool{"pkg-1": true, "pkg-1-1": true},
+	}
+
+	const manifest = `apiVersion: kubeapps.dev/v1alpha1
+installedPackages:
+- name: pkg-1
+  availablePackageRef:
+    context:
+      cluster: default
+      namespace: my-release-namespace-1
+    identifier: repo-1/package-id
+    plugin:
+      name: mock-existing
+      version: v1alpha1
+  pkgVersionReference:
+    version: 1.2.3
+  values: '{"value":"new"}'
+- name: pkg-2
+  namespace: exported-namespace
+  availablePackageRef:
+    identifier: repo-1/package-id
+    plugin:
+      name: mock-existing
+      version: v1alpha1
+`
+	targetContext := &corev1.Context{Cluster: "other-cluster", Namespace: "other-namespace"}
+	resultIn := func(context *corev1.Context, name, identifier string, result corev1.ImportInstalledPackageResult_Result) *corev1.ImportInstalledPackageResult {
+		return &corev1.ImportInstalledPackageResult{
+			Name: name,
+			InstalledPackageRef: &corev1.InstalledPackageReference{
+				Context:    context,
+				Identifier: identifier,
+				Plugin:     existingPlugin.plugin,
+			},
+			Result: result,
+		}
+	}
+	result := func(name, identifier string, result corev1.ImportInstalledPackageResult_Result) *corev1.ImportInstalledPackageResult {
+		return resultIn(targetContext, name, identifier, result)
+	}
+
+	testCases := []struct {
+		name             string
+		statusCode       connect.Code
+		request          *corev1.ImportInstalledPackagesRequest
+		expectedResponse *corev1.ImportInstalledPackagesResponse
+	}{
+		{
+			name: "it should create the missing installed packages and skip the existing ones by default",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:      manifest,
+				TargetContext: targetContext,
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportInstalledPackageResult{
+					result("pkg-1", "pkg-1", corev1.ImportInstalledPackageResult_RESULT_SKIPPED),
+					result("pkg-2", "pkg-2", corev1.ImportInstalledPackageResult_RESULT_CREATED),
+				},
+			},
+		},
+		{
+			name: "it should update the existing installed packages when overwriting",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:       manifest,
+				TargetContext:  targetContext,
+				ConflictPolicy: corev1.ImportInstalledPackagesRequest_CONFLICT_POLICY_OVERWRITE,
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportInstalledPackageResult{
+					result("pkg-1", "pkg-1", corev1.ImportInstalledPackageResult_RESULT_UPDATED),
+					result("pkg-2", "pkg-2", corev1.ImportInstalledPackageResult_RESULT_CREATED),
+				},
+			},
+		},
+		{
+			name: "it should create the existing installed packages with an available name when renaming",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:       manifest,
+				TargetContext:  targetContext,
+				ConflictPolicy: corev1.ImportInstalledPackagesRequest_CONFLICT_POLICY_RENAME,
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportInstalledPackageResult{
+					result("pkg-1", "pkg-1-2", corev1.ImportInstalledPackageResult_RESULT_CREATED),
+					result("pkg-2", "pkg-2", corev1.ImportInstalledPackageResult_RESULT_CREATED),
+				},
+			},
+		},
+		{
+			name: "it should create the installed packages in their exported namespace without a target namespace",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:      manifest,
+				TargetContext: &corev1.Context{Cluster: "other-cluster"},
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportInstalledPackageResult{
+					{
+						Name:         "pkg-1",
+						Result:       corev1.ImportInstalledPackageResult_RESULT_FAILED,
+						ErrorMessage: "Invalid manifest entry (missing namespace and no target namespace requested)",
+					},
+					resultIn(&corev1.Context{Cluster: "other-cluster", Namespace: "exported-namespace"}, "pkg-2", "pkg-2", corev1.ImportInstalledPackageResult_RESULT_CREATED),
+				},
+			},
+		},
+		{
+			name: "it should report the installed packages of an unknown plugin as failed",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:      `{"apiVersion": "kubeapps.dev/v1alpha1", "installedPackages": [{"name": "pkg-3", "availablePackageRef": {"identifier": "repo-1/package-id", "plugin": {"name": "unknown-plugin", "version": "v1alpha1"}}}]}`,
+				TargetContext: targetContext,
+			},
+			expectedResponse: &corev1.ImportInstalledPackagesResponse{
+				Results: []*corev1.ImportInstalledPackageResult{
+					{
+						Name:         "pkg-3",
+						Result:       corev1.ImportInstalledPackageResult_RESULT_FAILED,
+						ErrorMessage: `Unable to get the plugin "unknown-plugin"`,
+					},
+				},
+			},
+		},
+		{
+			name: "it should return an invalid argument error for an unsupported manifest version",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:      "apiVersion: kubeapps.dev/v2\ninstalledPackages: []",
+				TargetContext: targetContext,
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "it should return an invalid argument error for a manifest which cannot be parsed",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:      "installedPackages: [",
+				TargetContext: targetContext,
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "it should return an invalid argument error for an unknown conflict policy",
+			request: &corev1.ImportInstalledPackagesRequest{
+				Manifest:       manifest,
+				TargetContext:  targetContext,
+				ConflictPolicy: corev1.ImportInstalledPackagesRequest_ConflictPolicy(10),
+			},
+			statusCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		corev1.ImportInstalledPackagesResponse{},
+		corev1.ImportInstalledPackageResult{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		plugins.Plugin{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{existingPlugin},
+			}
+
+			response, err := server.ImportInstalledPackages(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.statusCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != 0 {
+				return
+			}
+
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
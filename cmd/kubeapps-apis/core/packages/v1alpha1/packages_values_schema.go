@@ -0,0 +1,124 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"google.golang.org/protobuf/types/known/anypb"
+	log "k8s.io/klog/v2"
+)
+
+// serverSideValuesMerger is implemented by the packaging plugins able to merge
+// values resolved server side, as requested by the custom detail of a create or
+// update request, with the values of the request.
+type serverSideValuesMerger interface {
+	MergesServerSideValues(customDetail *anypb.Any) bool
+}
+
+// mergesServerSideValues returns whether the plugin merges values resolved
+// server side for the custom detail of a request.
+func mergesServerSideValues(pluginWithServer pkgPluginWithServer, customDetail *anypb.Any) bool {
+	if customDetail == nil {
+		return false
+	}
+	merger, ok := pluginWithServer.server.(serverSideValuesMerger)
+	return ok && merger.MergesServerSideValues(customDetail)
+}
+
+// valuesSchemaViolations returns the violations of the values schema of the
+// available package found in the values.
+func valuesSchemaViolations(ctx context.Context, pluginWithServer pkgPluginWithServer, headers http.Header, availablePackageRef *packages.AvailablePackageReference, pkgVersion, values string) ([]*packages.ValuesSchemaViolation, error) {
+	request := connect.NewRequest(&packages.GetAvailablePackageDetailRequest{
+		AvailablePackageRef: availablePackageRef,
+		PkgVersion:          pkgVersion,
+	})
+	request.Header().Set("Authorization", headers.Get("Authorization"))
+	response, err := pluginWithServer.server.GetAvailablePackageDetail(ctx, request)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to get the available package detail for the package %q using the plugin %q: %w", availablePackageRef.GetIdentifier(), pluginWithServer.plugin.Name, err))
+	}
+
+	detail := response.Msg.GetAvailablePackageDetail()
+	violations, err := pkgutils.ValidateValuesAgainstSchema(values, detail.GetDefaultValues(), detail.GetValuesSchema())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to validate the values for the package %q: %w", availablePackageRef.GetIdentifier(), err))
+	}
+	return violations, nil
+}
+
+// validateValues returns an invalid argument error, detailing each violation,
+// when the values do not conform to the values schema of the available package.
+// As the plugin validates the request in any case, the validation is skipped
+// when the values cannot be validated, such as when the values schema cannot be
+// retrieved.
+func validateValues(ctx context.Context, pluginWithServer pkgPluginWithServer, headers http.Header, availablePackageRef *packages.AvailablePackageReference, pkgVersion, values string) error {
+	violations, err := valuesSchemaViolations(ctx, pluginWithServer, headers, availablePackageRef, pkgVersion, values)
+	if err != nil {
+		log.Warningf("+core skipping the values validation for the package %q: %v", availablePackageRef.GetIdentifier(), err)
+		return nil
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		descriptions[i] = fmt.Sprintf("%s: %s", v.GetPath(), v.GetDescription())
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The values do not conform to the values schema of the package %q: %s", availablePackageRef.GetIdentifier(), strings.Join(descriptions, "; ")))
+	if detail, err := connect.NewErrorDetail(&packages.ValidateInstalledPackageValuesResponse{
+		Valid:      false,
+		Violations: violations,
+	}); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// validateCreatedValues validates the values of a create request against the
+// values schema of the available package. When the plugin merges other values
+// resolved from the custom detail of the request, the values are left for the
+// plugin to validate.
+func validateCreatedValues(ctx context.Context, pluginWithServer pkgPluginWithServer, headers http.Header, createRequest *packages.CreateInstalledPackageRequest) error {
+	if mergesServerSideValues(pluginWithServer, createRequest.GetCustomDetail()) {
+		return nil
+	}
+	return validateValues(ctx, pluginWithServer, headers, createRequest.GetAvailablePackageRef(), createRequest.GetPkgVersionReference().GetVersion(), createRequest.GetValues())
+}
+
+// validateUpdatedValues validates the values of an update request against the
+// values schema of the package of the installed package, for the requested
+// version or, if none, the current one. As for a create request, the values are
+// left for the plugin to validate when it merges other values.
+func validateUpdatedValues(ctx context.Context, pluginWithServer pkgPluginWithServer, headers http.Header, updateRequest *packages.UpdateInstalledPackageRequest) error {
+	if mergesServerSideValues(pluginWithServer, updateRequest.GetCustomDetail()) {
+		return nil
+	}
+	request := connect.NewRequest(&packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: updateRequest.GetInstalledPackageRef(),
+	})
+	request.Header().Set("Authorization", headers.Get("Authorization"))
+	response, err := pluginWithServer.server.GetInstalledPackageDetail(ctx, request)
+	if err != nil {
+		log.Warningf("+core skipping the values validation for the installed package %q: %v", updateRequest.GetInstalledPackageRef().GetIdentifier(), err)
+		return nil
+	}
+
+	detail := response.Msg.GetInstalledPackageDetail()
+	if detail.GetAvailablePackageRef() == nil || detail.GetServerSideValues() {
+		return nil
+	}
+	pkgVersion := updateRequest.GetPkgVersionReference().GetVersion()
+	if pkgVersion == "" {
+		pkgVersion = detail.GetCurrentVersion().GetPkgVersion()
+	}
+	return validateValues(ctx, pluginWithServer, headers, detail.GetAvailablePackageRef(), pkgVersion, updateRequest.GetValues())
+}
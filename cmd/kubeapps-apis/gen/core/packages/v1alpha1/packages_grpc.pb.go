@@ -30,8 +30,13 @@ const (
 	PackagesService_GetInstalledPackageDetail_FullMethodName       = "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageDetail"
 	PackagesService_CreateInstalledPackage_FullMethodName          = "/kubeappsapis.core.packages.v1alpha1.PackagesService/CreateInstalledPackage"
 	PackagesService_UpdateInstalledPackage_FullMethodName          = "/kubeappsapis.core.packages.v1alpha1.PackagesService/UpdateInstalledPackage"
+	PackagesService_ValidateInstalledPackageValues_FullMethodName  = "/kubeappsapis.core.packages.v1alpha1.PackagesService/ValidateInstalledPackageValues"
+	PackagesService_BulkUpdateInstalledPackages_FullMethodName     = "/kubeappsapis.core.packages.v1alpha1.PackagesService/BulkUpdateInstalledPackages"
+	PackagesService_ExportInstalledPackages_FullMethodName         = "/kubeappsapis.core.packages.v1alpha1.PackagesService/ExportInstalledPackages"
+	PackagesService_ImportInstalledPackages_FullMethodName         = "/kubeappsapis.core.packages.v1alpha1.PackagesService/ImportInstalledPackages"
 	PackagesService_DeleteInstalledPackage_FullMethodName          = "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage"
 	PackagesService_GetInstalledPackageResourceRefs_FullMethodName = "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageResourceRefs"
+	PackagesService_WatchInstalledPackages_FullMethodName          = "/kubeappsapis.core.packages.v1alpha1.PackagesService/WatchInstalledPackages"
 )
 
 // PackagesServiceClient is the client API for PackagesService service.
@@ -46,8 +51,13 @@ type PackagesServiceClient interface {
 	GetInstalledPackageDetail(ctx context.Context, in *GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*GetInstalledPackageDetailResponse, error)
 	CreateInstalledPackage(ctx context.Context, in *CreateInstalledPackageRequest, opts ...grpc.CallOption) (*CreateInstalledPackageResponse, error)
 	UpdateInstalledPackage(ctx context.Context, in *UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*UpdateInstalledPackageResponse, error)
+	ValidateInstalledPackageValues(ctx context.Context, in *ValidateInstalledPackageValuesRequest, opts ...grpc.CallOption) (*ValidateInstalledPackageValuesResponse, error)
+	BulkUpdateInstalledPackages(ctx context.Context, in *BulkUpdateInstalledPackagesRequest, opts ...grpc.CallOption) (*BulkUpdateInstalledPackagesResponse, error)
+	ExportInstalledPackages(ctx context.Context, in *ExportInstalledPackagesRequest, opts ...grpc.CallOption) (*ExportInstalledPackagesResponse, error)
+	ImportInstalledPackages(ctx context.Context, in *ImportInstalledPackagesRequest, opts ...grpc.CallOption) (*ImportInstalledPackagesResponse, error)
 	DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error)
 	GetInstalledPackageResourceRefs(ctx context.Context, in *GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*GetInstalledPackageResourceRefsResponse, error)
+	WatchInstalledPackages(ctx context.Context, in *WatchInstalledPackagesRequest, opts ...grpc.CallOption) (PackagesService_WatchInstalledPackagesClient, error)
 }
 
 type packagesServiceClient struct {
@@ -130,6 +140,42 @@ func (c *packagesServiceClient) UpdateInstalledPackage(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *packagesServiceClient) ValidateInstalledPackageValues(ctx context.Context, in *ValidateInstalledPackageValuesRequest, opts ...grpc.CallOption) (*ValidateInstalledPackageValuesResponse, error) {
+	out := new(ValidateInstalledPackageValuesResponse)
+	err := c.cc.Invoke(ctx, PackagesService_ValidateInstalledPackageValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) BulkUpdateInstalledPackages(ctx context.Context, in *BulkUpdateInstalledPackagesRequest, opts ...grpc.CallOption) (*BulkUpdateInstalledPackagesResponse, error) {
+	out := new(BulkUpdateInstalledPackagesResponse)
+	err := c.cc.Invoke(ctx, PackagesService_BulkUpdateInstalledPackages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) ExportInstalledPackages(ctx context.Context, in *ExportInstalledPackagesRequest, opts ...grpc.CallOption) (*ExportInstalledPackagesResponse, error) {
+	out := new(ExportInstalledPackagesResponse)
+	err := c.cc.Invoke(ctx, PackagesService_ExportInstalledPackages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) ImportInstalledPackages(ctx context.Context, in *ImportInstalledPackagesRequest, opts ...grpc.CallOption) (*ImportInstalledPackagesResponse, error) {
+	out := new(ImportInstalledPackagesResponse)
+	err := c.cc.Invoke(ctx, PackagesService_ImportInstalledPackages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *packagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error) {
 	out := new(DeleteInstalledPackageResponse)
 	err := c.cc.Invoke(ctx, PackagesService_DeleteInstalledPackage_FullMethodName, in, out, opts...)
@@ -148,6 +194,38 @@ func (c *packagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Cont
 	return out, nil
 }
 
+func (c *packagesServiceClient) WatchInstalledPackages(ctx context.Context, in *WatchInstalledPackagesRequest, opts ...grpc.CallOption) (PackagesService_WatchInstalledPackagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PackagesService_ServiceDesc.Streams[0], PackagesService_WatchInstalledPackages_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &packagesServiceWatchInstalledPackagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PackagesService_WatchInstalledPackagesClient interface {
+	Recv() (*WatchInstalledPackagesResponse, error)
+	grpc.ClientStream
+}
+
+type packagesServiceWatchInstalledPackagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *packagesServiceWatchInstalledPackagesClient) Recv() (*WatchInstalledPackagesResponse, error) {
+	m := new(WatchInstalledPackagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PackagesServiceServer is the server API for PackagesService service.
 // All implementations should embed UnimplementedPackagesServiceServer
 // for forward compatibility
@@ -160,8 +238,13 @@ type PackagesServiceServer interface {
 	GetInstalledPackageDetail(context.Context, *GetInstalledPackageDetailRequest) (*GetInstalledPackageDetailResponse, error)
 	CreateInstalledPackage(context.Context, *CreateInstalledPackageRequest) (*CreateInstalledPackageResponse, error)
 	UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error)
+	ValidateInstalledPackageValues(context.Context, *ValidateInstalledPackageValuesRequest) (*ValidateInstalledPackageValuesResponse, error)
+	BulkUpdateInstalledPackages(context.Context, *BulkUpdateInstalledPackagesRequest) (*BulkUpdateInstalledPackagesResponse, error)
+	ExportInstalledPackages(context.Context, *ExportInstalledPackagesRequest) (*ExportInstalledPackagesResponse, error)
+	ImportInstalledPackages(context.Context, *ImportInstalledPackagesRequest) (*ImportInstalledPackagesResponse, error)
 	DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error)
 	GetInstalledPackageResourceRefs(context.Context, *GetInstalledPackageResourceRefsRequest) (*GetInstalledPackageResourceRefsResponse, error)
+	WatchInstalledPackages(*WatchInstalledPackagesRequest, PackagesService_WatchInstalledPackagesServer) error
 }
 
 // UnimplementedPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -192,12 +275,27 @@ func (UnimplementedPackagesServiceServer) CreateInstalledPackage(context.Context
 func (UnimplementedPackagesServiceServer) UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
 }
+func (UnimplementedPackagesServiceServer) ValidateInstalledPackageValues(context.Context, *ValidateInstalledPackageValuesRequest) (*ValidateInstalledPackageValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateInstalledPackageValues not implemented")
+}
+func (UnimplementedPackagesServiceServer) BulkUpdateInstalledPackages(context.Context, *BulkUpdateInstalledPackagesRequest) (*BulkUpdateInstalledPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateInstalledPackages not implemented")
+}
+func (UnimplementedPackagesServiceServer) ExportInstalledPackages(context.Context, *ExportInstalledPackagesRequest) (*ExportInstalledPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInstalledPackages not implemented")
+}
+func (UnimplementedPackagesServiceServer) ImportInstalledPackages(context.Context, *ImportInstalledPackagesRequest) (*ImportInstalledPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportInstalledPackages not implemented")
+}
 func (UnimplementedPackagesServiceServer) DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
 }
 func (UnimplementedPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *GetInstalledPackageResourceRefsRequest) (*GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedPackagesServiceServer) WatchInstalledPackages(*WatchInstalledPackagesRequest, PackagesService_WatchInstalledPackagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInstalledPackages not implemented")
+}
 
 // UnsafePackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PackagesServiceServer will
@@ -354,6 +452,78 @@ func _PackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_ValidateInstalledPackageValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateInstalledPackageValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).ValidateInstalledPackageValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackagesService_ValidateInstalledPackageValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).ValidateInstalledPackageValues(ctx, req.(*ValidateInstalledPackageValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_BulkUpdateInstalledPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateInstalledPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).BulkUpdateInstalledPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackagesService_BulkUpdateInstalledPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).BulkUpdateInstalledPackages(ctx, req.(*BulkUpdateInstalledPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_ExportInstalledPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportInstalledPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).ExportInstalledPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackagesService_ExportInstalledPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).ExportInstalledPackages(ctx, req.(*ExportInstalledPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_ImportInstalledPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportInstalledPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).ImportInstalledPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackagesService_ImportInstalledPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).ImportInstalledPackages(ctx, req.(*ImportInstalledPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInstalledPackageRequest)
 	if err := dec(in); err != nil {
@@ -390,6 +560,27 @@ func _PackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_WatchInstalledPackages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInstalledPackagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PackagesServiceServer).WatchInstalledPackages(m, &packagesServiceWatchInstalledPackagesServer{stream})
+}
+
+type PackagesService_WatchInstalledPackagesServer interface {
+	Send(*WatchInstalledPackagesResponse) error
+	grpc.ServerStream
+}
+
+type packagesServiceWatchInstalledPackagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *packagesServiceWatchInstalledPackagesServer) Send(m *WatchInstalledPackagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PackagesService_ServiceDesc is the grpc.ServiceDesc for PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -429,6 +620,22 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstalledPackage",
 			Handler:    _PackagesService_UpdateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "ValidateInstalledPackageValues",
+			Handler:    _PackagesService_ValidateInstalledPackageValues_Handler,
+		},
+		{
+			MethodName: "BulkUpdateInstalledPackages",
+			Handler:    _PackagesService_BulkUpdateInstalledPackages_Handler,
+		},
+		{
+			MethodName: "ExportInstalledPackages",
+			Handler:    _PackagesService_ExportInstalledPackages_Handler,
+		},
+		{
+			MethodName: "ImportInstalledPackages",
+			Handler:    _PackagesService_ImportInstalledPackages_Handler,
+		},
 		{
 			MethodName: "DeleteInstalledPackage",
 			Handler:    _PackagesService_DeleteInstalledPackage_Handler,
@@ -438,6 +645,12 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInstalledPackages",
+			Handler:       _PackagesService_WatchInstalledPackages_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "kubeappsapis/core/packages/v1alpha1/packages.proto",
 }
@@ -60,12 +60,27 @@ const (
 	// PackagesServiceUpdateInstalledPackageProcedure is the fully-qualified name of the
 	// PackagesService's UpdateInstalledPackage RPC.
 	PackagesServiceUpdateInstalledPackageProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/UpdateInstalledPackage"
+	// PackagesServiceValidateInstalledPackageValuesProcedure is the fully-qualified name of the
+	// PackagesService's ValidateInstalledPackageValues RPC.
+	PackagesServiceValidateInstalledPackageValuesProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/ValidateInstalledPackageValues"
+	// PackagesServiceBulkUpdateInstalledPackagesProcedure is the fully-qualified name of the
+	// PackagesService's BulkUpdateInstalledPackages RPC.
+	PackagesServiceBulkUpdateInstalledPackagesProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/BulkUpdateInstalledPackages"
+	// PackagesServiceExportInstalledPackagesProcedure is the fully-qualified name of the
+	// PackagesService's ExportInstalledPackages RPC.
+	PackagesServiceExportInstalledPackagesProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/ExportInstalledPackages"
+	// PackagesServiceImportInstalledPackagesProcedure is the fully-qualified name of the
+	// PackagesService's ImportInstalledPackages RPC.
+	PackagesServiceImportInstalledPackagesProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/ImportInstalledPackages"
 	// PackagesServiceDeleteInstalledPackageProcedure is the fully-qualified name of the
 	// PackagesService's DeleteInstalledPackage RPC.
 	PackagesServiceDeleteInstalledPackageProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage"
 	// PackagesServiceGetInstalledPackageResourceRefsProcedure is the fully-qualified name of the
 	// PackagesService's GetInstalledPackageResourceRefs RPC.
 	PackagesServiceGetInstalledPackageResourceRefsProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageResourceRefs"
+	// PackagesServiceWatchInstalledPackagesProcedure is the fully-qualified name of the
+	// PackagesService's WatchInstalledPackages RPC.
+	PackagesServiceWatchInstalledPackagesProcedure = "/kubeappsapis.core.packages.v1alpha1.PackagesService/WatchInstalledPackages"
 )
 
 // PackagesServiceClient is a client for the kubeappsapis.core.packages.v1alpha1.PackagesService
@@ -79,8 +94,13 @@ type PackagesServiceClient interface {
 	GetInstalledPackageDetail(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageDetailRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageDetailResponse], error)
 	CreateInstalledPackage(context.Context, *connect_go.Request[v1alpha1.CreateInstalledPackageRequest]) (*connect_go.Response[v1alpha1.CreateInstalledPackageResponse], error)
 	UpdateInstalledPackage(context.Context, *connect_go.Request[v1alpha1.UpdateInstalledPackageRequest]) (*connect_go.Response[v1alpha1.UpdateInstalledPackageResponse], error)
+	ValidateInstalledPackageValues(context.Context, *connect_go.Request[v1alpha1.ValidateInstalledPackageValuesRequest]) (*connect_go.Response[v1alpha1.ValidateInstalledPackageValuesResponse], error)
+	BulkUpdateInstalledPackages(context.Context, *connect_go.Request[v1alpha1.BulkUpdateInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.BulkUpdateInstalledPackagesResponse], error)
+	ExportInstalledPackages(context.Context, *connect_go.Request[v1alpha1.ExportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ExportInstalledPackagesResponse], error)
+	ImportInstalledPackages(context.Context, *connect_go.Request[v1alpha1.ImportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ImportInstalledPackagesResponse], error)
 	DeleteInstalledPackage(context.Context, *connect_go.Request[v1alpha1.DeleteInstalledPackageRequest]) (*connect_go.Response[v1alpha1.DeleteInstalledPackageResponse], error)
 	GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error)
+	WatchInstalledPackages(context.Context, *connect_go.Request[v1alpha1.WatchInstalledPackagesRequest]) (*connect_go.ServerStreamForClient[v1alpha1.WatchInstalledPackagesResponse], error)
 }
 
 // NewPackagesServiceClient constructs a client for the
@@ -134,6 +154,26 @@ func NewPackagesServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+PackagesServiceUpdateInstalledPackageProcedure,
 			opts...,
 		),
+		validateInstalledPackageValues: connect_go.NewClient[v1alpha1.ValidateInstalledPackageValuesRequest, v1alpha1.ValidateInstalledPackageValuesResponse](
+			httpClient,
+			baseURL+PackagesServiceValidateInstalledPackageValuesProcedure,
+			opts...,
+		),
+		bulkUpdateInstalledPackages: connect_go.NewClient[v1alpha1.BulkUpdateInstalledPackagesRequest, v1alpha1.BulkUpdateInstalledPackagesResponse](
+			httpClient,
+			baseURL+PackagesServiceBulkUpdateInstalledPackagesProcedure,
+			opts...,
+		),
+		exportInstalledPackages: connect_go.NewClient[v1alpha1.ExportInstalledPackagesRequest, v1alpha1.ExportInstalledPackagesResponse](
+			httpClient,
+			baseURL+PackagesServiceExportInstalledPackagesProcedure,
+			opts...,
+		),
+		importInstalledPackages: connect_go.NewClient[v1alpha1.ImportInstalledPackagesRequest, v1alpha1.ImportInstalledPackagesResponse](
+			httpClient,
+			baseURL+PackagesServiceImportInstalledPackagesProcedure,
+			opts...,
+		),
 		deleteInstalledPackage: connect_go.NewClient[v1alpha1.DeleteInstalledPackageRequest, v1alpha1.DeleteInstalledPackageResponse](
 			httpClient,
 			baseURL+PackagesServiceDeleteInstalledPackageProcedure,
@@ -144,6 +184,11 @@ func NewPackagesServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+PackagesServiceGetInstalledPackageResourceRefsProcedure,
 			opts...,
 		),
+		watchInstalledPackages: connect_go.NewClient[v1alpha1.WatchInstalledPackagesRequest, v1alpha1.WatchInstalledPackagesResponse](
+			httpClient,
+			baseURL+PackagesServiceWatchInstalledPackagesProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -157,8 +202,13 @@ type packagesServiceClient struct {
 	getInstalledPackageDetail       *connect_go.Client[v1alpha1.GetInstalledPackageDetailRequest, v1alpha1.GetInstalledPackageDetailResponse]
 	createInstalledPackage          *connect_go.Client[v1alpha1.CreateInstalledPackageRequest, v1alpha1.CreateInstalledPackageResponse]
 	updateInstalledPackage          *connect_go.Client[v1alpha1.UpdateInstalledPackageRequest, v1alpha1.UpdateInstalledPackageResponse]
+	validateInstalledPackageValues  *connect_go.Client[v1alpha1.ValidateInstalledPackageValuesRequest, v1alpha1.ValidateInstalledPackageValuesResponse]
+	bulkUpdateInstalledPackages     *connect_go.Client[v1alpha1.BulkUpdateInstalledPackagesRequest, v1alpha1.BulkUpdateInstalledPackagesResponse]
+	exportInstalledPackages         *connect_go.Client[v1alpha1.ExportInstalledPackagesRequest, v1alpha1.ExportInstalledPackagesResponse]
+	importInstalledPackages         *connect_go.Client[v1alpha1.ImportInstalledPackagesRequest, v1alpha1.ImportInstalledPackagesResponse]
 	deleteInstalledPackage          *connect_go.Client[v1alpha1.DeleteInstalledPackageRequest, v1alpha1.DeleteInstalledPackageResponse]
 	getInstalledPackageResourceRefs *connect_go.Client[v1alpha1.GetInstalledPackageResourceRefsRequest, v1alpha1.GetInstalledPackageResourceRefsResponse]
+	watchInstalledPackages          *connect_go.Client[v1alpha1.WatchInstalledPackagesRequest, v1alpha1.WatchInstalledPackagesResponse]
 }
 
 // GetAvailablePackageSummaries calls
@@ -209,6 +259,30 @@ func (c *packagesServiceClient) UpdateInstalledPackage(ctx context.Context, req
 	return c.updateInstalledPackage.CallUnary(ctx, req)
 }
 
+// ValidateInstalledPackageValues calls
+// kubeappsapis.core.packages.v1alpha1.PackagesService.ValidateInstalledPackageValues.
+func (c *packagesServiceClient) ValidateInstalledPackageValues(ctx context.Context, req *connect_go.Request[v1alpha1.ValidateInstalledPackageValuesRequest]) (*connect_go.Response[v1alpha1.ValidateInstalledPackageValuesResponse], error) {
+	return c.validateInstalledPackageValues.CallUnary(ctx, req)
+}
+
+// BulkUpdateInstalledPackages calls
+// kubeappsapis.core.packages.v1alpha1.PackagesService.BulkUpdateInstalledPackages.
+func (c *packagesServiceClient) BulkUpdateInstalledPackages(ctx context.Context, req *connect_go.Request[v1alpha1.BulkUpdateInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.BulkUpdateInstalledPackagesResponse], error) {
+	return c.bulkUpdateInstalledPackages.CallUnary(ctx, req)
+}
+
+// ExportInstalledPackages calls
+// kubeappsapis.core.packages.v1alpha1.PackagesService.ExportInstalledPackages.
+func (c *packagesServiceClient) ExportInstalledPackages(ctx context.Context, req *connect_go.Request[v1alpha1.ExportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ExportInstalledPackagesResponse], error) {
+	return c.exportInstalledPackages.CallUnary(ctx, req)
+}
+
+// ImportInstalledPackages calls
+// kubeappsapis.core.packages.v1alpha1.PackagesService.ImportInstalledPackages.
+func (c *packagesServiceClient) ImportInstalledPackages(ctx context.Context, req *connect_go.Request[v1alpha1.ImportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ImportInstalledPackagesResponse], error) {
+	return c.importInstalledPackages.CallUnary(ctx, req)
+}
+
 // DeleteInstalledPackage calls
 // kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage.
 func (c *packagesServiceClient) DeleteInstalledPackage(ctx context.Context, req *connect_go.Request[v1alpha1.DeleteInstalledPackageRequest]) (*connect_go.Response[v1alpha1.DeleteInstalledPackageResponse], error) {
@@ -221,6 +295,12 @@ func (c *packagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Cont
 	return c.getInstalledPackageResourceRefs.CallUnary(ctx, req)
 }
 
+// WatchInstalledPackages calls
+// kubeappsapis.core.packages.v1alpha1.PackagesService.WatchInstalledPackages.
+func (c *packagesServiceClient) WatchInstalledPackages(ctx context.Context, req *connect_go.Request[v1alpha1.WatchInstalledPackagesRequest]) (*connect_go.ServerStreamForClient[v1alpha1.WatchInstalledPackagesResponse], error) {
+	return c.watchInstalledPackages.CallServerStream(ctx, req)
+}
+
 // PackagesServiceHandler is an implementation of the
 // kubeappsapis.core.packages.v1alpha1.PackagesService service.
 type PackagesServiceHandler interface {
@@ -232,8 +312,13 @@ type PackagesServiceHandler interface {
 	GetInstalledPackageDetail(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageDetailRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageDetailResponse], error)
 	CreateInstalledPackage(context.Context, *connect_go.Request[v1alpha1.CreateInstalledPackageRequest]) (*connect_go.Response[v1alpha1.CreateInstalledPackageResponse], error)
 	UpdateInstalledPackage(context.Context, *connect_go.Request[v1alpha1.UpdateInstalledPackageRequest]) (*connect_go.Response[v1alpha1.UpdateInstalledPackageResponse], error)
+	ValidateInstalledPackageValues(context.Context, *connect_go.Request[v1alpha1.ValidateInstalledPackageValuesRequest]) (*connect_go.Response[v1alpha1.ValidateInstalledPackageValuesResponse], error)
+	BulkUpdateInstalledPackages(context.Context, *connect_go.Request[v1alpha1.BulkUpdateInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.BulkUpdateInstalledPackagesResponse], error)
+	ExportInstalledPackages(context.Context, *connect_go.Request[v1alpha1.ExportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ExportInstalledPackagesResponse], error)
+	ImportInstalledPackages(context.Context, *connect_go.Request[v1alpha1.ImportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ImportInstalledPackagesResponse], error)
 	DeleteInstalledPackage(context.Context, *connect_go.Request[v1alpha1.DeleteInstalledPackageRequest]) (*connect_go.Response[v1alpha1.DeleteInstalledPackageResponse], error)
 	GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error)
+	WatchInstalledPackages(context.Context, *connect_go.Request[v1alpha1.WatchInstalledPackagesRequest], *connect_go.ServerStream[v1alpha1.WatchInstalledPackagesResponse]) error
 }
 
 // NewPackagesServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -282,6 +367,26 @@ func NewPackagesServiceHandler(svc PackagesServiceHandler, opts ...connect_go.Ha
 		svc.UpdateInstalledPackage,
 		opts...,
 	)
+	packagesServiceValidateInstalledPackageValuesHandler := connect_go.NewUnaryHandler(
+		PackagesServiceValidateInstalledPackageValuesProcedure,
+		svc.ValidateInstalledPackageValues,
+		opts...,
+	)
+	packagesServiceBulkUpdateInstalledPackagesHandler := connect_go.NewUnaryHandler(
+		PackagesServiceBulkUpdateInstalledPackagesProcedure,
+		svc.BulkUpdateInstalledPackages,
+		opts...,
+	)
+	packagesServiceExportInstalledPackagesHandler := connect_go.NewUnaryHandler(
+		PackagesServiceExportInstalledPackagesProcedure,
+		svc.ExportInstalledPackages,
+		opts...,
+	)
+	packagesServiceImportInstalledPackagesHandler := connect_go.NewUnaryHandler(
+		PackagesServiceImportInstalledPackagesProcedure,
+		svc.ImportInstalledPackages,
+		opts...,
+	)
 	packagesServiceDeleteInstalledPackageHandler := connect_go.NewUnaryHandler(
 		PackagesServiceDeleteInstalledPackageProcedure,
 		svc.DeleteInstalledPackage,
@@ -292,6 +397,11 @@ func NewPackagesServiceHandler(svc PackagesServiceHandler, opts ...connect_go.Ha
 		svc.GetInstalledPackageResourceRefs,
 		opts...,
 	)
+	packagesServiceWatchInstalledPackagesHandler := connect_go.NewServerStreamHandler(
+		PackagesServiceWatchInstalledPackagesProcedure,
+		svc.WatchInstalledPackages,
+		opts...,
+	)
 	return "/kubeappsapis.core.packages.v1alpha1.PackagesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PackagesServiceGetAvailablePackageSummariesProcedure:
@@ -310,10 +420,20 @@ func NewPackagesServiceHandler(svc PackagesServiceHandler, opts ...connect_go.Ha
 			packagesServiceCreateInstalledPackageHandler.ServeHTTP(w, r)
 		case PackagesServiceUpdateInstalledPackageProcedure:
 			packagesServiceUpdateInstalledPackageHandler.ServeHTTP(w, r)
+		case PackagesServiceValidateInstalledPackageValuesProcedure:
+			packagesServiceValidateInstalledPackageValuesHandler.ServeHTTP(w, r)
+		case PackagesServiceBulkUpdateInstalledPackagesProcedure:
+			packagesServiceBulkUpdateInstalledPackagesHandler.ServeHTTP(w, r)
+		case PackagesServiceExportInstalledPackagesProcedure:
+			packagesServiceExportInstalledPackagesHandler.ServeHTTP(w, r)
+		case PackagesServiceImportInstalledPackagesProcedure:
+			packagesServiceImportInstalledPackagesHandler.ServeHTTP(w, r)
 		case PackagesServiceDeleteInstalledPackageProcedure:
 			packagesServiceDeleteInstalledPackageHandler.ServeHTTP(w, r)
 		case PackagesServiceGetInstalledPackageResourceRefsProcedure:
 			packagesServiceGetInstalledPackageResourceRefsHandler.ServeHTTP(w, r)
+		case PackagesServiceWatchInstalledPackagesProcedure:
+			packagesServiceWatchInstalledPackagesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -355,6 +475,22 @@ func (UnimplementedPackagesServiceHandler) UpdateInstalledPackage(context.Contex
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.UpdateInstalledPackage is not implemented"))
 }
 
+func (UnimplementedPackagesServiceHandler) ValidateInstalledPackageValues(context.Context, *connect_go.Request[v1alpha1.ValidateInstalledPackageValuesRequest]) (*connect_go.Response[v1alpha1.ValidateInstalledPackageValuesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.ValidateInstalledPackageValues is not implemented"))
+}
+
+func (UnimplementedPackagesServiceHandler) BulkUpdateInstalledPackages(context.Context, *connect_go.Request[v1alpha1.BulkUpdateInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.BulkUpdateInstalledPackagesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.BulkUpdateInstalledPackages is not implemented"))
+}
+
+func (UnimplementedPackagesServiceHandler) ExportInstalledPackages(context.Context, *connect_go.Request[v1alpha1.ExportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ExportInstalledPackagesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.ExportInstalledPackages is not implemented"))
+}
+
+func (UnimplementedPackagesServiceHandler) ImportInstalledPackages(context.Context, *connect_go.Request[v1alpha1.ImportInstalledPackagesRequest]) (*connect_go.Response[v1alpha1.ImportInstalledPackagesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.ImportInstalledPackages is not implemented"))
+}
+
 func (UnimplementedPackagesServiceHandler) DeleteInstalledPackage(context.Context, *connect_go.Request[v1alpha1.DeleteInstalledPackageRequest]) (*connect_go.Response[v1alpha1.DeleteInstalledPackageResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage is not implemented"))
 }
@@ -362,3 +498,7 @@ func (UnimplementedPackagesServiceHandler) DeleteInstalledPackage(context.Contex
 func (UnimplementedPackagesServiceHandler) GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageResourceRefs is not implemented"))
 }
+
+func (UnimplementedPackagesServiceHandler) WatchInstalledPackages(context.Context, *connect_go.Request[v1alpha1.WatchInstalledPackagesRequest], *connect_go.ServerStream[v1alpha1.WatchInstalledPackagesResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.core.packages.v1alpha1.PackagesService.WatchInstalledPackages is not implemented"))
+}
@@ -32,6 +32,10 @@ const (
 	FluxV2PackagesService_UpdateInstalledPackage_FullMethodName          = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/UpdateInstalledPackage"
 	FluxV2PackagesService_DeleteInstalledPackage_FullMethodName          = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/DeleteInstalledPackage"
 	FluxV2PackagesService_GetInstalledPackageResourceRefs_FullMethodName = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageResourceRefs"
+	FluxV2PackagesService_ReconcileInstalledPackage_FullMethodName       = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ReconcileInstalledPackage"
+	FluxV2PackagesService_SuspendInstalledPackage_FullMethodName         = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/SuspendInstalledPackage"
+	FluxV2PackagesService_ResumeInstalledPackage_FullMethodName          = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ResumeInstalledPackage"
+	FluxV2PackagesService_GetInstalledPackageHistory_FullMethodName      = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageHistory"
 )
 
 // FluxV2PackagesServiceClient is the client API for FluxV2PackagesService service.
@@ -57,6 +61,17 @@ type FluxV2PackagesServiceClient interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// ReconcileInstalledPackage requests an immediate reconciliation of an installed
+	// package by flux, optionally waiting until it has been handled.
+	ReconcileInstalledPackage(ctx context.Context, in *ReconcileInstalledPackageRequest, opts ...grpc.CallOption) (*ReconcileInstalledPackageResponse, error)
+	// SuspendInstalledPackage suspends the reconciliation of an installed package
+	// by flux, leaving the rest of its spec intact.
+	SuspendInstalledPackage(ctx context.Context, in *SuspendInstalledPackageRequest, opts ...grpc.CallOption) (*SuspendInstalledPackageResponse, error)
+	// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+	ResumeInstalledPackage(ctx context.Context, in *ResumeInstalledPackageRequest, opts ...grpc.CallOption) (*ResumeInstalledPackageResponse, error)
+	// GetInstalledPackageHistory returns the reconciliation history recorded by flux
+	// in the status of a HelmRelease, together with the related kubernetes events.
+	GetInstalledPackageHistory(ctx context.Context, in *GetInstalledPackageHistoryRequest, opts ...grpc.CallOption) (*GetInstalledPackageHistoryResponse, error)
 }
 
 type fluxV2PackagesServiceClient struct {
@@ -148,6 +163,42 @@ func (c *fluxV2PackagesServiceClient) GetInstalledPackageResourceRefs(ctx contex
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) ReconcileInstalledPackage(ctx context.Context, in *ReconcileInstalledPackageRequest, opts ...grpc.CallOption) (*ReconcileInstalledPackageResponse, error) {
+	out := new(ReconcileInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, FluxV2PackagesService_ReconcileInstalledPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) SuspendInstalledPackage(ctx context.Context, in *SuspendInstalledPackageRequest, opts ...grpc.CallOption) (*SuspendInstalledPackageResponse, error) {
+	out := new(SuspendInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, FluxV2PackagesService_SuspendInstalledPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) ResumeInstalledPackage(ctx context.Context, in *ResumeInstalledPackageRequest, opts ...grpc.CallOption) (*ResumeInstalledPackageResponse, error) {
+	out := new(ResumeInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, FluxV2PackagesService_ResumeInstalledPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageHistory(ctx context.Context, in *GetInstalledPackageHistoryRequest, opts ...grpc.CallOption) (*GetInstalledPackageHistoryResponse, error) {
+	out := new(GetInstalledPackageHistoryResponse)
+	err := c.cc.Invoke(ctx, FluxV2PackagesService_GetInstalledPackageHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FluxV2PackagesServiceServer is the server API for FluxV2PackagesService service.
 // All implementations should embed UnimplementedFluxV2PackagesServiceServer
 // for forward compatibility
@@ -171,6 +222,17 @@ type FluxV2PackagesServiceServer interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// ReconcileInstalledPackage requests an immediate reconciliation of an installed
+	// package by flux, optionally waiting until it has been handled.
+	ReconcileInstalledPackage(context.Context, *ReconcileInstalledPackageRequest) (*ReconcileInstalledPackageResponse, error)
+	// SuspendInstalledPackage suspends the reconciliation of an installed package
+	// by flux, leaving the rest of its spec intact.
+	SuspendInstalledPackage(context.Context, *SuspendInstalledPackageRequest) (*SuspendInstalledPackageResponse, error)
+	// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+	ResumeInstalledPackage(context.Context, *ResumeInstalledPackageRequest) (*ResumeInstalledPackageResponse, error)
+	// GetInstalledPackageHistory returns the reconciliation history recorded by flux
+	// in the status of a HelmRelease, together with the related kubernetes events.
+	GetInstalledPackageHistory(context.Context, *GetInstalledPackageHistoryRequest) (*GetInstalledPackageHistoryResponse, error)
 }
 
 // UnimplementedFluxV2PackagesServiceServer should be embedded to have forward compatible implementations.
@@ -204,6 +266,18 @@ func (UnimplementedFluxV2PackagesServiceServer) DeleteInstalledPackage(context.C
 func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) ReconcileInstalledPackage(context.Context, *ReconcileInstalledPackageRequest) (*ReconcileInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) SuspendInstalledPackage(context.Context, *SuspendInstalledPackageRequest) (*SuspendInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) ResumeInstalledPackage(context.Context, *ResumeInstalledPackageRequest) (*ResumeInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageHistory(context.Context, *GetInstalledPackageHistoryRequest) (*GetInstalledPackageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageHistory not implemented")
+}
 
 // UnsafeFluxV2PackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FluxV2PackagesServiceServer will
@@ -378,6 +452,78 @@ func _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_ReconcileInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).ReconcileInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FluxV2PackagesService_ReconcileInstalledPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).ReconcileInstalledPackage(ctx, req.(*ReconcileInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_SuspendInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).SuspendInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FluxV2PackagesService_SuspendInstalledPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).SuspendInstalledPackage(ctx, req.(*SuspendInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_ResumeInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).ResumeInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FluxV2PackagesService_ResumeInstalledPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).ResumeInstalledPackage(ctx, req.(*ResumeInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetInstalledPackageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FluxV2PackagesService_GetInstalledPackageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageHistory(ctx, req.(*GetInstalledPackageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FluxV2PackagesService_ServiceDesc is the grpc.ServiceDesc for FluxV2PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -421,6 +567,22 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
+		{
+			MethodName: "ReconcileInstalledPackage",
+			Handler:    _FluxV2PackagesService_ReconcileInstalledPackage_Handler,
+		},
+		{
+			MethodName: "SuspendInstalledPackage",
+			Handler:    _FluxV2PackagesService_SuspendInstalledPackage_Handler,
+		},
+		{
+			MethodName: "ResumeInstalledPackage",
+			Handler:    _FluxV2PackagesService_ResumeInstalledPackage_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageHistory",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/fluxv2/packages/v1alpha1/fluxv2.proto",
@@ -433,6 +595,9 @@ const (
 	FluxV2RepositoriesService_UpdatePackageRepository_FullMethodName         = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/UpdatePackageRepository"
 	FluxV2RepositoriesService_DeletePackageRepository_FullMethodName         = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/DeletePackageRepository"
 	FluxV2RepositoriesService_GetPackageRepositoryPermissions_FullMethodName = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/GetPackageRepositoryPermissions"
+	FluxV2RepositoriesService_ReconcilePackageRepository_FullMethodName      = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ReconcilePackageRepository"
+	FluxV2RepositoriesService_SuspendPackageRepository_FullMethodName        = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/SuspendPackageRepository"
+	FluxV2RepositoriesService_ResumePackageRepository_FullMethodName         = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ResumePackageRepository"
 )
 
 // FluxV2RepositoriesServiceClient is the client API for FluxV2RepositoriesService service.
@@ -447,6 +612,13 @@ type FluxV2RepositoriesServiceClient interface {
 	UpdatePackageRepository(ctx context.Context, in *v1alpha1.UpdatePackageRepositoryRequest, opts ...grpc.CallOption) (*v1alpha1.UpdatePackageRepositoryResponse, error)
 	DeletePackageRepository(ctx context.Context, in *v1alpha1.DeletePackageRepositoryRequest, opts ...grpc.CallOption) (*v1alpha1.DeletePackageRepositoryResponse, error)
 	GetPackageRepositoryPermissions(ctx context.Context, in *v1alpha1.GetPackageRepositoryPermissionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetPackageRepositoryPermissionsResponse, error)
+	// ReconcilePackageRepository requests an immediate reconciliation of a package
+	// repository by flux, optionally waiting until it has been handled.
+	ReconcilePackageRepository(ctx context.Context, in *ReconcilePackageRepositoryRequest, opts ...grpc.CallOption) (*ReconcilePackageRepositoryResponse, error)
+	// SuspendPackageRepository suspends the reconciliation of a package repository by flux.
+	SuspendPackageRepository(ctx context.Context, in *SuspendPackageRepositoryRequest, opts ...grpc.CallOption) (*SuspendPackageRepositoryResponse, error)
+	// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+	ResumePackageRepository(ctx context.Context, in *ResumePackageRepositoryRequest, opts ...grpc.CallOption) (*ResumePackageRepositoryResponse, error)
 }
 
 type fluxV2RepositoriesServiceClient struct {
@@ -511,6 +683,33 @@ func (c *fluxV2RepositoriesServiceClient) GetPackageRepositoryPermissions(ctx co
 	return out, nil
 }
 
+func (c *fluxV2RepositoriesServiceClient) ReconcilePackageRepository(ctx context.Context, in *ReconcilePackageRepositoryRequest, opts ...grpc.CallOption) (*ReconcilePackageRepositoryResponse, error) {
+	out := new(ReconcilePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, FluxV2RepositoriesService_ReconcilePackageRepository_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2RepositoriesServiceClient) SuspendPackageRepository(ctx context.Context, in *SuspendPackageRepositoryRequest, opts ...grpc.CallOption) (*SuspendPackageRepositoryResponse, error) {
+	out := new(SuspendPackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, FluxV2RepositoriesService_SuspendPackageRepository_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2RepositoriesServiceClient) ResumePackageRepository(ctx context.Context, in *ResumePackageRepositoryRequest, opts ...grpc.CallOption) (*ResumePackageRepositoryResponse, error) {
+	out := new(ResumePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, FluxV2RepositoriesService_ResumePackageRepository_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FluxV2RepositoriesServiceServer is the server API for FluxV2RepositoriesService service.
 // All implementations should embed UnimplementedFluxV2RepositoriesServiceServer
 // for forward compatibility
@@ -523,6 +722,13 @@ type FluxV2RepositoriesServiceServer interface {
 	UpdatePackageRepository(context.Context, *v1alpha1.UpdatePackageRepositoryRequest) (*v1alpha1.UpdatePackageRepositoryResponse, error)
 	DeletePackageRepository(context.Context, *v1alpha1.DeletePackageRepositoryRequest) (*v1alpha1.DeletePackageRepositoryResponse, error)
 	GetPackageRepositoryPermissions(context.Context, *v1alpha1.GetPackageRepositoryPermissionsRequest) (*v1alpha1.GetPackageRepositoryPermissionsResponse, error)
+	// ReconcilePackageRepository requests an immediate reconciliation of a package
+	// repository by flux, optionally waiting until it has been handled.
+	ReconcilePackageRepository(context.Context, *ReconcilePackageRepositoryRequest) (*ReconcilePackageRepositoryResponse, error)
+	// SuspendPackageRepository suspends the reconciliation of a package repository by flux.
+	SuspendPackageRepository(context.Context, *SuspendPackageRepositoryRequest) (*SuspendPackageRepositoryResponse, error)
+	// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+	ResumePackageRepository(context.Context, *ResumePackageRepositoryRequest) (*ResumePackageRepositoryResponse, error)
 }
 
 // UnimplementedFluxV2RepositoriesServiceServer should be embedded to have forward compatible implementations.
@@ -547,6 +753,15 @@ func (UnimplementedFluxV2RepositoriesServiceServer) DeletePackageRepository(cont
 func (UnimplementedFluxV2RepositoriesServiceServer) GetPackageRepositoryPermissions(context.Context, *v1alpha1.GetPackageRepositoryPermissionsRequest) (*v1alpha1.GetPackageRepositoryPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPackageRepositoryPermissions not implemented")
 }
+func (UnimplementedFluxV2RepositoriesServiceServer) ReconcilePackageRepository(context.Context, *ReconcilePackageRepositoryRequest) (*ReconcilePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcilePackageRepository not implemented")
+}
+func (UnimplementedFluxV2RepositoriesServiceServer) SuspendPackageRepository(context.Context, *SuspendPackageRepositoryRequest) (*SuspendPackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendPackageRepository not implemented")
+}
+func (UnimplementedFluxV2RepositoriesServiceServer) ResumePackageRepository(context.Context, *ResumePackageRepositoryRequest) (*ResumePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePackageRepository not implemented")
+}
 
 // UnsafeFluxV2RepositoriesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FluxV2RepositoriesServiceServer will
@@ -667,6 +882,60 @@ func _FluxV2RepositoriesService_GetPackageRepositoryPermissions_Handler(srv inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2RepositoriesService_ReconcilePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcilePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2RepositoriesServiceServer).ReconcilePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FluxV2RepositoriesService_ReconcilePackageRepository_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2RepositoriesServiceServer).ReconcilePackageRepository(ctx, req.(*ReconcilePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2RepositoriesService_SuspendPackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendPackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2RepositoriesServiceServer).SuspendPackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FluxV2RepositoriesService_SuspendPackageRepository_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2RepositoriesServiceServer).SuspendPackageRepository(ctx, req.(*SuspendPackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2RepositoriesService_ResumePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2RepositoriesServiceServer).ResumePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FluxV2RepositoriesService_ResumePackageRepository_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2RepositoriesServiceServer).ResumePackageRepository(ctx, req.(*ResumePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FluxV2RepositoriesService_ServiceDesc is the grpc.ServiceDesc for FluxV2RepositoriesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -698,6 +967,18 @@ var FluxV2RepositoriesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPackageRepositoryPermissions",
 			Handler:    _FluxV2RepositoriesService_GetPackageRepositoryPermissions_Handler,
 		},
+		{
+			MethodName: "ReconcilePackageRepository",
+			Handler:    _FluxV2RepositoriesService_ReconcilePackageRepository_Handler,
+		},
+		{
+			MethodName: "SuspendPackageRepository",
+			Handler:    _FluxV2RepositoriesService_SuspendPackageRepository_Handler,
+		},
+		{
+			MethodName: "ResumePackageRepository",
+			Handler:    _FluxV2RepositoriesService_ResumePackageRepository_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/fluxv2/packages/v1alpha1/fluxv2.proto",
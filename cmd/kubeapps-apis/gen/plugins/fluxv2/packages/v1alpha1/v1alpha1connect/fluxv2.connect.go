@@ -12,7 +12,7 @@ import (
 	errors "errors"
 	connect_go "github.com/bufbuild/connect-go"
 	v1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
-	_ "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	v1alpha11 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
 	http "net/http"
 	strings "strings"
 )
@@ -67,6 +67,18 @@ const (
 	// FluxV2PackagesServiceGetInstalledPackageResourceRefsProcedure is the fully-qualified name of the
 	// FluxV2PackagesService's GetInstalledPackageResourceRefs RPC.
 	FluxV2PackagesServiceGetInstalledPackageResourceRefsProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageResourceRefs"
+	// FluxV2PackagesServiceReconcileInstalledPackageProcedure is the fully-qualified name of the
+	// FluxV2PackagesService's ReconcileInstalledPackage RPC.
+	FluxV2PackagesServiceReconcileInstalledPackageProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ReconcileInstalledPackage"
+	// FluxV2PackagesServiceSuspendInstalledPackageProcedure is the fully-qualified name of the
+	// FluxV2PackagesService's SuspendInstalledPackage RPC.
+	FluxV2PackagesServiceSuspendInstalledPackageProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/SuspendInstalledPackage"
+	// FluxV2PackagesServiceResumeInstalledPackageProcedure is the fully-qualified name of the
+	// FluxV2PackagesService's ResumeInstalledPackage RPC.
+	FluxV2PackagesServiceResumeInstalledPackageProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ResumeInstalledPackage"
+	// FluxV2PackagesServiceGetInstalledPackageHistoryProcedure is the fully-qualified name of the
+	// FluxV2PackagesService's GetInstalledPackageHistory RPC.
+	FluxV2PackagesServiceGetInstalledPackageHistoryProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageHistory"
 	// FluxV2RepositoriesServiceAddPackageRepositoryProcedure is the fully-qualified name of the
 	// FluxV2RepositoriesService's AddPackageRepository RPC.
 	FluxV2RepositoriesServiceAddPackageRepositoryProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/AddPackageRepository"
@@ -85,6 +97,15 @@ const (
 	// FluxV2RepositoriesServiceGetPackageRepositoryPermissionsProcedure is the fully-qualified name of
 	// the FluxV2RepositoriesService's GetPackageRepositoryPermissions RPC.
 	FluxV2RepositoriesServiceGetPackageRepositoryPermissionsProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/GetPackageRepositoryPermissions"
+	// FluxV2RepositoriesServiceReconcilePackageRepositoryProcedure is the fully-qualified name of the
+	// FluxV2RepositoriesService's ReconcilePackageRepository RPC.
+	FluxV2RepositoriesServiceReconcilePackageRepositoryProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ReconcilePackageRepository"
+	// FluxV2RepositoriesServiceSuspendPackageRepositoryProcedure is the fully-qualified name of the
+	// FluxV2RepositoriesService's SuspendPackageRepository RPC.
+	FluxV2RepositoriesServiceSuspendPackageRepositoryProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/SuspendPackageRepository"
+	// FluxV2RepositoriesServiceResumePackageRepositoryProcedure is the fully-qualified name of the
+	// FluxV2RepositoriesService's ResumePackageRepository RPC.
+	FluxV2RepositoriesServiceResumePackageRepositoryProcedure = "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ResumePackageRepository"
 )
 
 // FluxV2PackagesServiceClient is a client for the
@@ -109,6 +130,17 @@ type FluxV2PackagesServiceClient interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error)
+	// ReconcileInstalledPackage requests an immediate reconciliation of an installed
+	// package by flux, optionally waiting until it has been handled.
+	ReconcileInstalledPackage(context.Context, *connect_go.Request[v1alpha11.ReconcileInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ReconcileInstalledPackageResponse], error)
+	// SuspendInstalledPackage suspends the reconciliation of an installed package
+	// by flux, leaving the rest of its spec intact.
+	SuspendInstalledPackage(context.Context, *connect_go.Request[v1alpha11.SuspendInstalledPackageRequest]) (*connect_go.Response[v1alpha11.SuspendInstalledPackageResponse], error)
+	// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+	ResumeInstalledPackage(context.Context, *connect_go.Request[v1alpha11.ResumeInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ResumeInstalledPackageResponse], error)
+	// GetInstalledPackageHistory returns the reconciliation history recorded by flux
+	// in the status of a HelmRelease, together with the related kubernetes events.
+	GetInstalledPackageHistory(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageHistoryRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageHistoryResponse], error)
 }
 
 // NewFluxV2PackagesServiceClient constructs a client for the
@@ -167,6 +199,26 @@ func NewFluxV2PackagesServiceClient(httpClient connect_go.HTTPClient, baseURL st
 			baseURL+FluxV2PackagesServiceGetInstalledPackageResourceRefsProcedure,
 			opts...,
 		),
+		reconcileInstalledPackage: connect_go.NewClient[v1alpha11.ReconcileInstalledPackageRequest, v1alpha11.ReconcileInstalledPackageResponse](
+			httpClient,
+			baseURL+FluxV2PackagesServiceReconcileInstalledPackageProcedure,
+			opts...,
+		),
+		suspendInstalledPackage: connect_go.NewClient[v1alpha11.SuspendInstalledPackageRequest, v1alpha11.SuspendInstalledPackageResponse](
+			httpClient,
+			baseURL+FluxV2PackagesServiceSuspendInstalledPackageProcedure,
+			opts...,
+		),
+		resumeInstalledPackage: connect_go.NewClient[v1alpha11.ResumeInstalledPackageRequest, v1alpha11.ResumeInstalledPackageResponse](
+			httpClient,
+			baseURL+FluxV2PackagesServiceResumeInstalledPackageProcedure,
+			opts...,
+		),
+		getInstalledPackageHistory: connect_go.NewClient[v1alpha11.GetInstalledPackageHistoryRequest, v1alpha11.GetInstalledPackageHistoryResponse](
+			httpClient,
+			baseURL+FluxV2PackagesServiceGetInstalledPackageHistoryProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -181,6 +233,10 @@ type fluxV2PackagesServiceClient struct {
 	updateInstalledPackage          *connect_go.Client[v1alpha1.UpdateInstalledPackageRequest, v1alpha1.UpdateInstalledPackageResponse]
 	deleteInstalledPackage          *connect_go.Client[v1alpha1.DeleteInstalledPackageRequest, v1alpha1.DeleteInstalledPackageResponse]
 	getInstalledPackageResourceRefs *connect_go.Client[v1alpha1.GetInstalledPackageResourceRefsRequest, v1alpha1.GetInstalledPackageResourceRefsResponse]
+	reconcileInstalledPackage       *connect_go.Client[v1alpha11.ReconcileInstalledPackageRequest, v1alpha11.ReconcileInstalledPackageResponse]
+	suspendInstalledPackage         *connect_go.Client[v1alpha11.SuspendInstalledPackageRequest, v1alpha11.SuspendInstalledPackageResponse]
+	resumeInstalledPackage          *connect_go.Client[v1alpha11.ResumeInstalledPackageRequest, v1alpha11.ResumeInstalledPackageResponse]
+	getInstalledPackageHistory      *connect_go.Client[v1alpha11.GetInstalledPackageHistoryRequest, v1alpha11.GetInstalledPackageHistoryResponse]
 }
 
 // GetAvailablePackageSummaries calls
@@ -237,6 +293,30 @@ func (c *fluxV2PackagesServiceClient) GetInstalledPackageResourceRefs(ctx contex
 	return c.getInstalledPackageResourceRefs.CallUnary(ctx, req)
 }
 
+// ReconcileInstalledPackage calls
+// kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.ReconcileInstalledPackage.
+func (c *fluxV2PackagesServiceClient) ReconcileInstalledPackage(ctx context.Context, req *connect_go.Request[v1alpha11.ReconcileInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ReconcileInstalledPackageResponse], error) {
+	return c.reconcileInstalledPackage.CallUnary(ctx, req)
+}
+
+// SuspendInstalledPackage calls
+// kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.SuspendInstalledPackage.
+func (c *fluxV2PackagesServiceClient) SuspendInstalledPackage(ctx context.Context, req *connect_go.Request[v1alpha11.SuspendInstalledPackageRequest]) (*connect_go.Response[v1alpha11.SuspendInstalledPackageResponse], error) {
+	return c.suspendInstalledPackage.CallUnary(ctx, req)
+}
+
+// ResumeInstalledPackage calls
+// kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.ResumeInstalledPackage.
+func (c *fluxV2PackagesServiceClient) ResumeInstalledPackage(ctx context.Context, req *connect_go.Request[v1alpha11.ResumeInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ResumeInstalledPackageResponse], error) {
+	return c.resumeInstalledPackage.CallUnary(ctx, req)
+}
+
+// GetInstalledPackageHistory calls
+// kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.GetInstalledPackageHistory.
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageHistory(ctx context.Context, req *connect_go.Request[v1alpha11.GetInstalledPackageHistoryRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageHistoryResponse], error) {
+	return c.getInstalledPackageHistory.CallUnary(ctx, req)
+}
+
 // FluxV2PackagesServiceHandler is an implementation of the
 // kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService service.
 type FluxV2PackagesServiceHandler interface {
@@ -259,6 +339,17 @@ type FluxV2PackagesServiceHandler interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error)
+	// ReconcileInstalledPackage requests an immediate reconciliation of an installed
+	// package by flux, optionally waiting until it has been handled.
+	ReconcileInstalledPackage(context.Context, *connect_go.Request[v1alpha11.ReconcileInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ReconcileInstalledPackageResponse], error)
+	// SuspendInstalledPackage suspends the reconciliation of an installed package
+	// by flux, leaving the rest of its spec intact.
+	SuspendInstalledPackage(context.Context, *connect_go.Request[v1alpha11.SuspendInstalledPackageRequest]) (*connect_go.Response[v1alpha11.SuspendInstalledPackageResponse], error)
+	// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+	ResumeInstalledPackage(context.Context, *connect_go.Request[v1alpha11.ResumeInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ResumeInstalledPackageResponse], error)
+	// GetInstalledPackageHistory returns the reconciliation history recorded by flux
+	// in the status of a HelmRelease, together with the related kubernetes events.
+	GetInstalledPackageHistory(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageHistoryRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageHistoryResponse], error)
 }
 
 // NewFluxV2PackagesServiceHandler builds an HTTP handler from the service implementation. It
@@ -312,6 +403,26 @@ func NewFluxV2PackagesServiceHandler(svc FluxV2PackagesServiceHandler, opts ...c
 		svc.GetInstalledPackageResourceRefs,
 		opts...,
 	)
+	fluxV2PackagesServiceReconcileInstalledPackageHandler := connect_go.NewUnaryHandler(
+		FluxV2PackagesServiceReconcileInstalledPackageProcedure,
+		svc.ReconcileInstalledPackage,
+		opts...,
+	)
+	fluxV2PackagesServiceSuspendInstalledPackageHandler := connect_go.NewUnaryHandler(
+		FluxV2PackagesServiceSuspendInstalledPackageProcedure,
+		svc.SuspendInstalledPackage,
+		opts...,
+	)
+	fluxV2PackagesServiceResumeInstalledPackageHandler := connect_go.NewUnaryHandler(
+		FluxV2PackagesServiceResumeInstalledPackageProcedure,
+		svc.ResumeInstalledPackage,
+		opts...,
+	)
+	fluxV2PackagesServiceGetInstalledPackageHistoryHandler := connect_go.NewUnaryHandler(
+		FluxV2PackagesServiceGetInstalledPackageHistoryProcedure,
+		svc.GetInstalledPackageHistory,
+		opts...,
+	)
 	return "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FluxV2PackagesServiceGetAvailablePackageSummariesProcedure:
@@ -332,6 +443,14 @@ func NewFluxV2PackagesServiceHandler(svc FluxV2PackagesServiceHandler, opts ...c
 			fluxV2PackagesServiceDeleteInstalledPackageHandler.ServeHTTP(w, r)
 		case FluxV2PackagesServiceGetInstalledPackageResourceRefsProcedure:
 			fluxV2PackagesServiceGetInstalledPackageResourceRefsHandler.ServeHTTP(w, r)
+		case FluxV2PackagesServiceReconcileInstalledPackageProcedure:
+			fluxV2PackagesServiceReconcileInstalledPackageHandler.ServeHTTP(w, r)
+		case FluxV2PackagesServiceSuspendInstalledPackageProcedure:
+			fluxV2PackagesServiceSuspendInstalledPackageHandler.ServeHTTP(w, r)
+		case FluxV2PackagesServiceResumeInstalledPackageProcedure:
+			fluxV2PackagesServiceResumeInstalledPackageHandler.ServeHTTP(w, r)
+		case FluxV2PackagesServiceGetInstalledPackageHistoryProcedure:
+			fluxV2PackagesServiceGetInstalledPackageHistoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -377,6 +496,22 @@ func (UnimplementedFluxV2PackagesServiceHandler) GetInstalledPackageResourceRefs
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.GetInstalledPackageResourceRefs is not implemented"))
 }
 
+func (UnimplementedFluxV2PackagesServiceHandler) ReconcileInstalledPackage(context.Context, *connect_go.Request[v1alpha11.ReconcileInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ReconcileInstalledPackageResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.ReconcileInstalledPackage is not implemented"))
+}
+
+func (UnimplementedFluxV2PackagesServiceHandler) SuspendInstalledPackage(context.Context, *connect_go.Request[v1alpha11.SuspendInstalledPackageRequest]) (*connect_go.Response[v1alpha11.SuspendInstalledPackageResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.SuspendInstalledPackage is not implemented"))
+}
+
+func (UnimplementedFluxV2PackagesServiceHandler) ResumeInstalledPackage(context.Context, *connect_go.Request[v1alpha11.ResumeInstalledPackageRequest]) (*connect_go.Response[v1alpha11.ResumeInstalledPackageResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.ResumeInstalledPackage is not implemented"))
+}
+
+func (UnimplementedFluxV2PackagesServiceHandler) GetInstalledPackageHistory(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageHistoryRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageHistoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.GetInstalledPackageHistory is not implemented"))
+}
+
 // FluxV2RepositoriesServiceClient is a client for the
 // kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService service.
 type FluxV2RepositoriesServiceClient interface {
@@ -388,6 +523,13 @@ type FluxV2RepositoriesServiceClient interface {
 	UpdatePackageRepository(context.Context, *connect_go.Request[v1alpha1.UpdatePackageRepositoryRequest]) (*connect_go.Response[v1alpha1.UpdatePackageRepositoryResponse], error)
 	DeletePackageRepository(context.Context, *connect_go.Request[v1alpha1.DeletePackageRepositoryRequest]) (*connect_go.Response[v1alpha1.DeletePackageRepositoryResponse], error)
 	GetPackageRepositoryPermissions(context.Context, *connect_go.Request[v1alpha1.GetPackageRepositoryPermissionsRequest]) (*connect_go.Response[v1alpha1.GetPackageRepositoryPermissionsResponse], error)
+	// ReconcilePackageRepository requests an immediate reconciliation of a package
+	// repository by flux, optionally waiting until it has been handled.
+	ReconcilePackageRepository(context.Context, *connect_go.Request[v1alpha11.ReconcilePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ReconcilePackageRepositoryResponse], error)
+	// SuspendPackageRepository suspends the reconciliation of a package repository by flux.
+	SuspendPackageRepository(context.Context, *connect_go.Request[v1alpha11.SuspendPackageRepositoryRequest]) (*connect_go.Response[v1alpha11.SuspendPackageRepositoryResponse], error)
+	// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+	ResumePackageRepository(context.Context, *connect_go.Request[v1alpha11.ResumePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ResumePackageRepositoryResponse], error)
 }
 
 // NewFluxV2RepositoriesServiceClient constructs a client for the
@@ -431,6 +573,21 @@ func NewFluxV2RepositoriesServiceClient(httpClient connect_go.HTTPClient, baseUR
 			baseURL+FluxV2RepositoriesServiceGetPackageRepositoryPermissionsProcedure,
 			opts...,
 		),
+		reconcilePackageRepository: connect_go.NewClient[v1alpha11.ReconcilePackageRepositoryRequest, v1alpha11.ReconcilePackageRepositoryResponse](
+			httpClient,
+			baseURL+FluxV2RepositoriesServiceReconcilePackageRepositoryProcedure,
+			opts...,
+		),
+		suspendPackageRepository: connect_go.NewClient[v1alpha11.SuspendPackageRepositoryRequest, v1alpha11.SuspendPackageRepositoryResponse](
+			httpClient,
+			baseURL+FluxV2RepositoriesServiceSuspendPackageRepositoryProcedure,
+			opts...,
+		),
+		resumePackageRepository: connect_go.NewClient[v1alpha11.ResumePackageRepositoryRequest, v1alpha11.ResumePackageRepositoryResponse](
+			httpClient,
+			baseURL+FluxV2RepositoriesServiceResumePackageRepositoryProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -442,6 +599,9 @@ type fluxV2RepositoriesServiceClient struct {
 	updatePackageRepository         *connect_go.Client[v1alpha1.UpdatePackageRepositoryRequest, v1alpha1.UpdatePackageRepositoryResponse]
 	deletePackageRepository         *connect_go.Client[v1alpha1.DeletePackageRepositoryRequest, v1alpha1.DeletePackageRepositoryResponse]
 	getPackageRepositoryPermissions *connect_go.Client[v1alpha1.GetPackageRepositoryPermissionsRequest, v1alpha1.GetPackageRepositoryPermissionsResponse]
+	reconcilePackageRepository      *connect_go.Client[v1alpha11.ReconcilePackageRepositoryRequest, v1alpha11.ReconcilePackageRepositoryResponse]
+	suspendPackageRepository        *connect_go.Client[v1alpha11.SuspendPackageRepositoryRequest, v1alpha11.SuspendPackageRepositoryResponse]
+	resumePackageRepository         *connect_go.Client[v1alpha11.ResumePackageRepositoryRequest, v1alpha11.ResumePackageRepositoryResponse]
 }
 
 // AddPackageRepository calls
@@ -480,6 +640,24 @@ func (c *fluxV2RepositoriesServiceClient) GetPackageRepositoryPermissions(ctx co
 	return c.getPackageRepositoryPermissions.CallUnary(ctx, req)
 }
 
+// ReconcilePackageRepository calls
+// kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.ReconcilePackageRepository.
+func (c *fluxV2RepositoriesServiceClient) ReconcilePackageRepository(ctx context.Context, req *connect_go.Request[v1alpha11.ReconcilePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ReconcilePackageRepositoryResponse], error) {
+	return c.reconcilePackageRepository.CallUnary(ctx, req)
+}
+
+// SuspendPackageRepository calls
+// kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.SuspendPackageRepository.
+func (c *fluxV2RepositoriesServiceClient) SuspendPackageRepository(ctx context.Context, req *connect_go.Request[v1alpha11.SuspendPackageRepositoryRequest]) (*connect_go.Response[v1alpha11.SuspendPackageRepositoryResponse], error) {
+	return c.suspendPackageRepository.CallUnary(ctx, req)
+}
+
+// ResumePackageRepository calls
+// kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.ResumePackageRepository.
+func (c *fluxV2RepositoriesServiceClient) ResumePackageRepository(ctx context.Context, req *connect_go.Request[v1alpha11.ResumePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ResumePackageRepositoryResponse], error) {
+	return c.resumePackageRepository.CallUnary(ctx, req)
+}
+
 // FluxV2RepositoriesServiceHandler is an implementation of the
 // kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService service.
 type FluxV2RepositoriesServiceHandler interface {
@@ -491,6 +669,13 @@ type FluxV2RepositoriesServiceHandler interface {
 	UpdatePackageRepository(context.Context, *connect_go.Request[v1alpha1.UpdatePackageRepositoryRequest]) (*connect_go.Response[v1alpha1.UpdatePackageRepositoryResponse], error)
 	DeletePackageRepository(context.Context, *connect_go.Request[v1alpha1.DeletePackageRepositoryRequest]) (*connect_go.Response[v1alpha1.DeletePackageRepositoryResponse], error)
 	GetPackageRepositoryPermissions(context.Context, *connect_go.Request[v1alpha1.GetPackageRepositoryPermissionsRequest]) (*connect_go.Response[v1alpha1.GetPackageRepositoryPermissionsResponse], error)
+	// ReconcilePackageRepository requests an immediate reconciliation of a package
+	// repository by flux, optionally waiting until it has been handled.
+	ReconcilePackageRepository(context.Context, *connect_go.Request[v1alpha11.ReconcilePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ReconcilePackageRepositoryResponse], error)
+	// SuspendPackageRepository suspends the reconciliation of a package repository by flux.
+	SuspendPackageRepository(context.Context, *connect_go.Request[v1alpha11.SuspendPackageRepositoryRequest]) (*connect_go.Response[v1alpha11.SuspendPackageRepositoryResponse], error)
+	// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+	ResumePackageRepository(context.Context, *connect_go.Request[v1alpha11.ResumePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ResumePackageRepositoryResponse], error)
 }
 
 // NewFluxV2RepositoriesServiceHandler builds an HTTP handler from the service implementation. It
@@ -529,6 +714,21 @@ func NewFluxV2RepositoriesServiceHandler(svc FluxV2RepositoriesServiceHandler, o
 		svc.GetPackageRepositoryPermissions,
 		opts...,
 	)
+	fluxV2RepositoriesServiceReconcilePackageRepositoryHandler := connect_go.NewUnaryHandler(
+		FluxV2RepositoriesServiceReconcilePackageRepositoryProcedure,
+		svc.ReconcilePackageRepository,
+		opts...,
+	)
+	fluxV2RepositoriesServiceSuspendPackageRepositoryHandler := connect_go.NewUnaryHandler(
+		FluxV2RepositoriesServiceSuspendPackageRepositoryProcedure,
+		svc.SuspendPackageRepository,
+		opts...,
+	)
+	fluxV2RepositoriesServiceResumePackageRepositoryHandler := connect_go.NewUnaryHandler(
+		FluxV2RepositoriesServiceResumePackageRepositoryProcedure,
+		svc.ResumePackageRepository,
+		opts...,
+	)
 	return "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FluxV2RepositoriesServiceAddPackageRepositoryProcedure:
@@ -543,6 +743,12 @@ func NewFluxV2RepositoriesServiceHandler(svc FluxV2RepositoriesServiceHandler, o
 			fluxV2RepositoriesServiceDeletePackageRepositoryHandler.ServeHTTP(w, r)
 		case FluxV2RepositoriesServiceGetPackageRepositoryPermissionsProcedure:
 			fluxV2RepositoriesServiceGetPackageRepositoryPermissionsHandler.ServeHTTP(w, r)
+		case FluxV2RepositoriesServiceReconcilePackageRepositoryProcedure:
+			fluxV2RepositoriesServiceReconcilePackageRepositoryHandler.ServeHTTP(w, r)
+		case FluxV2RepositoriesServiceSuspendPackageRepositoryProcedure:
+			fluxV2RepositoriesServiceSuspendPackageRepositoryHandler.ServeHTTP(w, r)
+		case FluxV2RepositoriesServiceResumePackageRepositoryProcedure:
+			fluxV2RepositoriesServiceResumePackageRepositoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -575,3 +781,15 @@ func (UnimplementedFluxV2RepositoriesServiceHandler) DeletePackageRepository(con
 func (UnimplementedFluxV2RepositoriesServiceHandler) GetPackageRepositoryPermissions(context.Context, *connect_go.Request[v1alpha1.GetPackageRepositoryPermissionsRequest]) (*connect_go.Response[v1alpha1.GetPackageRepositoryPermissionsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.GetPackageRepositoryPermissions is not implemented"))
 }
+
+func (UnimplementedFluxV2RepositoriesServiceHandler) ReconcilePackageRepository(context.Context, *connect_go.Request[v1alpha11.ReconcilePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ReconcilePackageRepositoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.ReconcilePackageRepository is not implemented"))
+}
+
+func (UnimplementedFluxV2RepositoriesServiceHandler) SuspendPackageRepository(context.Context, *connect_go.Request[v1alpha11.SuspendPackageRepositoryRequest]) (*connect_go.Response[v1alpha11.SuspendPackageRepositoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.SuspendPackageRepository is not implemented"))
+}
+
+func (UnimplementedFluxV2RepositoriesServiceHandler) ResumePackageRepository(context.Context, *connect_go.Request[v1alpha11.ResumePackageRepositoryRequest]) (*connect_go.Response[v1alpha11.ResumePackageRepositoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.ResumePackageRepository is not implemented"))
+}
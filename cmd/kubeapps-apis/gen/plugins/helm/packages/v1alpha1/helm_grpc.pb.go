@@ -23,16 +23,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	HelmPackagesService_GetAvailablePackageSummaries_FullMethodName    = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageSummaries"
-	HelmPackagesService_GetAvailablePackageDetail_FullMethodName       = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageDetail"
-	HelmPackagesService_GetAvailablePackageVersions_FullMethodName     = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageVersions"
-	HelmPackagesService_GetInstalledPackageSummaries_FullMethodName    = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageSummaries"
-	HelmPackagesService_GetInstalledPackageDetail_FullMethodName       = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageDetail"
-	HelmPackagesService_CreateInstalledPackage_FullMethodName          = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CreateInstalledPackage"
-	HelmPackagesService_UpdateInstalledPackage_FullMethodName          = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/UpdateInstalledPackage"
-	HelmPackagesService_DeleteInstalledPackage_FullMethodName          = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DeleteInstalledPackage"
-	HelmPackagesService_RollbackInstalledPackage_FullMethodName        = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage"
-	HelmPackagesService_GetInstalledPackageResourceRefs_FullMethodName = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceRefs"
+	HelmPackagesService_GetAvailablePackageSummaries_FullMethodName     = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageSummaries"
+	HelmPackagesService_GetAvailablePackageDetail_FullMethodName        = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageDetail"
+	HelmPackagesService_GetAvailablePackageVersions_FullMethodName      = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetAvailablePackageVersions"
+	HelmPackagesService_GetInstalledPackageSummaries_FullMethodName     = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageSummaries"
+	HelmPackagesService_GetInstalledPackageDetail_FullMethodName        = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageDetail"
+	HelmPackagesService_CreateInstalledPackage_FullMethodName           = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/CreateInstalledPackage"
+	HelmPackagesService_UpdateInstalledPackage_FullMethodName           = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/UpdateInstalledPackage"
+	HelmPackagesService_DeleteInstalledPackage_FullMethodName           = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DeleteInstalledPackage"
+	HelmPackagesService_RollbackInstalledPackage_FullMethodName         = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage"
+	HelmPackagesService_AdoptInstalledPackage_FullMethodName            = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/AdoptInstalledPackage"
+	HelmPackagesService_RunInstalledPackageTests_FullMethodName         = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RunInstalledPackageTests"
+	HelmPackagesService_InstallPackageFromTarball_FullMethodName        = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/InstallPackageFromTarball"
+	HelmPackagesService_GetInstalledPackageRevisions_FullMethodName     = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisions"
+	HelmPackagesService_GetInstalledPackageRevisionsDiff_FullMethodName = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisionsDiff"
+	HelmPackagesService_GetInstalledPackageDrift_FullMethodName         = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageDrift"
+	HelmPackagesService_GetInstalledPackageResourceRefs_FullMethodName  = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceRefs"
 )
 
 // HelmPackagesServiceClient is the client API for HelmPackagesService service.
@@ -57,6 +63,29 @@ type HelmPackagesServiceClient interface {
 	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
 	// RollbackInstalledPackage updates an installed package based on the request.
 	RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error)
+	// AdoptInstalledPackage associates an installed package, such as a Helm release
+	// installed outside Kubeapps, with the available package from which it was installed,
+	// so that it can be updated using the versions of that available package.
+	AdoptInstalledPackage(ctx context.Context, in *AdoptInstalledPackageRequest, opts ...grpc.CallOption) (*AdoptInstalledPackageResponse, error)
+	// RunInstalledPackageTests runs the tests of an installed package (the Helm
+	// release test hooks) one at a time, streaming the status of each test as it
+	// starts and completes, together with the logs of its pod.
+	RunInstalledPackageTests(ctx context.Context, in *RunInstalledPackageTestsRequest, opts ...grpc.CallOption) (HelmPackagesService_RunInstalledPackageTestsClient, error)
+	// InstallPackageFromTarball installs a chart archive uploaded with the request,
+	// such as a chart under development, or upgrades the installed package with the
+	// same name in the target context if it exists. The installed package is reported
+	// as locally sourced, without an available package.
+	InstallPackageFromTarball(ctx context.Context, in *InstallPackageFromTarballRequest, opts ...grpc.CallOption) (*InstallPackageFromTarballResponse, error)
+	// GetInstalledPackageRevisions returns the revisions (the Helm release history)
+	// of an installed package, from the most recent one.
+	GetInstalledPackageRevisions(ctx context.Context, in *GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsResponse, error)
+	// GetInstalledPackageRevisionsDiff returns the differences, in both the user-supplied
+	// values and the rendered manifests, between two revisions of an installed package.
+	GetInstalledPackageRevisionsDiff(ctx context.Context, in *GetInstalledPackageRevisionsDiffRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsDiffResponse, error)
+	// GetInstalledPackageDrift returns the differences between the resources of the
+	// current revision of an installed package and their live state in the cluster,
+	// such as hand-edited fields, optionally re-applying the revision to repair them.
+	GetInstalledPackageDrift(ctx context.Context, in *GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*GetInstalledPackageDriftResponse, error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
@@ -151,6 +180,83 @@ func (c *helmPackagesServiceClient) RollbackInstalledPackage(ctx context.Context
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) AdoptInstalledPackage(ctx context.Context, in *AdoptInstalledPackageRequest, opts ...grpc.CallOption) (*AdoptInstalledPackageResponse, error) {
+	out := new(AdoptInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, HelmPackagesService_AdoptInstalledPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) RunInstalledPackageTests(ctx context.Context, in *RunInstalledPackageTestsRequest, opts ...grpc.CallOption) (HelmPackagesService_RunInstalledPackageTestsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HelmPackagesService_ServiceDesc.Streams[0], HelmPackagesService_RunInstalledPackageTests_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &helmPackagesServiceRunInstalledPackageTestsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HelmPackagesService_RunInstalledPackageTestsClient interface {
+	Recv() (*RunInstalledPackageTestsResponse, error)
+	grpc.ClientStream
+}
+
+type helmPackagesServiceRunInstalledPackageTestsClient struct {
+	grpc.ClientStream
+}
+
+func (x *helmPackagesServiceRunInstalledPackageTestsClient) Recv() (*RunInstalledPackageTestsResponse, error) {
+	m := new(RunInstalledPackageTestsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *helmPackagesServiceClient) InstallPackageFromTarball(ctx context.Context, in *InstallPackageFromTarballRequest, opts ...grpc.CallOption) (*InstallPackageFromTarballResponse, error) {
+	out := new(InstallPackageFromTarballResponse)
+	err := c.cc.Invoke(ctx, HelmPackagesService_InstallPackageFromTarball_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsResponse, error) {
+	out := new(GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, HelmPackagesService_GetInstalledPackageRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetInstalledPackageRevisionsDiff(ctx context.Context, in *GetInstalledPackageRevisionsDiffRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsDiffResponse, error) {
+	out := new(GetInstalledPackageRevisionsDiffResponse)
+	err := c.cc.Invoke(ctx, HelmPackagesService_GetInstalledPackageRevisionsDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetInstalledPackageDrift(ctx context.Context, in *GetInstalledPackageDriftRequest, opts ...grpc.CallOption) (*GetInstalledPackageDriftResponse, error) {
+	out := new(GetInstalledPackageDriftResponse)
+	err := c.cc.Invoke(ctx, HelmPackagesService_GetInstalledPackageDrift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *helmPackagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	out := new(v1alpha1.GetInstalledPackageResourceRefsResponse)
 	err := c.cc.Invoke(ctx, HelmPackagesService_GetInstalledPackageResourceRefs_FullMethodName, in, out, opts...)
@@ -182,6 +288,29 @@ type HelmPackagesServiceServer interface {
 	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
 	// RollbackInstalledPackage updates an installed package based on the request.
 	RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error)
+	// AdoptInstalledPackage associates an installed package, such as a Helm release
+	// installed outside Kubeapps, with the available package from which it was installed,
+	// so that it can be updated using the versions of that available package.
+	AdoptInstalledPackage(context.Context, *AdoptInstalledPackageRequest) (*AdoptInstalledPackageResponse, error)
+	// RunInstalledPackageTests runs the tests of an installed package (the Helm
+	// release test hooks) one at a time, streaming the status of each test as it
+	// starts and completes, together with the logs of its pod.
+	RunInstalledPackageTests(*RunInstalledPackageTestsRequest, HelmPackagesService_RunInstalledPackageTestsServer) error
+	// InstallPackageFromTarball installs a chart archive uploaded with the request,
+	// such as a chart under development, or upgrades the installed package with the
+	// same name in the target context if it exists. The installed package is reported
+	// as locally sourced, without an available package.
+	InstallPackageFromTarball(context.Context, *InstallPackageFromTarballRequest) (*InstallPackageFromTarballResponse, error)
+	// GetInstalledPackageRevisions returns the revisions (the Helm release history)
+	// of an installed package, from the most recent one.
+	GetInstalledPackageRevisions(context.Context, *GetInstalledPackageRevisionsRequest) (*GetInstalledPackageRevisionsResponse, error)
+	// GetInstalledPackageRevisionsDiff returns the differences, in both the user-supplied
+	// values and the rendered manifests, between two revisions of an installed package.
+	GetInstalledPackageRevisionsDiff(context.Context, *GetInstalledPackageRevisionsDiffRequest) (*GetInstalledPackageRevisionsDiffResponse, error)
+	// GetInstalledPackageDrift returns the differences between the resources of the
+	// current revision of an installed package and their live state in the cluster,
+	// such as hand-edited fields, optionally re-applying the revision to repair them.
+	GetInstalledPackageDrift(context.Context, *GetInstalledPackageDriftRequest) (*GetInstalledPackageDriftResponse, error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
@@ -218,6 +347,24 @@ func (UnimplementedHelmPackagesServiceServer) DeleteInstalledPackage(context.Con
 func (UnimplementedHelmPackagesServiceServer) RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) AdoptInstalledPackage(context.Context, *AdoptInstalledPackageRequest) (*AdoptInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdoptInstalledPackage not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) RunInstalledPackageTests(*RunInstalledPackageTestsRequest, HelmPackagesService_RunInstalledPackageTestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunInstalledPackageTests not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) InstallPackageFromTarball(context.Context, *InstallPackageFromTarballRequest) (*InstallPackageFromTarballResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallPackageFromTarball not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageRevisions(context.Context, *GetInstalledPackageRevisionsRequest) (*GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageRevisionsDiff(context.Context, *GetInstalledPackageRevisionsDiffRequest) (*GetInstalledPackageRevisionsDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisionsDiff not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageDrift(context.Context, *GetInstalledPackageDriftRequest) (*GetInstalledPackageDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageDrift not implemented")
+}
 func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
@@ -395,6 +542,117 @@ func _HelmPackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_AdoptInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdoptInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).AdoptInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmPackagesService_AdoptInstalledPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).AdoptInstalledPackage(ctx, req.(*AdoptInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_RunInstalledPackageTests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunInstalledPackageTestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HelmPackagesServiceServer).RunInstalledPackageTests(m, &helmPackagesServiceRunInstalledPackageTestsServer{stream})
+}
+
+type HelmPackagesService_RunInstalledPackageTestsServer interface {
+	Send(*RunInstalledPackageTestsResponse) error
+	grpc.ServerStream
+}
+
+type helmPackagesServiceRunInstalledPackageTestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *helmPackagesServiceRunInstalledPackageTestsServer) Send(m *RunInstalledPackageTestsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HelmPackagesService_InstallPackageFromTarball_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallPackageFromTarballRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).InstallPackageFromTarball(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmPackagesService_InstallPackageFromTarball_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).InstallPackageFromTarball(ctx, req.(*InstallPackageFromTarballRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmPackagesService_GetInstalledPackageRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetInstalledPackageRevisionsDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageRevisionsDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisionsDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmPackagesService_GetInstalledPackageRevisionsDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisionsDiff(ctx, req.(*GetInstalledPackageRevisionsDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetInstalledPackageDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HelmPackagesService_GetInstalledPackageDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageDrift(ctx, req.(*GetInstalledPackageDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HelmPackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.GetInstalledPackageResourceRefsRequest)
 	if err := dec(in); err != nil {
@@ -456,12 +714,38 @@ var HelmPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RollbackInstalledPackage",
 			Handler:    _HelmPackagesService_RollbackInstalledPackage_Handler,
 		},
+		{
+			MethodName: "AdoptInstalledPackage",
+			Handler:    _HelmPackagesService_AdoptInstalledPackage_Handler,
+		},
+		{
+			MethodName: "InstallPackageFromTarball",
+			Handler:    _HelmPackagesService_InstallPackageFromTarball_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _HelmPackagesService_GetInstalledPackageRevisions_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageRevisionsDiff",
+			Handler:    _HelmPackagesService_GetInstalledPackageRevisionsDiff_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageDrift",
+			Handler:    _HelmPackagesService_GetInstalledPackageDrift_Handler,
+		},
 		{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _HelmPackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RunInstalledPackageTests",
+			Handler:       _HelmPackagesService_RunInstalledPackageTests_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "kubeappsapis/plugins/helm/packages/v1alpha1/helm.proto",
 }
 
@@ -66,6 +66,24 @@ const (
 	// HelmPackagesServiceRollbackInstalledPackageProcedure is the fully-qualified name of the
 	// HelmPackagesService's RollbackInstalledPackage RPC.
 	HelmPackagesServiceRollbackInstalledPackageProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage"
+	// HelmPackagesServiceAdoptInstalledPackageProcedure is the fully-qualified name of the
+	// HelmPackagesService's AdoptInstalledPackage RPC.
+	HelmPackagesServiceAdoptInstalledPackageProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/AdoptInstalledPackage"
+	// HelmPackagesServiceRunInstalledPackageTestsProcedure is the fully-qualified name of the
+	// HelmPackagesService's RunInstalledPackageTests RPC.
+	HelmPackagesServiceRunInstalledPackageTestsProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RunInstalledPackageTests"
+	// HelmPackagesServiceInstallPackageFromTarballProcedure is the fully-qualified name of the
+	// HelmPackagesService's InstallPackageFromTarball RPC.
+	HelmPackagesServiceInstallPackageFromTarballProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/InstallPackageFromTarball"
+	// HelmPackagesServiceGetInstalledPackageRevisionsProcedure is the fully-qualified name of the
+	// HelmPackagesService's GetInstalledPackageRevisions RPC.
+	HelmPackagesServiceGetInstalledPackageRevisionsProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisions"
+	// HelmPackagesServiceGetInstalledPackageRevisionsDiffProcedure is the fully-qualified name of the
+	// HelmPackagesService's GetInstalledPackageRevisionsDiff RPC.
+	HelmPackagesServiceGetInstalledPackageRevisionsDiffProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisionsDiff"
+	// HelmPackagesServiceGetInstalledPackageDriftProcedure is the fully-qualified name of the
+	// HelmPackagesService's GetInstalledPackageDrift RPC.
+	HelmPackagesServiceGetInstalledPackageDriftProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageDrift"
 	// HelmPackagesServiceGetInstalledPackageResourceRefsProcedure is the fully-qualified name of the
 	// HelmPackagesService's GetInstalledPackageResourceRefs RPC.
 	HelmPackagesServiceGetInstalledPackageResourceRefsProcedure = "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceRefs"
@@ -110,6 +128,29 @@ type HelmPackagesServiceClient interface {
 	DeleteInstalledPackage(context.Context, *connect_go.Request[v1alpha1.DeleteInstalledPackageRequest]) (*connect_go.Response[v1alpha1.DeleteInstalledPackageResponse], error)
 	// RollbackInstalledPackage updates an installed package based on the request.
 	RollbackInstalledPackage(context.Context, *connect_go.Request[v1alpha11.RollbackInstalledPackageRequest]) (*connect_go.Response[v1alpha11.RollbackInstalledPackageResponse], error)
+	// AdoptInstalledPackage associates an installed package, such as a Helm release
+	// installed outside Kubeapps, with the available package from which it was installed,
+	// so that it can be updated using the versions of that available package.
+	AdoptInstalledPackage(context.Context, *connect_go.Request[v1alpha11.AdoptInstalledPackageRequest]) (*connect_go.Response[v1alpha11.AdoptInstalledPackageResponse], error)
+	// RunInstalledPackageTests runs the tests of an installed package (the Helm
+	// release test hooks) one at a time, streaming the status of each test as it
+	// starts and completes, together with the logs of its pod.
+	RunInstalledPackageTests(context.Context, *connect_go.Request[v1alpha11.RunInstalledPackageTestsRequest]) (*connect_go.ServerStreamForClient[v1alpha11.RunInstalledPackageTestsResponse], error)
+	// InstallPackageFromTarball installs a chart archive uploaded with the request,
+	// such as a chart under development, or upgrades the installed package with the
+	// same name in the target context if it exists. The installed package is reported
+	// as locally sourced, without an available package.
+	InstallPackageFromTarball(context.Context, *connect_go.Request[v1alpha11.InstallPackageFromTarballRequest]) (*connect_go.Response[v1alpha11.InstallPackageFromTarballResponse], error)
+	// GetInstalledPackageRevisions returns the revisions (the Helm release history)
+	// of an installed package, from the most recent one.
+	GetInstalledPackageRevisions(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsResponse], error)
+	// GetInstalledPackageRevisionsDiff returns the differences, in both the user-supplied
+	// values and the rendered manifests, between two revisions of an installed package.
+	GetInstalledPackageRevisionsDiff(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsDiffRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsDiffResponse], error)
+	// GetInstalledPackageDrift returns the differences between the resources of the
+	// current revision of an installed package and their live state in the cluster,
+	// such as hand-edited fields, optionally re-applying the revision to repair them.
+	GetInstalledPackageDrift(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageDriftRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageDriftResponse], error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error)
@@ -171,6 +212,36 @@ func NewHelmPackagesServiceClient(httpClient connect_go.HTTPClient, baseURL stri
 			baseURL+HelmPackagesServiceRollbackInstalledPackageProcedure,
 			opts...,
 		),
+		adoptInstalledPackage: connect_go.NewClient[v1alpha11.AdoptInstalledPackageRequest, v1alpha11.AdoptInstalledPackageResponse](
+			httpClient,
+			baseURL+HelmPackagesServiceAdoptInstalledPackageProcedure,
+			opts...,
+		),
+		runInstalledPackageTests: connect_go.NewClient[v1alpha11.RunInstalledPackageTestsRequest, v1alpha11.RunInstalledPackageTestsResponse](
+			httpClient,
+			baseURL+HelmPackagesServiceRunInstalledPackageTestsProcedure,
+			opts...,
+		),
+		installPackageFromTarball: connect_go.NewClient[v1alpha11.InstallPackageFromTarballRequest, v1alpha11.InstallPackageFromTarballResponse](
+			httpClient,
+			baseURL+HelmPackagesServiceInstallPackageFromTarballProcedure,
+			opts...,
+		),
+		getInstalledPackageRevisions: connect_go.NewClient[v1alpha11.GetInstalledPackageRevisionsRequest, v1alpha11.GetInstalledPackageRevisionsResponse](
+			httpClient,
+			baseURL+HelmPackagesServiceGetInstalledPackageRevisionsProcedure,
+			opts...,
+		),
+		getInstalledPackageRevisionsDiff: connect_go.NewClient[v1alpha11.GetInstalledPackageRevisionsDiffRequest, v1alpha11.GetInstalledPackageRevisionsDiffResponse](
+			httpClient,
+			baseURL+HelmPackagesServiceGetInstalledPackageRevisionsDiffProcedure,
+			opts...,
+		),
+		getInstalledPackageDrift: connect_go.NewClient[v1alpha11.GetInstalledPackageDriftRequest, v1alpha11.GetInstalledPackageDriftResponse](
+			httpClient,
+			baseURL+HelmPackagesServiceGetInstalledPackageDriftProcedure,
+			opts...,
+		),
 		getInstalledPackageResourceRefs: connect_go.NewClient[v1alpha1.GetInstalledPackageResourceRefsRequest, v1alpha1.GetInstalledPackageResourceRefsResponse](
 			httpClient,
 			baseURL+HelmPackagesServiceGetInstalledPackageResourceRefsProcedure,
@@ -181,16 +252,22 @@ func NewHelmPackagesServiceClient(httpClient connect_go.HTTPClient, baseURL stri
 
 // helmPackagesServiceClient implements HelmPackagesServiceClient.
 type helmPackagesServiceClient struct {
-	getAvailablePackageSummaries    *connect_go.Client[v1alpha1.GetAvailablePackageSummariesRequest, v1alpha1.GetAvailablePackageSummariesResponse]
-	getAvailablePackageDetail       *connect_go.Client[v1alpha1.GetAvailablePackageDetailRequest, v1alpha1.GetAvailablePackageDetailResponse]
-	getAvailablePackageVersions     *connect_go.Client[v1alpha1.GetAvailablePackageVersionsRequest, v1alpha1.GetAvailablePackageVersionsResponse]
-	getInstalledPackageSummaries    *connect_go.Client[v1alpha1.GetInstalledPackageSummariesRequest, v1alpha1.GetInstalledPackageSummariesResponse]
-	getInstalledPackageDetail       *connect_go.Client[v1alpha1.GetInstalledPackageDetailRequest, v1alpha1.GetInstalledPackageDetailResponse]
-	createInstalledPackage          *connect_go.Client[v1alpha1.CreateInstalledPackageRequest, v1alpha1.CreateInstalledPackageResponse]
-	updateInstalledPackage          *connect_go.Client[v1alpha1.UpdateInstalledPackageRequest, v1alpha1.UpdateInstalledPackageResponse]
-	deleteInstalledPackage          *connect_go.Client[v1alpha1.DeleteInstalledPackageRequest, v1alpha1.DeleteInstalledPackageResponse]
-	rollbackInstalledPackage        *connect_go.Client[v1alpha11.RollbackInstalledPackageRequest, v1alpha11.RollbackInstalledPackageResponse]
-	getInstalledPackageResourceRefs *connect_go.Client[v1alpha1.GetInstalledPackageResourceRefsRequest, v1alpha1.GetInstalledPackageResourceRefsResponse]
+	getAvailablePackageSummaries     *connect_go.Client[v1alpha1.GetAvailablePackageSummariesRequest, v1alpha1.GetAvailablePackageSummariesResponse]
+	getAvailablePackageDetail        *connect_go.Client[v1alpha1.GetAvailablePackageDetailRequest, v1alpha1.GetAvailablePackageDetailResponse]
+	getAvailablePackageVersions      *connect_go.Client[v1alpha1.GetAvailablePackageVersionsRequest, v1alpha1.GetAvailablePackageVersionsResponse]
+	getInstalledPackageSummaries     *connect_go.Client[v1alpha1.GetInstalledPackageSummariesRequest, v1alpha1.GetInstalledPackageSummariesResponse]
+	getInstalledPackageDetail        *connect_go.Client[v1alpha1.GetInstalledPackageDetailRequest, v1alpha1.GetInstalledPackageDetailResponse]
+	createInstalledPackage           *connect_go.Client[v1alpha1.CreateInstalledPackageRequest, v1alpha1.CreateInstalledPackageResponse]
+	updateInstalledPackage           *connect_go.Client[v1alpha1.UpdateInstalledPackageRequest, v1alpha1.UpdateInstalledPackageResponse]
+	deleteInstalledPackage           *connect_go.Client[v1alpha1.DeleteInstalledPackageRequest, v1alpha1.DeleteInstalledPackageResponse]
+	rollbackInstalledPackage         *connect_go.Client[v1alpha11.RollbackInstalledPackageRequest, v1alpha11.RollbackInstalledPackageResponse]
+	adoptInstalledPackage            *connect_go.Client[v1alpha11.AdoptInstalledPackageRequest, v1alpha11.AdoptInstalledPackageResponse]
+	runInstalledPackageTests         *connect_go.Client[v1alpha11.RunInstalledPackageTestsRequest, v1alpha11.RunInstalledPackageTestsResponse]
+	installPackageFromTarball        *connect_go.Client[v1alpha11.InstallPackageFromTarballRequest, v1alpha11.InstallPackageFromTarballResponse]
+	getInstalledPackageRevisions     *connect_go.Client[v1alpha11.GetInstalledPackageRevisionsRequest, v1alpha11.GetInstalledPackageRevisionsResponse]
+	getInstalledPackageRevisionsDiff *connect_go.Client[v1alpha11.GetInstalledPackageRevisionsDiffRequest, v1alpha11.GetInstalledPackageRevisionsDiffResponse]
+	getInstalledPackageDrift         *connect_go.Client[v1alpha11.GetInstalledPackageDriftRequest, v1alpha11.GetInstalledPackageDriftResponse]
+	getInstalledPackageResourceRefs  *connect_go.Client[v1alpha1.GetInstalledPackageResourceRefsRequest, v1alpha1.GetInstalledPackageResourceRefsResponse]
 }
 
 // GetAvailablePackageSummaries calls
@@ -247,6 +324,42 @@ func (c *helmPackagesServiceClient) RollbackInstalledPackage(ctx context.Context
 	return c.rollbackInstalledPackage.CallUnary(ctx, req)
 }
 
+// AdoptInstalledPackage calls
+// kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.AdoptInstalledPackage.
+func (c *helmPackagesServiceClient) AdoptInstalledPackage(ctx context.Context, req *connect_go.Request[v1alpha11.AdoptInstalledPackageRequest]) (*connect_go.Response[v1alpha11.AdoptInstalledPackageResponse], error) {
+	return c.adoptInstalledPackage.CallUnary(ctx, req)
+}
+
+// RunInstalledPackageTests calls
+// kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.RunInstalledPackageTests.
+func (c *helmPackagesServiceClient) RunInstalledPackageTests(ctx context.Context, req *connect_go.Request[v1alpha11.RunInstalledPackageTestsRequest]) (*connect_go.ServerStreamForClient[v1alpha11.RunInstalledPackageTestsResponse], error) {
+	return c.runInstalledPackageTests.CallServerStream(ctx, req)
+}
+
+// InstallPackageFromTarball calls
+// kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.InstallPackageFromTarball.
+func (c *helmPackagesServiceClient) InstallPackageFromTarball(ctx context.Context, req *connect_go.Request[v1alpha11.InstallPackageFromTarballRequest]) (*connect_go.Response[v1alpha11.InstallPackageFromTarballResponse], error) {
+	return c.installPackageFromTarball.CallUnary(ctx, req)
+}
+
+// GetInstalledPackageRevisions calls
+// kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageRevisions.
+func (c *helmPackagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, req *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsResponse], error) {
+	return c.getInstalledPackageRevisions.CallUnary(ctx, req)
+}
+
+// GetInstalledPackageRevisionsDiff calls
+// kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageRevisionsDiff.
+func (c *helmPackagesServiceClient) GetInstalledPackageRevisionsDiff(ctx context.Context, req *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsDiffRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsDiffResponse], error) {
+	return c.getInstalledPackageRevisionsDiff.CallUnary(ctx, req)
+}
+
+// GetInstalledPackageDrift calls
+// kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageDrift.
+func (c *helmPackagesServiceClient) GetInstalledPackageDrift(ctx context.Context, req *connect_go.Request[v1alpha11.GetInstalledPackageDriftRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageDriftResponse], error) {
+	return c.getInstalledPackageDrift.CallUnary(ctx, req)
+}
+
 // GetInstalledPackageResourceRefs calls
 // kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageResourceRefs.
 func (c *helmPackagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Context, req *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error) {
@@ -274,6 +387,29 @@ type HelmPackagesServiceHandler interface {
 	DeleteInstalledPackage(context.Context, *connect_go.Request[v1alpha1.DeleteInstalledPackageRequest]) (*connect_go.Response[v1alpha1.DeleteInstalledPackageResponse], error)
 	// RollbackInstalledPackage updates an installed package based on the request.
 	RollbackInstalledPackage(context.Context, *connect_go.Request[v1alpha11.RollbackInstalledPackageRequest]) (*connect_go.Response[v1alpha11.RollbackInstalledPackageResponse], error)
+	// AdoptInstalledPackage associates an installed package, such as a Helm release
+	// installed outside Kubeapps, with the available package from which it was installed,
+	// so that it can be updated using the versions of that available package.
+	AdoptInstalledPackage(context.Context, *connect_go.Request[v1alpha11.AdoptInstalledPackageRequest]) (*connect_go.Response[v1alpha11.AdoptInstalledPackageResponse], error)
+	// RunInstalledPackageTests runs the tests of an installed package (the Helm
+	// release test hooks) one at a time, streaming the status of each test as it
+	// starts and completes, together with the logs of its pod.
+	RunInstalledPackageTests(context.Context, *connect_go.Request[v1alpha11.RunInstalledPackageTestsRequest], *connect_go.ServerStream[v1alpha11.RunInstalledPackageTestsResponse]) error
+	// InstallPackageFromTarball installs a chart archive uploaded with the request,
+	// such as a chart under development, or upgrades the installed package with the
+	// same name in the target context if it exists. The installed package is reported
+	// as locally sourced, without an available package.
+	InstallPackageFromTarball(context.Context, *connect_go.Request[v1alpha11.InstallPackageFromTarballRequest]) (*connect_go.Response[v1alpha11.InstallPackageFromTarballResponse], error)
+	// GetInstalledPackageRevisions returns the revisions (the Helm release history)
+	// of an installed package, from the most recent one.
+	GetInstalledPackageRevisions(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsResponse], error)
+	// GetInstalledPackageRevisionsDiff returns the differences, in both the user-supplied
+	// values and the rendered manifests, between two revisions of an installed package.
+	GetInstalledPackageRevisionsDiff(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsDiffRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsDiffResponse], error)
+	// GetInstalledPackageDrift returns the differences between the resources of the
+	// current revision of an installed package and their live state in the cluster,
+	// such as hand-edited fields, optionally re-applying the revision to repair them.
+	GetInstalledPackageDrift(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageDriftRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageDriftResponse], error)
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error)
@@ -330,6 +466,36 @@ func NewHelmPackagesServiceHandler(svc HelmPackagesServiceHandler, opts ...conne
 		svc.RollbackInstalledPackage,
 		opts...,
 	)
+	helmPackagesServiceAdoptInstalledPackageHandler := connect_go.NewUnaryHandler(
+		HelmPackagesServiceAdoptInstalledPackageProcedure,
+		svc.AdoptInstalledPackage,
+		opts...,
+	)
+	helmPackagesServiceRunInstalledPackageTestsHandler := connect_go.NewServerStreamHandler(
+		HelmPackagesServiceRunInstalledPackageTestsProcedure,
+		svc.RunInstalledPackageTests,
+		opts...,
+	)
+	helmPackagesServiceInstallPackageFromTarballHandler := connect_go.NewUnaryHandler(
+		HelmPackagesServiceInstallPackageFromTarballProcedure,
+		svc.InstallPackageFromTarball,
+		opts...,
+	)
+	helmPackagesServiceGetInstalledPackageRevisionsHandler := connect_go.NewUnaryHandler(
+		HelmPackagesServiceGetInstalledPackageRevisionsProcedure,
+		svc.GetInstalledPackageRevisions,
+		opts...,
+	)
+	helmPackagesServiceGetInstalledPackageRevisionsDiffHandler := connect_go.NewUnaryHandler(
+		HelmPackagesServiceGetInstalledPackageRevisionsDiffProcedure,
+		svc.GetInstalledPackageRevisionsDiff,
+		opts...,
+	)
+	helmPackagesServiceGetInstalledPackageDriftHandler := connect_go.NewUnaryHandler(
+		HelmPackagesServiceGetInstalledPackageDriftProcedure,
+		svc.GetInstalledPackageDrift,
+		opts...,
+	)
 	helmPackagesServiceGetInstalledPackageResourceRefsHandler := connect_go.NewUnaryHandler(
 		HelmPackagesServiceGetInstalledPackageResourceRefsProcedure,
 		svc.GetInstalledPackageResourceRefs,
@@ -355,6 +521,18 @@ func NewHelmPackagesServiceHandler(svc HelmPackagesServiceHandler, opts ...conne
 			helmPackagesServiceDeleteInstalledPackageHandler.ServeHTTP(w, r)
 		case HelmPackagesServiceRollbackInstalledPackageProcedure:
 			helmPackagesServiceRollbackInstalledPackageHandler.ServeHTTP(w, r)
+		case HelmPackagesServiceAdoptInstalledPackageProcedure:
+			helmPackagesServiceAdoptInstalledPackageHandler.ServeHTTP(w, r)
+		case HelmPackagesServiceRunInstalledPackageTestsProcedure:
+			helmPackagesServiceRunInstalledPackageTestsHandler.ServeHTTP(w, r)
+		case HelmPackagesServiceInstallPackageFromTarballProcedure:
+			helmPackagesServiceInstallPackageFromTarballHandler.ServeHTTP(w, r)
+		case HelmPackagesServiceGetInstalledPackageRevisionsProcedure:
+			helmPackagesServiceGetInstalledPackageRevisionsHandler.ServeHTTP(w, r)
+		case HelmPackagesServiceGetInstalledPackageRevisionsDiffProcedure:
+			helmPackagesServiceGetInstalledPackageRevisionsDiffHandler.ServeHTTP(w, r)
+		case HelmPackagesServiceGetInstalledPackageDriftProcedure:
+			helmPackagesServiceGetInstalledPackageDriftHandler.ServeHTTP(w, r)
 		case HelmPackagesServiceGetInstalledPackageResourceRefsProcedure:
 			helmPackagesServiceGetInstalledPackageResourceRefsHandler.ServeHTTP(w, r)
 		default:
@@ -402,6 +580,30 @@ func (UnimplementedHelmPackagesServiceHandler) RollbackInstalledPackage(context.
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.RollbackInstalledPackage is not implemented"))
 }
 
+func (UnimplementedHelmPackagesServiceHandler) AdoptInstalledPackage(context.Context, *connect_go.Request[v1alpha11.AdoptInstalledPackageRequest]) (*connect_go.Response[v1alpha11.AdoptInstalledPackageResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.AdoptInstalledPackage is not implemented"))
+}
+
+func (UnimplementedHelmPackagesServiceHandler) RunInstalledPackageTests(context.Context, *connect_go.Request[v1alpha11.RunInstalledPackageTestsRequest], *connect_go.ServerStream[v1alpha11.RunInstalledPackageTestsResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.RunInstalledPackageTests is not implemented"))
+}
+
+func (UnimplementedHelmPackagesServiceHandler) InstallPackageFromTarball(context.Context, *connect_go.Request[v1alpha11.InstallPackageFromTarballRequest]) (*connect_go.Response[v1alpha11.InstallPackageFromTarballResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.InstallPackageFromTarball is not implemented"))
+}
+
+func (UnimplementedHelmPackagesServiceHandler) GetInstalledPackageRevisions(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageRevisions is not implemented"))
+}
+
+func (UnimplementedHelmPackagesServiceHandler) GetInstalledPackageRevisionsDiff(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageRevisionsDiffRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageRevisionsDiffResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageRevisionsDiff is not implemented"))
+}
+
+func (UnimplementedHelmPackagesServiceHandler) GetInstalledPackageDrift(context.Context, *connect_go.Request[v1alpha11.GetInstalledPackageDriftRequest]) (*connect_go.Response[v1alpha11.GetInstalledPackageDriftResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageDrift is not implemented"))
+}
+
 func (UnimplementedHelmPackagesServiceHandler) GetInstalledPackageResourceRefs(context.Context, *connect_go.Request[v1alpha1.GetInstalledPackageResourceRefsRequest]) (*connect_go.Response[v1alpha1.GetInstalledPackageResourceRefsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageResourceRefs is not implemented"))
 }
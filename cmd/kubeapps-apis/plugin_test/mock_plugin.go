@@ -6,11 +6,13 @@ package plugin_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/bufbuild/connect-go"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 type TestPackagingPluginServer struct {
@@ -23,9 +25,13 @@ type TestPackagingPluginServer struct {
 	InstalledPackageDetail    *corev1.InstalledPackageDetail
 	PackageAppVersions        []*corev1.PackageAppVersion
 	ResourceRefs              []*corev1.ResourceRef
+	InstalledPackageEvents    []*corev1.WatchInstalledPackagesResponse
 	Categories                []string
 	NextPageToken             string
 	ErrorCode                 connect.Code
+	// MergesCustomDetailValues reports whether the custom detail of a request
+	// provides values merged server side.
+	MergesCustomDetailValues bool
 }
 
 func NewTestPackagingPlugin(plugin *plugins.Plugin) *TestPackagingPluginServer {
@@ -145,6 +151,22 @@ func (s TestPackagingPluginServer) UpdateInstalledPackage(ctx context.Context, r
 	}), nil
 }
 
+func (s TestPackagingPluginServer) ValidateInstalledPackageValues(ctx context.Context, request *connect.Request[corev1.ValidateInstalledPackageValuesRequest]) (*connect.Response[corev1.ValidateInstalledPackageValuesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
+
+func (s TestPackagingPluginServer) BulkUpdateInstalledPackages(ctx context.Context, request *connect.Request[corev1.BulkUpdateInstalledPackagesRequest]) (*connect.Response[corev1.BulkUpdateInstalledPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
+
+func (s TestPackagingPluginServer) ExportInstalledPackages(ctx context.Context, request *connect.Request[corev1.ExportInstalledPackagesRequest]) (*connect.Response[corev1.ExportInstalledPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
+
+func (s TestPackagingPluginServer) ImportInstalledPackages(ctx context.Context, request *connect.Request[corev1.ImportInstalledPackagesRequest]) (*connect.Response[corev1.ImportInstalledPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
+
 func (s TestPackagingPluginServer) DeleteInstalledPackage(ctx context.Context, request *connect.Request[corev1.DeleteInstalledPackageRequest]) (*connect.Response[corev1.DeleteInstalledPackageResponse], error) {
 	if s.ErrorCode != 0 {
 		return nil, connect.NewError(s.ErrorCode, fmt.Errorf("Non-OK response"))
@@ -152,6 +174,26 @@ func (s TestPackagingPluginServer) DeleteInstalledPackage(ctx context.Context, r
 	return connect.NewResponse(&corev1.DeleteInstalledPackageResponse{}), nil
 }
 
+func (s TestPackagingPluginServer) WatchInstalledPackages(ctx context.Context, request *connect.Request[corev1.WatchInstalledPackagesRequest], stream *connect.ServerStream[corev1.WatchInstalledPackagesResponse]) error {
+	return s.WatchInstalledPackageEvents(ctx, request.Header(), request.Msg, stream.Send)
+}
+
+func (s TestPackagingPluginServer) WatchInstalledPackageEvents(ctx context.Context, headers http.Header, request *corev1.WatchInstalledPackagesRequest, send func(*corev1.WatchInstalledPackagesResponse) error) error {
+	if s.ErrorCode != 0 {
+		return connect.NewError(s.ErrorCode, fmt.Errorf("Non-OK response"))
+	}
+	for _, event := range s.InstalledPackageEvents {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s TestPackagingPluginServer) MergesServerSideValues(customDetail *anypb.Any) bool {
+	return s.MergesCustomDetailValues
+}
+
 type TestRepositoriesPluginServer struct {
 	corev1.UnimplementedRepositoriesServiceServer
 	Plugin                     *plugins.Plugin
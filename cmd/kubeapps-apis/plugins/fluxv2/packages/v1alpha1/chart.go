@@ -53,7 +53,13 @@ func (s *Server) availableChartDetail(ctx context.Context, headers http.Header,
 
 	// this verifies that the repo exists
 	repo, err := s.getRepoInCluster(ctx, headers, repoName)
-	if err != nil {
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// not a HelmRepository, but it may be a GitRepository or a Bucket
+		if src, srcErr := s.getChartSourceInCluster(ctx, headers, repoName); srcErr == nil {
+			return s.availableChartSourceChartDetail(ctx, headers, packageRef, src, chartName, chartVersion)
+		}
+		return nil, err
+	} else if err != nil {
 		return nil, err
 	} else if !isRepoReady(*repo) {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Repository [%s] is not in Ready state", repoName))
@@ -153,7 +159,8 @@ func (s *Server) getChartModel(ctx context.Context, headers http.Header, repoNam
 		if err != nil {
 			return nil, err
 		} else if typedValue == nil {
-			return nil, nil
+			// not a HelmRepository, but it may be a GitRepository or a Bucket
+			return s.getChartSourceChartModel(ctx, headers, repoName, chartName)
 		} else {
 			for _, chart := range typedValue.Charts {
 				if chart.Name == chartName {
@@ -24,7 +24,8 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/docker/cli/cli/config"
 	"github.com/docker/cli/cli/config/credentials"
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -55,10 +56,13 @@ var (
 	pluginDetail plugins.Plugin
 	// This version var is updated during the build (see the -ldflags option
 	// in the cmd/kubeapps-apis/Dockerfile)
-	version         = "devel"
-	repositoriesGvr schema.GroupVersionResource
-	chartsGvr       schema.GroupVersionResource
-	releasesGvr     schema.GroupVersionResource
+	version            = "devel"
+	repositoriesGvr    schema.GroupVersionResource
+	gitRepositoriesGvr schema.GroupVersionResource
+	bucketsGvr         schema.GroupVersionResource
+	ociRepositoriesGvr schema.GroupVersionResource
+	chartsGvr          schema.GroupVersionResource
+	releasesGvr        schema.GroupVersionResource
 )
 
 func init() {
@@ -73,6 +77,24 @@ func init() {
 		Resource: "helmrepositories",
 	}
 
+	gitRepositoriesGvr = schema.GroupVersionResource{
+		Group:    sourcev1.GroupVersion.Group,
+		Version:  sourcev1.GroupVersion.Version,
+		Resource: "gitrepositories",
+	}
+
+	bucketsGvr = schema.GroupVersionResource{
+		Group:    sourcev1beta2.GroupVersion.Group,
+		Version:  sourcev1beta2.GroupVersion.Version,
+		Resource: "buckets",
+	}
+
+	ociRepositoriesGvr = schema.GroupVersionResource{
+		Group:    sourcev1beta2.GroupVersion.Group,
+		Version:  sourcev1beta2.GroupVersion.Version,
+		Resource: "ocirepositories",
+	}
+
 	chartsGvr = schema.GroupVersionResource{
 		Group:    sourcev1beta2.GroupVersion.Group,
 		Version:  sourcev1beta2.GroupVersion.Version,
@@ -80,8 +102,8 @@ func init() {
 	}
 
 	releasesGvr = schema.GroupVersionResource{
-		Group:    helmv2beta2.GroupVersion.Group,
-		Version:  helmv2beta2.GroupVersion.Version,
+		Group:    helmv2.GroupVersion.Group,
+		Version:  helmv2.GroupVersion.Version,
 		Resource: "helmreleases",
 	}
 }
@@ -488,6 +510,18 @@ func GetRepositoriesGvr() schema.GroupVersionResource {
 	return repositoriesGvr
 }
 
+func GetGitRepositoriesGvr() schema.GroupVersionResource {
+	return gitRepositoriesGvr
+}
+
+func GetBucketsGvr() schema.GroupVersionResource {
+	return bucketsGvr
+}
+
+func GetOCIRepositoriesGvr() schema.GroupVersionResource {
+	return ociRepositoriesGvr
+}
+
 func GetChartsGvr() schema.GroupVersionResource {
 	return chartsGvr
 }
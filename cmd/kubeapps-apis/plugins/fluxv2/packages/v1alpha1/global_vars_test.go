@@ -10,7 +10,7 @@ import (
 
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
 
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
@@ -1831,7 +1831,7 @@ var (
 			Namespace:  "test",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
@@ -1844,12 +1844,12 @@ var (
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               "Released",
 					Status:             metav1.ConditionTrue,
-					Reason:             helmv2beta2.InstallSucceededReason,
+					Reason:             helmv2.InstallSucceededReason,
 					Message:            "Helm install succeeded",
 				},
 			},
 			HelmChart:             "default/redis",
-			LastAppliedRevision:   "14.4.0",
+			History:               helmv2.Snapshots{{ChartVersion: "14.4.0", Status: release.StatusDeployed.String()}},
 			LastAttemptedRevision: "14.4.0",
 			ObservedGeneration:    1,
 		},
@@ -1886,7 +1886,7 @@ var (
 		releaseSuspend:            true,
 		releaseServiceAccountName: "foo",
 		releaseValues:             &v1.JSON{Raw: redis_existing_spec_completed_with_values_and_reconciliation_options_values_bytes},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
@@ -1899,12 +1899,12 @@ var (
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               "Released",
 					Status:             metav1.ConditionTrue,
-					Reason:             helmv2beta2.InstallSucceededReason,
+					Reason:             helmv2.InstallSucceededReason,
 					Message:            "Helm install succeeded",
 				},
 			},
 			HelmChart:             "default/redis",
-			LastAppliedRevision:   "14.4.0",
+			History:               helmv2.Snapshots{{ChartVersion: "14.4.0", Status: release.StatusDeployed.String()}},
 			LastAttemptedRevision: "14.4.0",
 			ObservedGeneration:    1,
 		},
@@ -1923,20 +1923,20 @@ var (
 			Namespace:  "test",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               fluxmeta.ReadyCondition,
 					Status:             metav1.ConditionFalse,
-					Reason:             helmv2beta2.InstallFailedReason,
+					Reason:             helmv2.InstallFailedReason,
 					Message:            "install retries exhausted",
 				},
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               "Released",
 					Status:             metav1.ConditionFalse,
-					Reason:             helmv2beta2.InstallFailedReason,
+					Reason:             helmv2.InstallFailedReason,
 					Message:            "Helm install failed: unable to build kubernetes objects from release manifest: error validating \"\": error validating data: ValidationError(Deployment.spec.replicas): invalid type for io.k8s.api.apps.v1.DeploymentSpec.replicas: got \"string\", expected \"integer\"",
 				},
 			},
@@ -1961,13 +1961,13 @@ var (
 			Namespace:  "test",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               fluxmeta.ReadyCondition,
 					Status:             metav1.ConditionFalse,
-					Reason:             helmv2beta2.GetLastReleaseFailedReason,
+					Reason:             getLastReleaseFailedReason,
 					Message:            "failed to get last release revision",
 				},
 			},
@@ -1990,13 +1990,13 @@ var (
 			Namespace:  "test",
 			Generation: 2,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               fluxmeta.ReadyCondition,
 					Status:             metav1.ConditionFalse,
-					Reason:             helmv2beta2.GetLastReleaseFailedReason,
+					Reason:             getLastReleaseFailedReason,
 					Message:            "failed to get last release revision",
 				},
 			},
@@ -2027,7 +2027,7 @@ var (
 			Namespace:  "namespace-2",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
@@ -2040,12 +2040,12 @@ var (
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               "Released",
 					Status:             metav1.ConditionTrue,
-					Reason:             helmv2beta2.InstallSucceededReason,
+					Reason:             helmv2.InstallSucceededReason,
 					Message:            "Helm install succeeded",
 				},
 			},
 			HelmChart:             "default/airflow",
-			LastAppliedRevision:   "6.7.1",
+			History:               helmv2.Snapshots{{ChartVersion: "6.7.1", Status: release.StatusDeployed.String()}},
 			LastAttemptedRevision: "6.7.1",
 			ObservedGeneration:    1,
 		},
@@ -2064,7 +2064,7 @@ var (
 			Namespace:  "namespace-2",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
@@ -2077,12 +2077,12 @@ var (
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               "Released",
 					Status:             metav1.ConditionTrue,
-					Reason:             helmv2beta2.InstallSucceededReason,
+					Reason:             helmv2.InstallSucceededReason,
 					Message:            "Helm install succeeded",
 				},
 			},
 			HelmChart:             "default/airflow",
-			LastAppliedRevision:   "6.7.1",
+			History:               helmv2.Snapshots{{ChartVersion: "6.7.1", Status: release.StatusDeployed.String()}},
 			LastAttemptedRevision: "6.7.1",
 			ObservedGeneration:    1,
 		},
@@ -2101,7 +2101,7 @@ var (
 			Namespace:  "test",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
@@ -2130,13 +2130,13 @@ var (
 			Namespace:  "test",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               fluxmeta.ReadyCondition,
 					Status:             metav1.ConditionFalse,
-					Reason:             helmv2beta2.ArtifactFailedReason,
+					Reason:             helmv2.ArtifactFailedReason,
 					Message:            "HelmChart 'default/kubeapps-my-redis' is not ready",
 				},
 			},
@@ -2168,7 +2168,7 @@ var (
 			Namespace:  "test",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
@@ -2181,12 +2181,12 @@ var (
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               "Released",
 					Status:             metav1.ConditionTrue,
-					Reason:             helmv2beta2.InstallSucceededReason,
+					Reason:             helmv2.InstallSucceededReason,
 					Message:            "Helm install succeeded",
 				},
 			},
 			HelmChart:             "default/redis",
-			LastAppliedRevision:   "14.4.0",
+			History:               helmv2.Snapshots{{ChartVersion: "14.4.0", Status: release.StatusDeployed.String()}},
 			LastAttemptedRevision: "14.4.0",
 			ObservedGeneration:    1,
 		},
@@ -2275,18 +2275,18 @@ var (
 		PostInstallationNotes: "some notes",
 	}
 
-	flux_helm_release_basic = newFluxHelmRelease(helmv2beta2.HelmChartTemplateSpec{
+	flux_helm_release_basic = newFluxHelmRelease(helmv2.HelmChartTemplateSpec{
 		Chart: "podinfo",
-		SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+		SourceRef: helmv2.CrossNamespaceObjectReference{
 			Kind:      sourcev1beta2.HelmRepositoryKind,
 			Name:      "podinfo",
 			Namespace: "namespace-1",
 		},
 	})
 
-	flux_helm_release_semver_constraint = newFluxHelmRelease(helmv2beta2.HelmChartTemplateSpec{
+	flux_helm_release_semver_constraint = newFluxHelmRelease(helmv2.HelmChartTemplateSpec{
 		Chart: "podinfo",
-		SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+		SourceRef: helmv2.CrossNamespaceObjectReference{
 			Kind:      sourcev1beta2.HelmRepositoryKind,
 			Name:      "podinfo",
 			Namespace: "namespace-1",
@@ -2294,21 +2294,21 @@ var (
 		Version: "> 5",
 	})
 
-	flux_helm_release_reconcile_options = &helmv2beta2.HelmRelease{
+	flux_helm_release_reconcile_options = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-podinfo",
 			Namespace:       "test",
 			ResourceVersion: "1",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Chart: "podinfo",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "podinfo",
 						Namespace: "namespace-1",
@@ -2327,21 +2327,21 @@ var (
 				"message": "what we do in the shadows",
 			}})
 
-	flux_helm_release_values = &helmv2beta2.HelmRelease{
+	flux_helm_release_values = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-podinfo",
 			Namespace:       "test",
 			ResourceVersion: "1",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Chart: "podinfo",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "podinfo",
 						Namespace: "namespace-1",
@@ -2357,10 +2357,10 @@ var (
 		InstalledPackageRef: installedRef("my-podinfo", "test"),
 	}
 
-	flux_helm_release_updated_1 = &helmv2beta2.HelmRelease{
+	flux_helm_release_updated_1 = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-redis",
@@ -2368,11 +2368,11 @@ var (
 			Generation:      int64(1),
 			ResourceVersion: "1000",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Chart: "redis",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "bitnami-1",
 						Namespace: "default",
@@ -2384,10 +2384,10 @@ var (
 		},
 	}
 
-	flux_helm_release_updated_2 = &helmv2beta2.HelmRelease{
+	flux_helm_release_updated_2 = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-redis",
@@ -2395,11 +2395,11 @@ var (
 			Generation:      int64(1),
 			ResourceVersion: "1000",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Chart: "redis",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "bitnami-1",
 						Namespace: "default",
@@ -2424,7 +2424,7 @@ var (
 			Namespace:  "test",
 			Generation: 1,
 		},
-		releaseStatus: helmv2beta2.HelmReleaseStatus{
+		releaseStatus: helmv2.HelmReleaseStatus{
 			Conditions: []metav1.Condition{
 				{
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
@@ -2437,12 +2437,12 @@ var (
 					LastTransitionTime: metav1.Time{Time: lastTransitionTime},
 					Type:               "Released",
 					Status:             metav1.ConditionTrue,
-					Reason:             helmv2beta2.InstallSucceededReason,
+					Reason:             helmv2.InstallSucceededReason,
 					Message:            "Helm install succeeded",
 				},
 			},
 			HelmChart:             "default/redis",
-			LastAppliedRevision:   "14.4.0",
+			History:               helmv2.Snapshots{{ChartVersion: "14.4.0", Status: release.StatusDeployed.String()}},
 			LastAttemptedRevision: "14.4.0",
 			ObservedGeneration:    1,
 		},
@@ -2457,10 +2457,10 @@ var (
 		status:       release.StatusDeployed,
 	}
 
-	flux_helm_release_updated_target_ns_is_set = &helmv2beta2.HelmRelease{
+	flux_helm_release_updated_target_ns_is_set = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-redis",
@@ -2468,11 +2468,11 @@ var (
 			Generation:      int64(1),
 			ResourceVersion: "1000",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Chart: "redis",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "bitnami-1",
 						Namespace: "default",
@@ -2661,50 +2661,98 @@ var (
 		},
 	}
 
-	flux_helm_release_upgrade_policy_none = newFluxHelmRelease(helmv2beta2.HelmChartTemplateSpec{
+	flux_helm_release_upgrade_policy_none = newFluxHelmRelease(helmv2.HelmChartTemplateSpec{
 		Chart:   "podinfo",
 		Version: "5.2.1",
-		SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+		SourceRef: helmv2.CrossNamespaceObjectReference{
 			Kind:      sourcev1beta2.HelmRepositoryKind,
 			Name:      "podinfo",
 			Namespace: "namespace-1",
 		},
 	})
 
-	flux_helm_release_upgrade_policy_major = newFluxHelmRelease(helmv2beta2.HelmChartTemplateSpec{
+	flux_helm_release_upgrade_policy_major = newFluxHelmRelease(helmv2.HelmChartTemplateSpec{
 		Chart:   "podinfo",
 		Version: ">=5.2.1",
-		SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+		SourceRef: helmv2.CrossNamespaceObjectReference{
 			Kind:      sourcev1beta2.HelmRepositoryKind,
 			Name:      "podinfo",
 			Namespace: "namespace-1",
 		},
 	})
 
-	flux_helm_release_upgrade_policy_minor = newFluxHelmRelease(helmv2beta2.HelmChartTemplateSpec{
+	flux_helm_release_upgrade_policy_minor = newFluxHelmRelease(helmv2.HelmChartTemplateSpec{
 		Chart:   "podinfo",
 		Version: ">=5.2.1 <6.0.0",
-		SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+		SourceRef: helmv2.CrossNamespaceObjectReference{
 			Kind:      sourcev1beta2.HelmRepositoryKind,
 			Name:      "podinfo",
 			Namespace: "namespace-1",
 		},
 	})
 
-	flux_helm_release_upgrade_policy_patch = newFluxHelmRelease(helmv2beta2.HelmChartTemplateSpec{
+	flux_helm_release_upgrade_policy_patch = newFluxHelmRelease(helmv2.HelmChartTemplateSpec{
 		Chart:   "podinfo",
 		Version: ">=5.2.1 <5.3.0",
-		SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+		SourceRef: helmv2.CrossNamespaceObjectReference{
 			Kind:      sourcev1beta2.HelmRepositoryKind,
 			Name:      "podinfo",
 			Namespace: "namespace-1",
 		},
 	})
 
-	flux_helm_release_updated_upgrade_major = &helmv2beta2.HelmRelease{
+	create_package_custom_detail_req = func() *corev1.CreateInstalledPackageRequest {
+		customDetail, _ := anypb.New(&v1alpha1.FluxHelmReleaseCustomDetail{
+			DependsOn: []*v1alpha1.FluxDependencyReference{
+				{Name: "my-redis"},
+				{Name: "my-db", Namespace: "db"},
+			},
+			Install: &v1alpha1.FluxHelmReleaseInstall{
+				Crds:               "CreateReplace",
+				RemediationRetries: 3,
+			},
+			Upgrade: &v1alpha1.FluxHelmReleaseUpgrade{
+				RemediationRetries: -1,
+				CleanupOnFail:      true,
+			},
+		})
+
+		return &corev1.CreateInstalledPackageRequest{
+			AvailablePackageRef: availableRef("podinfo/podinfo", "namespace-1"),
+			Name:                "my-podinfo",
+			TargetContext:       &corev1.Context{Namespace: "test"},
+			CustomDetail:        customDetail,
+		}
+	}
+
+	flux_helm_release_custom_detail = func() *helmv2.HelmRelease {
+		rel := newFluxHelmRelease(helmv2.HelmChartTemplateSpec{
+			Chart: "podinfo",
+			SourceRef: helmv2.CrossNamespaceObjectReference{
+				Kind:      sourcev1beta2.HelmRepositoryKind,
+				Name:      "podinfo",
+				Namespace: "namespace-1",
+			},
+		})
+		rel.Spec.DependsOn = []fluxmeta.NamespacedObjectReference{
+			{Name: "my-redis"},
+			{Name: "my-db", Namespace: "db"},
+		}
+		rel.Spec.Install = &helmv2.Install{
+			CRDs:        helmv2.CreateReplace,
+			Remediation: &helmv2.InstallRemediation{Retries: 3},
+		}
+		rel.Spec.Upgrade = &helmv2.Upgrade{
+			CleanupOnFail: true,
+			Remediation:   &helmv2.UpgradeRemediation{Retries: -1},
+		}
+		return rel
+	}()
+
+	flux_helm_release_updated_upgrade_major = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-redis",
@@ -2712,12 +2760,12 @@ var (
 			Generation:      int64(1),
 			ResourceVersion: "1000",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Version: ">=14.4.0",
 					Chart:   "redis",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "bitnami-1",
 						Namespace: "default",
@@ -2728,10 +2776,10 @@ var (
 		},
 	}
 
-	flux_helm_release_updated_upgrade_minor = &helmv2beta2.HelmRelease{
+	flux_helm_release_updated_upgrade_minor = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-redis",
@@ -2739,12 +2787,12 @@ var (
 			Generation:      int64(1),
 			ResourceVersion: "1000",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Version: ">=14.4.0 <15.0.0",
 					Chart:   "redis",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "bitnami-1",
 						Namespace: "default",
@@ -2755,10 +2803,10 @@ var (
 		},
 	}
 
-	flux_helm_release_updated_upgrade_patch = &helmv2beta2.HelmRelease{
+	flux_helm_release_updated_upgrade_patch = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       helmv2beta2.HelmReleaseKind,
-			APIVersion: helmv2beta2.GroupVersion.String(),
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "my-redis",
@@ -2766,12 +2814,12 @@ var (
 			Generation:      int64(1),
 			ResourceVersion: "1000",
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Version: ">=14.4.0 <14.5.0",
 					Chart:   "redis",
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Name:      "bitnami-1",
 						Namespace: "default",
@@ -4027,19 +4075,19 @@ var (
 		}
 	}
 
-	newFluxHelmRelease = func(chartSpec helmv2beta2.HelmChartTemplateSpec) *helmv2beta2.HelmRelease {
-		return &helmv2beta2.HelmRelease{
+	newFluxHelmRelease = func(chartSpec helmv2.HelmChartTemplateSpec) *helmv2.HelmRelease {
+		return &helmv2.HelmRelease{
 			TypeMeta: metav1.TypeMeta{
-				Kind:       helmv2beta2.HelmReleaseKind,
-				APIVersion: helmv2beta2.GroupVersion.String(),
+				Kind:       helmv2.HelmReleaseKind,
+				APIVersion: helmv2.GroupVersion.String(),
 			},
 			ObjectMeta: metav1.ObjectMeta{
 				Name:            "my-podinfo",
 				Namespace:       "test",
 				ResourceVersion: "1",
 			},
-			Spec: helmv2beta2.HelmReleaseSpec{
-				Chart: helmv2beta2.HelmChartTemplate{
+			Spec: helmv2.HelmReleaseSpec{
+				Chart: &helmv2.HelmChartTemplate{
 					Spec: chartSpec,
 				},
 				Interval: metav1.Duration{Duration: 1 * time.Minute},
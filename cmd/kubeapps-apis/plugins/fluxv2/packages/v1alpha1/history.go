@@ -0,0 +1,186 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/bufbuild/connect-go"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	log "k8s.io/klog/v2"
+)
+
+// GetInstalledPackageHistory returns the reconciliation history recorded by flux
+// in the status of a HelmRelease, together with the related kubernetes events.
+func (s *Server) GetInstalledPackageHistory(ctx context.Context, request *connect.Request[v1alpha1.GetInstalledPackageHistoryRequest]) (*connect.Response[v1alpha1.GetInstalledPackageHistoryResponse], error) {
+	log.Infof("+fluxv2 GetInstalledPackageHistory [%v]", request)
+	packageRef := request.Msg.GetInstalledPackageRef()
+	if err := s.checkInstalledPackageRef(packageRef); err != nil {
+		return nil, err
+	}
+	if isKustomizationIdentifier(packageRef.GetIdentifier()) {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: the history of Kustomization [%s]", packageRef.GetIdentifier()))
+	}
+
+	key := types.NamespacedName{Namespace: packageRef.GetContext().GetNamespace(), Name: packageRef.GetIdentifier()}
+	rel, err := s.getReleaseInCluster(ctx, request.Header(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.releaseEvents(ctx, request.Header(), rel)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1alpha1.GetInstalledPackageHistoryResponse{
+		LastAttemptedReleaseAction: string(rel.Status.LastAttemptedReleaseAction),
+		LastAttemptedRevision:      rel.Status.LastAttemptedRevision,
+		Failures:                   rel.Status.Failures,
+		InstallFailures:            rel.Status.InstallFailures,
+		UpgradeFailures:            rel.Status.UpgradeFailures,
+		Events:                     events,
+	}
+	for _, snapshot := range rel.Status.History {
+		response.History = append(response.History, releaseSnapshot(snapshot))
+	}
+	for _, condition := range rel.Status.Conditions {
+		response.Conditions = append(response.Conditions, &v1alpha1.FluxCondition{
+			Type:               condition.Type,
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: timestampOrNil(condition.LastTransitionTime),
+		})
+	}
+	return connect.NewResponse(response), nil
+}
+
+// releaseEvents returns the events emitted by the helm-controller for the
+// HelmRelease and by the source-controller for its HelmChart, most recent
+// first. The events of a previous HelmRelease of the same name are dropped, as
+// are the events the user is not allowed to list.
+func (s *Server) releaseEvents(ctx context.Context, headers http.Header, rel *helmv2.HelmRelease) ([]*v1alpha1.FluxEvent, error) {
+	events, err := s.listEventsInCluster(ctx, headers, rel.Namespace, helmv2.HelmReleaseKind, rel.Name)
+	if err != nil {
+		return nil, err
+	}
+	result := []*v1alpha1.FluxEvent{}
+	for _, event := range events {
+		if rel.UID != "" && event.InvolvedObject.UID != "" && event.InvolvedObject.UID != rel.UID {
+			continue
+		}
+		result = append(result, fluxEvent(event))
+	}
+
+	if chartNamespace, chartName := rel.Status.GetHelmChart(); chartName != "" {
+		events, err = s.listEventsInCluster(ctx, headers, chartNamespace, sourcev1beta2.HelmChartKind, chartName)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			result = append(result, fluxEvent(event))
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].GetLastTimestamp().AsTime().After(result[j].GetLastTimestamp().AsTime())
+	})
+	return result, nil
+}
+
+func (s *Server) listEventsInCluster(ctx context.Context, headers http.Header, namespace, kind, name string) ([]apiv1.Event, error) {
+	typedClient, err := s.clientGetter.Typed(headers, s.kubeappsCluster)
+	if err != nil {
+		return nil, err
+	}
+	selector := fields.Set{
+		"involvedObject.kind": kind,
+		"involvedObject.name": name,
+	}.AsSelector().String()
+	eventList, err := typedClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		// the history is still useful without the events, which the user may not
+		// be allowed to list, e.g. in the namespace of the HelmCharts
+		if errors.IsForbidden(err) {
+			log.V(4).Infof("Skipping the events of %s [%s/%s] due to: %v", kind, namespace, name, err)
+			return nil, nil
+		}
+		return nil, connecterror.FromK8sError("list", "Event", fmt.Sprintf("%s/%s", namespace, name), err)
+	}
+	return eventList.Items, nil
+}
+
+func releaseSnapshot(snapshot *helmv2.Snapshot) *v1alpha1.FluxReleaseSnapshot {
+	return &v1alpha1.FluxReleaseSnapshot{
+		Version:       int32(snapshot.Version),
+		Status:        snapshot.Status,
+		ChartName:     snapshot.ChartName,
+		ChartVersion:  snapshot.ChartVersion,
+		ConfigDigest:  snapshot.ConfigDigest,
+		FirstDeployed: timestampOrNil(snapshot.FirstDeployed),
+		LastDeployed:  timestampOrNil(snapshot.LastDeployed),
+		Deleted:       timestampOrNil(snapshot.Deleted),
+	}
+}
+
+func fluxEvent(event apiv1.Event) *v1alpha1.FluxEvent {
+	source := event.ReportingController
+	if source == "" {
+		source = event.Source.Component
+	}
+	count := event.Count
+	if count == 0 && event.Series != nil {
+		count = event.Series.Count
+	}
+	return &v1alpha1.FluxEvent{
+		Type:               event.Type,
+		Reason:             event.Reason,
+		Message:            event.Message,
+		InvolvedObjectKind: event.InvolvedObject.Kind,
+		InvolvedObjectName: event.InvolvedObject.Name,
+		Source:             source,
+		Count:              count,
+		FirstTimestamp:     timestampOrNil(eventFirstTimestamp(event)),
+		LastTimestamp:      timestampOrNil(eventLastTimestamp(event)),
+	}
+}
+
+// events recorded via the events.k8s.io API only set the event time, with the
+// last occurrence of a series of events in the series
+func eventFirstTimestamp(event apiv1.Event) metav1.Time {
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp
+	}
+	return metav1.Time{Time: event.EventTime.Time}
+}
+
+func eventLastTimestamp(event apiv1.Event) metav1.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	if event.Series != nil && !event.Series.LastObservedTime.IsZero() {
+		return metav1.Time{Time: event.Series.LastObservedTime.Time}
+	}
+	return eventFirstTimestamp(event)
+}
+
+func timestampOrNil(t metav1.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
@@ -0,0 +1,240 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGetInstalledPackageHistory(t *testing.T) {
+	deployed := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	failed := deployed.Add(time.Hour)
+	pulled := failed.Add(time.Minute)
+
+	rel := newRelease(
+		metav1.ObjectMeta{Name: "my-podinfo", Namespace: "test", UID: "release-uid", Generation: 2},
+		nil,
+		&helmv2.HelmReleaseStatus{
+			ObservedGeneration: 2,
+			Conditions: []metav1.Condition{
+				{
+					Type:               fluxmeta.ReadyCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             helmv2.UpgradeFailedReason,
+					Message:            "Helm upgrade failed: timed out waiting for the condition",
+					LastTransitionTime: metav1.NewTime(failed),
+				},
+			},
+			HelmChart: "flux-system/test-my-podinfo",
+			History: helmv2.Snapshots{
+				{
+					Version:       2,
+					Status:        "failed",
+					ChartName:     "podinfo",
+					ChartVersion:  "6.1.0",
+					ConfigDigest:  "sha256:def",
+					FirstDeployed: metav1.NewTime(deployed),
+					LastDeployed:  metav1.NewTime(failed),
+				},
+				{
+					Version:       1,
+					Status:        "superseded",
+					ChartName:     "podinfo",
+					ChartVersion:  "6.0.0",
+					ConfigDigest:  "sha256:abc",
+					FirstDeployed: metav1.NewTime(deployed),
+					LastDeployed:  metav1.NewTime(deployed),
+				},
+			},
+			LastAttemptedReleaseAction: helmv2.ReleaseActionUpgrade,
+			LastAttemptedRevision:      "6.1.0",
+			Failures:                   2,
+			UpgradeFailures:            1,
+		})
+
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, []helmv2.HelmRelease{rel})
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+	typedClient, err := s.clientGetter.Typed(http.Header{}, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for _, event := range []*apiv1.Event{
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "my-podinfo.1", Namespace: "test"},
+			InvolvedObject: apiv1.ObjectReference{Kind: helmv2.HelmReleaseKind, Name: "my-podinfo", UID: "release-uid"},
+			Type:           apiv1.EventTypeWarning,
+			Reason:         "UpgradeFailed",
+			Message:        "Helm upgrade failed for release test/my-podinfo",
+			Source:         apiv1.EventSource{Component: "helm-controller"},
+			Count:          2,
+			FirstTimestamp: metav1.NewTime(deployed),
+			LastTimestamp:  metav1.NewTime(failed),
+		},
+		{
+			// emitted for a previous HelmRelease of the same name
+			ObjectMeta:     metav1.ObjectMeta{Name: "my-podinfo.2", Namespace: "test"},
+			InvolvedObject: apiv1.ObjectReference{Kind: helmv2.HelmReleaseKind, Name: "my-podinfo", UID: "previous-uid"},
+			Type:           apiv1.EventTypeNormal,
+			Reason:         "InstallSucceeded",
+			LastTimestamp:  metav1.NewTime(deployed),
+		},
+		{
+			ObjectMeta:          metav1.ObjectMeta{Name: "test-my-podinfo.1", Namespace: "flux-system"},
+			InvolvedObject:      apiv1.ObjectReference{Kind: sourcev1beta2.HelmChartKind, Name: "test-my-podinfo"},
+			Type:                apiv1.EventTypeNormal,
+			Reason:              "ChartPullSucceeded",
+			Message:             "pulled 'podinfo' chart with version '6.1.0'",
+			ReportingController: "source-controller",
+			EventTime:           metav1.NewMicroTime(pulled),
+		},
+	} {
+		if _, err = typedClient.CoreV1().Events(event.Namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	response, err := s.GetInstalledPackageHistory(ctx, connect.NewRequest(&v1alpha1.GetInstalledPackageHistoryRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &v1alpha1.GetInstalledPackageHistoryResponse{
+		History: []*v1alpha1.FluxReleaseSnapshot{
+			{
+				Version:       2,
+				Status:        "failed",
+				ChartName:     "podinfo",
+				ChartVersion:  "6.1.0",
+				ConfigDigest:  "sha256:def",
+				FirstDeployed: timestamppb.New(deployed),
+				LastDeployed:  timestamppb.New(failed),
+			},
+			{
+				Version:       1,
+				Status:        "superseded",
+				ChartName:     "podinfo",
+				ChartVersion:  "6.0.0",
+				ConfigDigest:  "sha256:abc",
+				FirstDeployed: timestamppb.New(deployed),
+				LastDeployed:  timestamppb.New(deployed),
+			},
+		},
+		Conditions: []*v1alpha1.FluxCondition{
+			{
+				Type:               fluxmeta.ReadyCondition,
+				Status:             "False",
+				Reason:             helmv2.UpgradeFailedReason,
+				Message:            "Helm upgrade failed: timed out waiting for the condition",
+				LastTransitionTime: timestamppb.New(failed),
+			},
+		},
+		LastAttemptedReleaseAction: "upgrade",
+		LastAttemptedRevision:      "6.1.0",
+		Failures:                   2,
+		UpgradeFailures:            1,
+		Events: []*v1alpha1.FluxEvent{
+			{
+				Type:               apiv1.EventTypeNormal,
+				Reason:             "ChartPullSucceeded",
+				Message:            "pulled 'podinfo' chart with version '6.1.0'",
+				InvolvedObjectKind: sourcev1beta2.HelmChartKind,
+				InvolvedObjectName: "test-my-podinfo",
+				Source:             "source-controller",
+				FirstTimestamp:     timestamppb.New(pulled),
+				LastTimestamp:      timestamppb.New(pulled),
+			},
+			{
+				Type:               apiv1.EventTypeWarning,
+				Reason:             "UpgradeFailed",
+				Message:            "Helm upgrade failed for release test/my-podinfo",
+				InvolvedObjectKind: helmv2.HelmReleaseKind,
+				InvolvedObjectName: "my-podinfo",
+				Source:             "helm-controller",
+				Count:              2,
+				FirstTimestamp:     timestamppb.New(deployed),
+				LastTimestamp:      timestamppb.New(failed),
+			},
+		},
+	}
+	if got, want := response.Msg, expected; !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+	}
+
+	_, err = s.GetInstalledPackageHistory(ctx, connect.NewRequest(&v1alpha1.GetInstalledPackageHistoryRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "other"),
+	}))
+	if got, want := connect.CodeOf(err), connect.CodeNotFound; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	_, err = s.GetInstalledPackageHistory(ctx, connect.NewRequest(&v1alpha1.GetInstalledPackageHistoryRequest{
+		InstalledPackageRef: installedRef(kustomizationIdentifier("my-podinfo"), "test"),
+	}))
+	if got, want := connect.CodeOf(err), connect.CodeUnimplemented; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestGetInstalledPackageHistoryWithoutEventsAccess(t *testing.T) {
+	rel := newRelease(
+		metav1.ObjectMeta{Name: "my-podinfo", Namespace: "test", UID: "release-uid", Generation: 1},
+		nil,
+		&helmv2.HelmReleaseStatus{
+			ObservedGeneration: 1,
+			HelmChart:          "flux-system/test-my-podinfo",
+			History: helmv2.Snapshots{
+				{Version: 1, Status: "deployed", ChartName: "podinfo", ChartVersion: "6.0.0"},
+			},
+		})
+
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, []helmv2.HelmRelease{rel})
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+	typedClient, err := s.clientGetter.Typed(http.Header{}, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	typedClient.(*typfake.Clientset).PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(schema.GroupResource{Resource: "events"}, "", fmt.Errorf("forbidden"))
+	})
+
+	response, err := s.GetInstalledPackageHistory(context.Background(), connect.NewRequest(&v1alpha1.GetInstalledPackageHistoryRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(response.Msg.GetHistory()), 1; got != want {
+		t.Errorf("got: %d snapshots, want: %d", got, want)
+	}
+	if got := response.Msg.GetEvents(); len(got) != 0 {
+		t.Errorf("got: %v, want no events", got)
+	}
+}
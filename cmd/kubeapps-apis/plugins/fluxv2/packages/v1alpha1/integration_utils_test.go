@@ -18,7 +18,7 @@ import (
 	"testing"
 	"time"
 
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/go-redis/redis/v8"
@@ -413,7 +413,7 @@ func kubeDeleteHelmRelease(t *testing.T, name types.NamespacedName) error {
 	t.Logf("+kubeDeleteHelmRelease(%s)", name)
 	ctx, cancel := context.WithTimeout(context.Background(), defaultContextTimeout)
 	defer cancel()
-	release := &helmv2beta2.HelmRelease{
+	release := &helmv2.HelmRelease{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name.Name,
 			Namespace: name.Namespace,
@@ -430,7 +430,7 @@ func kubeExistsHelmRelease(t *testing.T, name types.NamespacedName) (bool, error
 	t.Logf("+kubeExistsHelmRelease(%s)", name)
 	ctx, cancel := context.WithTimeout(context.Background(), defaultContextTimeout)
 	defer cancel()
-	var rel helmv2beta2.HelmRelease
+	var rel helmv2.HelmRelease
 	if ifc, err := kubeGetCtrlClient(); err != nil {
 		return false, err
 	} else if err = ifc.Get(ctx, name, &rel); err == nil {
@@ -1032,7 +1032,7 @@ func kubeGetCtrlClient() (ctrlclient.WithWatch, error) {
 			if err != nil {
 				return nil, err
 			}
-			err = helmv2beta2.AddToScheme(scheme)
+			err = helmv2.AddToScheme(scheme)
 			if err != nil {
 				return nil, err
 			}
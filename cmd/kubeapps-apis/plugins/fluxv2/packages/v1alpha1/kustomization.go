@@ -0,0 +1,452 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	log "k8s.io/klog/v2"
+)
+
+// Besides HelmReleases, the flux Kustomizations applying the manifests of a
+// GitRepository or a Bucket are exposed as installed packages. As both kinds
+// may share a name in a namespace, the identifiers of the Kustomizations are
+// prefixed to tell them apart. The prefix can't clash with a HelmRelease
+// name, as "_" is not allowed in the names of kubernetes objects.
+// ref https://fluxcd.io/flux/components/kustomize/kustomizations/
+const (
+	kustomizationIdentifierPrefix = "kustomization_"
+
+	// the inventory entries are identified by "<namespace>_<name>_<group>_<kind>",
+	// with the colons of the name replaced by a double underscore
+	// ref https://fluxcd.io/flux/components/kustomize/kustomizations/#inventory
+	inventoryFieldSeparator  = "_"
+	inventoryColonTranslator = "__"
+)
+
+func isKustomizationIdentifier(identifier string) bool {
+	return strings.HasPrefix(identifier, kustomizationIdentifierPrefix)
+}
+
+func kustomizationIdentifier(name string) string {
+	return kustomizationIdentifierPrefix + name
+}
+
+func kustomizationKey(packageRef *corev1.InstalledPackageReference) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: packageRef.GetContext().GetNamespace(),
+		Name:      strings.TrimPrefix(packageRef.GetIdentifier(), kustomizationIdentifierPrefix),
+	}
+}
+
+// isKustomizationCustomDetail returns whether the custom detail of a create
+// request asks for a Kustomization rather than a HelmRelease
+func isKustomizationCustomDetail(customDetail *anypb.Any) bool {
+	return customDetail != nil && customDetail.MessageIs(&v1alpha1.FluxKustomizationCustomDetail{})
+}
+
+// namespace maybe "", in which case Kustomizations from all namespaces are returned.
+// The Kustomizations are optional, so none are returned when the CRD is not
+// installed or the caller is not allowed to list them.
+func (s *Server) listKustomizationsInCluster(ctx context.Context, headers http.Header, namespace string) ([]kustomizev1.Kustomization, error) {
+	client, err := s.getClient(headers, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var ksList kustomizev1.KustomizationList
+	if err = client.List(ctx, &ksList); err != nil {
+		if meta.IsNoMatchError(err) || errors.IsForbidden(err) {
+			log.V(4).Infof("Skipping the Kustomizations in namespace [%s] due to: %v", namespace, err)
+			return nil, nil
+		}
+		return nil, connecterror.FromK8sError("list", kustomizev1.KustomizationKind, namespace+"/*", err)
+	}
+	return ksList.Items, nil
+}
+
+func (s *Server) getKustomizationInCluster(ctx context.Context, headers http.Header, key types.NamespacedName) (*kustomizev1.Kustomization, error) {
+	client, err := s.getClient(headers, key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var ks kustomizev1.Kustomization
+	if err = client.Get(ctx, key, &ks); err != nil {
+		return nil, connecterror.FromK8sError("get", kustomizev1.KustomizationKind, key.String(), err)
+	}
+	return &ks, nil
+}
+
+func (s *Server) installedPkgSummaryFromKustomization(ks kustomizev1.Kustomization) *corev1.InstalledPackageSummary {
+	return &corev1.InstalledPackageSummary{
+		InstalledPackageRef: s.kustomizationInstalledPackageRef(ks.Namespace, ks.Name),
+		Name:                ks.Name,
+		CurrentVersion: &corev1.PackageAppVersion{
+			PkgVersion: kustomizationRevision(ks),
+		},
+		PkgDisplayName:   ks.Name,
+		ShortDescription: kustomizationDescription(ks),
+		Status:           kustomizationStatus(ks),
+	}
+}
+
+func (s *Server) kustomizationDetail(ctx context.Context, headers http.Header, key types.NamespacedName) (*corev1.InstalledPackageDetail, error) {
+	ks, err := s.getKustomizationInCluster(ctx, headers, key)
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(4).Infof("Kustomization from kustomizationDetail:\n[%s]", common.PrettyPrint(ks))
+
+	sourceNamespace := ks.Spec.SourceRef.Namespace
+	// CrossNamespaceSourceReference namespace is optional
+	if sourceNamespace == "" {
+		sourceNamespace = ks.Namespace
+	}
+
+	customDetail, err := anypb.New(kustomizationCustomDetail(ks))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("The customDetail could not be marshalled due to: %w", err))
+	}
+
+	return &corev1.InstalledPackageDetail{
+		InstalledPackageRef: s.kustomizationInstalledPackageRef(key.Namespace, key.Name),
+		Name:                key.Name,
+		CurrentVersion: &corev1.PackageAppVersion{
+			PkgVersion: kustomizationRevision(*ks),
+		},
+		ReconciliationOptions: &corev1.ReconciliationOptions{
+			Interval:           pkgutils.FromDuration(&ks.Spec.Interval),
+			Suspend:            ks.Spec.Suspend,
+			ServiceAccountName: ks.Spec.ServiceAccountName,
+		},
+		AvailablePackageRef: &corev1.AvailablePackageReference{
+			Identifier: ks.Spec.SourceRef.Name,
+			Plugin:     GetPluginDetail(),
+			Context: &corev1.Context{
+				Namespace: sourceNamespace,
+				Cluster:   s.kubeappsCluster,
+			},
+		},
+		Status:       kustomizationStatus(*ks),
+		CustomDetail: customDetail,
+	}, nil
+}
+
+// newKustomization creates a Kustomization applying the manifests of the
+// GitRepository or Bucket referenced by the available package. Kustomizations
+// are neither versioned nor configured with values.
+func (s *Server) newKustomization(ctx context.Context, headers http.Header, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string, customDetail *anypb.Any) (*corev1.InstalledPackageReference, error) {
+	if err := validateKustomizationRequest(versionRef, valuesString); err != nil {
+		return nil, err
+	}
+	detail, err := kustomizationCustomDetailFromAny(customDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceKey := types.NamespacedName{Namespace: packageRef.GetContext().GetNamespace(), Name: packageRef.GetIdentifier()}
+	src, err := s.getChartSourceInCluster(ctx, headers, sourceKey)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := src.(*sourcev1beta2.OCIRepository); ok {
+		// the artifact of an OCIRepository is a chart rather than manifests
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Kustomizations of repositories of type [%s] are not supported", ociRepositoryType))
+	}
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      targetName.Name,
+			Namespace: targetName.Namespace,
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind:      chartSourceKind(src),
+				Name:      src.GetName(),
+				Namespace: src.GetNamespace(),
+			},
+			// required field, without which flux controller will fail to create the CRD
+			Interval: defaultReconcileInterval,
+		},
+	}
+	applyKustomizationCustomDetail(ks, detail)
+	if err = applyKustomizationReconciliationOptions(ks, reconcile); err != nil {
+		return nil, err
+	}
+	if s.pluginConfig.TimeoutSeconds > 0 {
+		ks.Spec.Timeout = &metav1.Duration{Duration: time.Duration(s.pluginConfig.TimeoutSeconds) * time.Second}
+	}
+
+	client, err := s.getClient(headers, targetName.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Create(ctx, ks); err != nil {
+		return nil, connecterror.FromK8sError("create", kustomizev1.KustomizationKind, targetName.String(), err)
+	}
+
+	return s.kustomizationInstalledPackageRef(targetName.Namespace, targetName.Name), nil
+}
+
+// updateKustomization updates the reconciliation options of a Kustomization
+// and, when a custom detail is provided, the path, prune and target namespace
+func (s *Server) updateKustomization(ctx context.Context, headers http.Header, packageRef *corev1.InstalledPackageReference, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string, customDetail *anypb.Any) (*corev1.InstalledPackageReference, error) {
+	if err := validateKustomizationRequest(versionRef, valuesString); err != nil {
+		return nil, err
+	}
+
+	key := kustomizationKey(packageRef)
+	ks, err := s.getKustomizationInCluster(ctx, headers, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// same as for HelmReleases, updates to pending Kustomizations are not supported
+	_, reason, _ := isKustomizationReady(*ks)
+	if reason == corev1.InstalledPackageStatus_STATUS_REASON_PENDING {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Updates to kustomizations pending reconciliation are not supported"))
+	}
+
+	if customDetail != nil {
+		detail, err := kustomizationCustomDetailFromAny(customDetail)
+		if err != nil {
+			return nil, err
+		}
+		applyKustomizationCustomDetail(ks, detail)
+	}
+
+	ks.Spec.Interval = defaultReconcileInterval
+	ks.Spec.ServiceAccountName = ""
+	if err = applyKustomizationReconciliationOptions(ks, reconcile); err != nil {
+		return nil, err
+	}
+
+	// the current status no longer applies, see updateRelease()
+	ks.Status = kustomizev1.KustomizationStatus{}
+
+	client, err := s.getClient(headers, key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Update(ctx, ks); err != nil {
+		return nil, connecterror.FromK8sError("update", kustomizev1.KustomizationKind, key.String(), err)
+	}
+
+	log.V(4).Infof("Updated kustomization: %s", common.PrettyPrint(ks))
+
+	return s.kustomizationInstalledPackageRef(key.Namespace, key.Name), nil
+}
+
+func (s *Server) deleteKustomization(ctx context.Context, headers http.Header, packageRef *corev1.InstalledPackageReference) error {
+	key := kustomizationKey(packageRef)
+	client, err := s.getClient(headers, key.Namespace)
+	if err != nil {
+		return err
+	}
+
+	log.V(4).Infof("Deleting kustomization: [%s]", key)
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+	if err = client.Delete(ctx, ks); err != nil {
+		return connecterror.FromK8sError("delete", kustomizev1.KustomizationKind, key.String(), err)
+	}
+	return nil
+}
+
+// kustomizationResourceRefs returns the references of the resources applied by
+// a Kustomization, as recorded in its inventory
+func (s *Server) kustomizationResourceRefs(ctx context.Context, headers http.Header, key types.NamespacedName) ([]*corev1.ResourceRef, error) {
+	ks, err := s.getKustomizationInCluster(ctx, headers, key)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []*corev1.ResourceRef{}
+	if ks.Status.Inventory == nil {
+		return refs, nil
+	}
+	for _, entry := range ks.Status.Inventory.Entries {
+		ref, err := resourceRefFromInventoryEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func (s *Server) kustomizationInstalledPackageRef(namespace, name string) *corev1.InstalledPackageReference {
+	return &corev1.InstalledPackageReference{
+		Context: &corev1.Context{
+			Namespace: namespace,
+			Cluster:   s.kubeappsCluster,
+		},
+		Identifier: kustomizationIdentifier(name),
+		Plugin:     GetPluginDetail(),
+	}
+}
+
+func validateKustomizationRequest(versionRef *corev1.VersionReference, valuesString string) error {
+	if versionRef.GetVersion() != "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("A version cannot be specified for a kustomization"))
+	} else if valuesString != "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Values cannot be specified for a kustomization"))
+	}
+	return nil
+}
+
+func kustomizationCustomDetailFromAny(customDetail *anypb.Any) (*v1alpha1.FluxKustomizationCustomDetail, error) {
+	detail := &v1alpha1.FluxKustomizationCustomDetail{}
+	if customDetail != nil {
+		if err := customDetail.UnmarshalTo(detail); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The customDetail could not be parsed due to: %w", err))
+		}
+	}
+	return detail, nil
+}
+
+func applyKustomizationCustomDetail(ks *kustomizev1.Kustomization, detail *v1alpha1.FluxKustomizationCustomDetail) {
+	ks.Spec.Path = detail.GetPath()
+	ks.Spec.Prune = detail.GetPrune()
+	ks.Spec.TargetNamespace = detail.GetTargetNamespace()
+}
+
+func applyKustomizationReconciliationOptions(ks *kustomizev1.Kustomization, reconcile *corev1.ReconciliationOptions) error {
+	if reconcile == nil {
+		return nil
+	}
+	if reconcile.Interval != "" {
+		reconcileInterval, err := pkgutils.ToDuration(reconcile.Interval)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The reconciliation interval is invalid: %w", err))
+		}
+		ks.Spec.Interval = *reconcileInterval
+	}
+	if reconcile.ServiceAccountName != "" {
+		ks.Spec.ServiceAccountName = reconcile.ServiceAccountName
+	}
+	ks.Spec.Suspend = reconcile.Suspend
+	return nil
+}
+
+func kustomizationCustomDetail(ks *kustomizev1.Kustomization) *v1alpha1.FluxKustomizationCustomDetail {
+	return &v1alpha1.FluxKustomizationCustomDetail{
+		Path:            ks.Spec.Path,
+		Prune:           ks.Spec.Prune,
+		TargetNamespace: ks.Spec.TargetNamespace,
+	}
+}
+
+// the revision of the source last applied, e.g. "main@sha1:<commit>", or the one
+// being attempted if the reconciliation is in progress or has failed
+func kustomizationRevision(ks kustomizev1.Kustomization) string {
+	if ks.Status.LastAppliedRevision != "" {
+		return ks.Status.LastAppliedRevision
+	}
+	return ks.Status.LastAttemptedRevision
+}
+
+func kustomizationDescription(ks kustomizev1.Kustomization) string {
+	path := ks.Spec.Path
+	if path == "" {
+		path = "./"
+	}
+	return fmt.Sprintf("Kustomization of path [%s] from %s", path, ks.Spec.SourceRef.String())
+}
+
+// returns whether the Kustomization is ready, the status reason and the user
+// reason, the same way as isHelmReleaseReady() does for HelmReleases
+func isKustomizationReady(ks kustomizev1.Kustomization) (ready bool, status corev1.InstalledPackageStatus_StatusReason, userReason string) {
+	generation := ks.GetGeneration()
+	if generation <= 0 || generation != ks.Status.ObservedGeneration {
+		return false,
+			corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+			"Flux Kustomization resource is in a transient state"
+	}
+
+	readyCond := meta.FindStatusCondition(ks.GetConditions(), fluxmeta.ReadyCondition)
+	if readyCond == nil {
+		return false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING, ""
+	}
+
+	userReason = readyCond.Reason
+	if readyCond.Message != "" {
+		userReason += ": " + readyCond.Message
+	}
+	if readyCond.Status == metav1.ConditionTrue {
+		return true, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED, userReason
+	}
+	switch readyCond.Reason {
+	case kustomizev1.ReconciliationFailedReason,
+		kustomizev1.BuildFailedReason,
+		kustomizev1.HealthCheckFailedReason,
+		kustomizev1.PruneFailedReason,
+		kustomizev1.ArtifactFailedReason:
+		return false, corev1.InstalledPackageStatus_STATUS_REASON_FAILED, userReason
+	}
+	return false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING, userReason
+}
+
+func kustomizationStatus(ks kustomizev1.Kustomization) *corev1.InstalledPackageStatus {
+	ready, reason, userReason := isKustomizationReady(ks)
+	return &corev1.InstalledPackageStatus{
+		Ready:      ready,
+		Reason:     reason,
+		UserReason: userReason,
+	}
+}
+
+// resourceRefFromInventoryEntry parses an inventory entry, such as
+// "default_podinfo_apps_Deployment" with version "v1"
+func resourceRefFromInventoryEntry(entry kustomizev1.ResourceRef) (*corev1.ResourceRef, error) {
+	id := entry.ID
+	first := strings.Index(id, inventoryFieldSeparator)
+	last := strings.LastIndex(id, inventoryFieldSeparator)
+	if first < 0 || last <= first {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to parse the inventory entry [%s]", id))
+	}
+	namespace, kind := id[:first], id[last+1:]
+	rest := id[first+1 : last]
+	sep := strings.LastIndex(rest, inventoryFieldSeparator)
+	if sep < 0 || kind == "" {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to parse the inventory entry [%s]", id))
+	}
+	name, group := strings.ReplaceAll(rest[:sep], inventoryColonTranslator, ":"), rest[sep+1:]
+
+	apiVersion := entry.Version
+	if group != "" {
+		apiVersion = group + "/" + entry.Version
+	}
+	return &corev1.ResourceRef{
+		ApiVersion: apiVersion,
+		Kind:       kind,
+		Name:       name,
+		Namespace:  namespace,
+	}, nil
+}
@@ -0,0 +1,309 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestResourceRefFromInventoryEntry(t *testing.T) {
+	testCases := []struct {
+		name     string
+		entry    kustomizev1.ResourceRef
+		expected *corev1.ResourceRef
+	}{
+		{
+			name:  "namespaced resource",
+			entry: kustomizev1.ResourceRef{ID: "default_podinfo_apps_Deployment", Version: "v1"},
+			expected: &corev1.ResourceRef{
+				ApiVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "podinfo",
+				Namespace:  "default",
+			},
+		},
+		{
+			name:  "resource of the core group",
+			entry: kustomizev1.ResourceRef{ID: "default_podinfo__Service", Version: "v1"},
+			expected: &corev1.ResourceRef{
+				ApiVersion: "v1",
+				Kind:       "Service",
+				Name:       "podinfo",
+				Namespace:  "default",
+			},
+		},
+		{
+			name:  "cluster-scoped resource with a colon in its name",
+			entry: kustomizev1.ResourceRef{ID: "_system__podinfo_rbac.authorization.k8s.io_ClusterRole", Version: "v1"},
+			expected: &corev1.ResourceRef{
+				ApiVersion: "rbac.authorization.k8s.io/v1",
+				Kind:       "ClusterRole",
+				Name:       "system:podinfo",
+			},
+		},
+		{
+			name:  "invalid entry",
+			entry: kustomizev1.ResourceRef{ID: "podinfo_Deployment", Version: "v1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, err := resourceRefFromInventoryEntry(tc.entry)
+			if tc.expected == nil {
+				if got, want := connect.CodeOf(err), connect.CodeInternal; got != want {
+					t.Fatalf("got: %v, want: %v", got, want)
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := ref, tc.expected; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestIsKustomizationReady(t *testing.T) {
+	testCases := []struct {
+		name           string
+		observed       int64
+		conditions     []metav1.Condition
+		expectedReady  bool
+		expectedReason corev1.InstalledPackageStatus_StatusReason
+	}{
+		{
+			name:           "transient state",
+			observed:       0,
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+		},
+		{
+			name:     "applied",
+			observed: 1,
+			conditions: []metav1.Condition{
+				{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionTrue, Reason: kustomizev1.ReconciliationSucceededReason},
+			},
+			expectedReady:  true,
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+		},
+		{
+			name:     "health check failed",
+			observed: 1,
+			conditions: []metav1.Condition{
+				{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionFalse, Reason: kustomizev1.HealthCheckFailedReason},
+			},
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+		},
+		{
+			name:     "waiting for a dependency",
+			observed: 1,
+			conditions: []metav1.Condition{
+				{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionFalse, Reason: kustomizev1.DependencyNotReadyReason},
+			},
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ks := kustomizev1.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status: kustomizev1.KustomizationStatus{
+					ObservedGeneration: tc.observed,
+					Conditions:         tc.conditions,
+				},
+			}
+			ready, reason, _ := isKustomizationReady(ks)
+			if ready != tc.expectedReady || reason != tc.expectedReason {
+				t.Errorf("got: (%v, %v), want: (%v, %v)", ready, reason, tc.expectedReady, tc.expectedReason)
+			}
+		})
+	}
+}
+
+func TestKustomizationInstalledPackage(t *testing.T) {
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+	ctx := context.Background()
+	ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ctrlClient.Create(ctx, newReadyGitRepository("podinfo", "flux-system", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	customDetail, err := anypb.New(&v1alpha1.FluxKustomizationCustomDetail{
+		Path:            "./kustomize",
+		Prune:           true,
+		TargetNamespace: "test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// values are not supported by kustomizations
+	_, err = s.CreateInstalledPackage(ctx, connect.NewRequest(&corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: availableRef("podinfo", "flux-system"),
+		Name:                "podinfo",
+		TargetContext:       &corev1.Context{Namespace: "test"},
+		Values:              "replicaCount: 2",
+		CustomDetail:        customDetail,
+	}))
+	if got, want := connect.CodeOf(err), connect.CodeInvalidArgument; got != want {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+
+	created, err := s.CreateInstalledPackage(ctx, connect.NewRequest(&corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef:   availableRef("podinfo", "flux-system"),
+		Name:                  "podinfo",
+		TargetContext:         &corev1.Context{Namespace: "test"},
+		ReconciliationOptions: &corev1.ReconciliationOptions{Interval: "5m"},
+		CustomDetail:          customDetail,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkgRef := created.Msg.InstalledPackageRef
+	if got, want := pkgRef, installedRef("kustomization_podinfo", "test"); !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Fatalf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+	}
+
+	key := types.NamespacedName{Name: "podinfo", Namespace: "test"}
+	var ks kustomizev1.Kustomization
+	if err = ctrlClient.Get(ctx, key, &ks); err != nil {
+		t.Fatal(err)
+	}
+	expectedSpec := kustomizev1.KustomizationSpec{
+		SourceRef: kustomizev1.CrossNamespaceSourceReference{
+			Kind:      sourcev1.GitRepositoryKind,
+			Name:      "podinfo",
+			Namespace: "flux-system",
+		},
+		Path:            "./kustomize",
+		Prune:           true,
+		TargetNamespace: "test",
+		Interval:        metav1.Duration{Duration: 5 * time.Minute},
+	}
+	if got, want := ks.Spec, expectedSpec; !cmp.Equal(want, got) {
+		t.Fatalf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// flux applies the kustomization
+	ks.Generation = 1
+	ks.Status = kustomizev1.KustomizationStatus{
+		ObservedGeneration:  1,
+		LastAppliedRevision: "master@sha1:0123",
+		Conditions: []metav1.Condition{
+			{
+				Type:    fluxmeta.ReadyCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  kustomizev1.ReconciliationSucceededReason,
+				Message: "Applied revision: master@sha1:0123",
+			},
+		},
+		Inventory: &kustomizev1.ResourceInventory{
+			Entries: []kustomizev1.ResourceRef{
+				{ID: "test_podinfo_apps_Deployment", Version: "v1"},
+				{ID: "test_podinfo__Service", Version: "v1"},
+			},
+		},
+	}
+	if err = ctrlClient.Update(ctx, &ks); err != nil {
+		t.Fatal(err)
+	}
+
+	summaries, err := s.GetInstalledPackageSummaries(ctx, connect.NewRequest(&corev1.GetInstalledPackageSummariesRequest{
+		Context: &corev1.Context{Namespace: "test"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(summaries.Msg.InstalledPackageSummaries), 1; got != want {
+		t.Fatalf("got: %d summaries, want: %d", got, want)
+	}
+	summary := summaries.Msg.InstalledPackageSummaries[0]
+	if got, want := summary.GetInstalledPackageRef().GetIdentifier(), "kustomization_podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := summary.GetCurrentVersion().GetPkgVersion(), "master@sha1:0123"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if !summary.GetStatus().GetReady() {
+		t.Errorf("got: %v, want a ready status", summary.GetStatus())
+	}
+
+	detail, err := s.GetInstalledPackageDetail(ctx, connect.NewRequest(&corev1.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: pkgRef,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotDetail := &v1alpha1.FluxKustomizationCustomDetail{}
+	if err = detail.Msg.InstalledPackageDetail.GetCustomDetail().UnmarshalTo(gotDetail); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&v1alpha1.FluxKustomizationCustomDetail{Path: "./kustomize", Prune: true, TargetNamespace: "test"}); !cmp.Equal(want, gotDetail, protocmp.Transform()) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, gotDetail, protocmp.Transform()))
+	}
+	if got, want := detail.Msg.InstalledPackageDetail.GetAvailablePackageRef().GetIdentifier(), "podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	refs, err := s.GetInstalledPackageResourceRefs(ctx, connect.NewRequest(&corev1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: pkgRef,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRefs := []*corev1.ResourceRef{
+		{ApiVersion: "apps/v1", Kind: "Deployment", Name: "podinfo", Namespace: "test"},
+		{ApiVersion: "v1", Kind: "Service", Name: "podinfo", Namespace: "test"},
+	}
+	if got, want := refs.Msg.ResourceRefs, expectedRefs; !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+	}
+
+	// suspending keeps the custom detail when not provided
+	if _, err = s.UpdateInstalledPackage(ctx, connect.NewRequest(&corev1.UpdateInstalledPackageRequest{
+		InstalledPackageRef:   pkgRef,
+		ReconciliationOptions: &corev1.ReconciliationOptions{Interval: "5m", Suspend: true},
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err = ctrlClient.Get(ctx, key, &ks); err != nil {
+		t.Fatal(err)
+	}
+	expectedSpec.Suspend = true
+	if got, want := ks.Spec, expectedSpec; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	if _, err = s.DeleteInstalledPackage(ctx, connect.NewRequest(&corev1.DeleteInstalledPackageRequest{
+		InstalledPackageRef: pkgRef,
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err = ctrlClient.Get(ctx, key, &ks); err == nil {
+		t.Errorf("expected the kustomization to be deleted")
+	}
+}
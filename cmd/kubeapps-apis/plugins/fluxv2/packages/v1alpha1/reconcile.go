@@ -0,0 +1,369 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	log "k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// how often a reconciled object is polled when waiting for flux to handle
+	// the reconciliation request
+	reconcileWaitInterval = 2 * time.Second
+
+	// how long to wait for flux to handle a reconciliation request, unless
+	// specified by the request or the plugin config
+	defaultReconcileWaitTimeout = 5 * time.Minute
+)
+
+// ReconcileInstalledPackage requests an immediate reconciliation of an installed
+// package by flux, optionally waiting until it has been handled.
+func (s *Server) ReconcileInstalledPackage(ctx context.Context, request *connect.Request[v1alpha1.ReconcileInstalledPackageRequest]) (*connect.Response[v1alpha1.ReconcileInstalledPackageResponse], error) {
+	log.Infof("+fluxv2 ReconcileInstalledPackage [%v]", request)
+	packageRef := request.Msg.GetInstalledPackageRef()
+	if err := s.checkInstalledPackageRef(packageRef); err != nil {
+		return nil, err
+	}
+
+	obj, kind, err := s.getInstalledPackageObject(ctx, request.Header(), packageRef)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.requestReconcile(ctx, request.Header(), obj, kind)
+	if err != nil {
+		return nil, err
+	}
+	if request.Msg.GetWait() {
+		if err = s.waitForReconcile(ctx, request.Header(), obj, kind, token, request.Msg.GetTimeoutSeconds()); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(&v1alpha1.ReconcileInstalledPackageResponse{
+		InstalledPackageRef: packageRef,
+		Status:              installedPackageObjectStatus(obj),
+	}), nil
+}
+
+// SuspendInstalledPackage suspends the reconciliation of an installed package by flux.
+func (s *Server) SuspendInstalledPackage(ctx context.Context, request *connect.Request[v1alpha1.SuspendInstalledPackageRequest]) (*connect.Response[v1alpha1.SuspendInstalledPackageResponse], error) {
+	log.Infof("+fluxv2 SuspendInstalledPackage [%v]", request)
+	packageRef := request.Msg.GetInstalledPackageRef()
+	if err := s.setInstalledPackageSuspended(ctx, request.Header(), packageRef, true); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1alpha1.SuspendInstalledPackageResponse{
+		InstalledPackageRef: packageRef,
+	}), nil
+}
+
+// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+func (s *Server) ResumeInstalledPackage(ctx context.Context, request *connect.Request[v1alpha1.ResumeInstalledPackageRequest]) (*connect.Response[v1alpha1.ResumeInstalledPackageResponse], error) {
+	log.Infof("+fluxv2 ResumeInstalledPackage [%v]", request)
+	packageRef := request.Msg.GetInstalledPackageRef()
+	if err := s.setInstalledPackageSuspended(ctx, request.Header(), packageRef, false); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1alpha1.ResumeInstalledPackageResponse{
+		InstalledPackageRef: packageRef,
+	}), nil
+}
+
+// ReconcilePackageRepository requests an immediate reconciliation of a package
+// repository by flux, optionally waiting until it has been handled.
+func (s *Server) ReconcilePackageRepository(ctx context.Context, request *connect.Request[v1alpha1.ReconcilePackageRepositoryRequest]) (*connect.Response[v1alpha1.ReconcilePackageRepositoryResponse], error) {
+	log.Infof("+fluxv2 ReconcilePackageRepository [%v]", request)
+	repoRef := request.Msg.GetPackageRepoRef()
+	if err := s.checkPackageRepoRef(repoRef); err != nil {
+		return nil, err
+	}
+
+	obj, kind, err := s.getRepoObject(ctx, request.Header(), repoRef)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.requestReconcile(ctx, request.Header(), obj, kind)
+	if err != nil {
+		return nil, err
+	}
+	if request.Msg.GetWait() {
+		if err = s.waitForReconcile(ctx, request.Header(), obj, kind, token, request.Msg.GetTimeoutSeconds()); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(&v1alpha1.ReconcilePackageRepositoryResponse{
+		PackageRepoRef: repoRef,
+		Status:         repoObjectStatus(obj),
+	}), nil
+}
+
+// SuspendPackageRepository suspends the reconciliation of a package repository by flux.
+func (s *Server) SuspendPackageRepository(ctx context.Context, request *connect.Request[v1alpha1.SuspendPackageRepositoryRequest]) (*connect.Response[v1alpha1.SuspendPackageRepositoryResponse], error) {
+	log.Infof("+fluxv2 SuspendPackageRepository [%v]", request)
+	repoRef := request.Msg.GetPackageRepoRef()
+	if err := s.setRepoSuspended(ctx, request.Header(), repoRef, true); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1alpha1.SuspendPackageRepositoryResponse{
+		PackageRepoRef: repoRef,
+	}), nil
+}
+
+// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+func (s *Server) ResumePackageRepository(ctx context.Context, request *connect.Request[v1alpha1.ResumePackageRepositoryRequest]) (*connect.Response[v1alpha1.ResumePackageRepositoryResponse], error) {
+	log.Infof("+fluxv2 ResumePackageRepository [%v]", request)
+	repoRef := request.Msg.GetPackageRepoRef()
+	if err := s.setRepoSuspended(ctx, request.Header(), repoRef, false); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1alpha1.ResumePackageRepositoryResponse{
+		PackageRepoRef: repoRef,
+	}), nil
+}
+
+func (s *Server) setInstalledPackageSuspended(ctx context.Context, headers http.Header, packageRef *corev1.InstalledPackageReference, suspend bool) error {
+	if err := s.checkInstalledPackageRef(packageRef); err != nil {
+		return err
+	}
+	obj, kind, err := s.getInstalledPackageObject(ctx, headers, packageRef)
+	if err != nil {
+		return err
+	}
+	return s.setSuspended(ctx, headers, obj, kind, suspend)
+}
+
+func (s *Server) setRepoSuspended(ctx context.Context, headers http.Header, repoRef *corev1.PackageRepositoryReference, suspend bool) error {
+	if err := s.checkPackageRepoRef(repoRef); err != nil {
+		return err
+	}
+	obj, kind, err := s.getRepoObject(ctx, headers, repoRef)
+	if err != nil {
+		return err
+	}
+	return s.setSuspended(ctx, headers, obj, kind, suspend)
+}
+
+func (s *Server) checkInstalledPackageRef(packageRef *corev1.InstalledPackageReference) error {
+	if packageRef == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("No request InstalledPackageRef provided"))
+	}
+	// flux CRDs require a namespace, cluster-wide resources are not supported
+	if packageRef.GetContext().GetNamespace() == "" || packageRef.GetIdentifier() == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("InstalledPackageReference is missing required 'namespace' or 'identifier' field"))
+	}
+	cluster := packageRef.GetContext().GetCluster()
+	if cluster != "" && cluster != s.kubeappsCluster {
+		return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]", cluster))
+	}
+	return nil
+}
+
+func (s *Server) checkPackageRepoRef(repoRef *corev1.PackageRepositoryReference) error {
+	if repoRef == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("No request PackageRepoRef provided"))
+	}
+	// flux CRDs require a namespace, cluster-wide resources are not supported
+	if repoRef.GetContext().GetNamespace() == "" || repoRef.GetIdentifier() == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("PackageRepositoryReference is missing required 'namespace' or 'identifier' field"))
+	}
+	cluster := repoRef.GetContext().GetCluster()
+	if cluster != "" && cluster != s.kubeappsCluster {
+		return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.PackageRepoRef.Context.Cluster: [%v]", cluster))
+	}
+	return nil
+}
+
+// getInstalledPackageObject returns the HelmRelease or the Kustomization of an
+// installed package, together with its kind
+func (s *Server) getInstalledPackageObject(ctx context.Context, headers http.Header, packageRef *corev1.InstalledPackageReference) (ctrlclient.Object, string, error) {
+	if isKustomizationIdentifier(packageRef.GetIdentifier()) {
+		ks, err := s.getKustomizationInCluster(ctx, headers, kustomizationKey(packageRef))
+		return ks, kustomizev1.KustomizationKind, err
+	}
+	key := types.NamespacedName{Namespace: packageRef.GetContext().GetNamespace(), Name: packageRef.GetIdentifier()}
+	rel, err := s.getReleaseInCluster(ctx, headers, key)
+	return rel, helmv2.HelmReleaseKind, err
+}
+
+// getRepoObject returns the HelmRepository, GitRepository, Bucket or OCIRepository of a
+// package repository, together with its kind
+func (s *Server) getRepoObject(ctx context.Context, headers http.Header, repoRef *corev1.PackageRepositoryReference) (ctrlclient.Object, string, error) {
+	key := types.NamespacedName{Namespace: repoRef.GetContext().GetNamespace(), Name: repoRef.GetIdentifier()}
+	repo, err := s.getRepoInCluster(ctx, headers, key)
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// not a HelmRepository, but it may be another source of charts
+		if src, srcErr := s.getChartSourceInCluster(ctx, headers, key); srcErr == nil {
+			return src, chartSourceKind(src), nil
+		}
+		return nil, "", err
+	} else if err != nil {
+		return nil, "", err
+	}
+	return repo, sourcev1beta2.HelmRepositoryKind, nil
+}
+
+// requestReconcile sets the annotation requesting flux to reconcile the object
+// immediately, returning the token of the request. The reconciliation of a
+// suspended object can't be requested, as flux would ignore it.
+// ref https://fluxcd.io/flux/components/helm/helmreleases/#triggering-a-reconcile
+func (s *Server) requestReconcile(ctx context.Context, headers http.Header, obj ctrlclient.Object, kind string) (string, error) {
+	if isFluxObjectSuspended(obj) {
+		return "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("The reconciliation of %s [%s/%s] is suspended", kind, obj.GetNamespace(), obj.GetName()))
+	}
+
+	client, err := s.getClient(headers, obj.GetNamespace())
+	if err != nil {
+		return "", err
+	}
+
+	patch := ctrlclient.MergeFrom(obj.DeepCopyObject().(ctrlclient.Object))
+	token := time.Now().Format(time.RFC3339Nano)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[fluxmeta.ReconcileRequestAnnotation] = token
+	obj.SetAnnotations(annotations)
+	if err = client.Patch(ctx, obj, patch); err != nil {
+		return "", connecterror.FromK8sError("patch", kind, obj.GetNamespace()+"/"+obj.GetName(), err)
+	}
+	log.V(4).Infof("Requested the reconciliation of %s [%s/%s] at [%s]", kind, obj.GetNamespace(), obj.GetName(), token)
+	return token, nil
+}
+
+// waitForReconcile polls the object until flux has handled the reconciliation
+// request with the token and observed its latest generation, updating the object
+// in place
+func (s *Server) waitForReconcile(ctx context.Context, headers http.Header, obj ctrlclient.Object, kind, token string, timeoutSeconds int32) error {
+	timeout := defaultReconcileWaitTimeout
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	} else if s.pluginConfig.TimeoutSeconds > 0 {
+		timeout = time.Duration(s.pluginConfig.TimeoutSeconds) * time.Second
+	}
+
+	client, err := s.getClient(headers, obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	key := ctrlclient.ObjectKeyFromObject(obj)
+	err = wait.PollUntilContextTimeout(ctx, reconcileWaitInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := client.Get(ctx, key, obj); err != nil {
+			return false, connecterror.FromK8sError("get", kind, key.String(), err)
+		}
+		return isFluxObjectReconciled(obj, token), nil
+	})
+	if wait.Interrupted(err) {
+		return connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("Timed out waiting for the reconciliation of %s [%s] after %s", kind, key, timeout))
+	}
+	return err
+}
+
+// setSuspended suspends or resumes the reconciliation of the object, leaving the
+// rest of its spec intact
+func (s *Server) setSuspended(ctx context.Context, headers http.Header, obj ctrlclient.Object, kind string, suspend bool) error {
+	client, err := s.getClient(headers, obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	patch := ctrlclient.MergeFrom(obj.DeepCopyObject().(ctrlclient.Object))
+	setFluxObjectSuspended(obj, suspend)
+	if err = client.Patch(ctx, obj, patch); err != nil {
+		return connecterror.FromK8sError("patch", kind, obj.GetNamespace()+"/"+obj.GetName(), err)
+	}
+	return nil
+}
+
+//
+// flux object utilities
+//
+
+func isFluxObjectSuspended(obj ctrlclient.Object) bool {
+	switch obj := obj.(type) {
+	case *helmv2.HelmRelease:
+		return obj.Spec.Suspend
+	case *kustomizev1.Kustomization:
+		return obj.Spec.Suspend
+	case *sourcev1beta2.HelmRepository:
+		return obj.Spec.Suspend
+	case *sourcev1.GitRepository:
+		return obj.Spec.Suspend
+	case *sourcev1beta2.Bucket:
+		return obj.Spec.Suspend
+	}
+	return false
+}
+
+func setFluxObjectSuspended(obj ctrlclient.Object, suspend bool) {
+	switch obj := obj.(type) {
+	case *helmv2.HelmRelease:
+		obj.Spec.Suspend = suspend
+	case *kustomizev1.Kustomization:
+		obj.Spec.Suspend = suspend
+	case *sourcev1beta2.HelmRepository:
+		obj.Spec.Suspend = suspend
+	case *sourcev1.GitRepository:
+		obj.Spec.Suspend = suspend
+	case *sourcev1beta2.Bucket:
+		obj.Spec.Suspend = suspend
+	}
+}
+
+// isFluxObjectReconciled returns whether flux has handled the reconciliation
+// request with the token and its status reflects the latest generation
+func isFluxObjectReconciled(obj ctrlclient.Object, token string) bool {
+	switch obj := obj.(type) {
+	case *helmv2.HelmRelease:
+		return obj.Status.LastHandledReconcileAt == token && checkReleaseGeneration(*obj)
+	case *kustomizev1.Kustomization:
+		return obj.Status.LastHandledReconcileAt == token && obj.Generation > 0 && obj.Generation == obj.Status.ObservedGeneration
+	case *sourcev1beta2.HelmRepository:
+		return obj.Status.LastHandledReconcileAt == token && checkRepoGeneration(*obj)
+	case *sourcev1.GitRepository:
+		return obj.Status.LastHandledReconcileAt == token && obj.Generation > 0 && obj.Generation == obj.Status.ObservedGeneration
+	case *sourcev1beta2.Bucket:
+		return obj.Status.LastHandledReconcileAt == token && obj.Generation > 0 && obj.Generation == obj.Status.ObservedGeneration
+	}
+	return false
+}
+
+func installedPackageObjectStatus(obj ctrlclient.Object) *corev1.InstalledPackageStatus {
+	switch obj := obj.(type) {
+	case *helmv2.HelmRelease:
+		return installedPackageStatus(*obj)
+	case *kustomizev1.Kustomization:
+		return kustomizationStatus(*obj)
+	}
+	return nil
+}
+
+func repoObjectStatus(obj ctrlclient.Object) *corev1.PackageRepositoryStatus {
+	switch obj := obj.(type) {
+	case *sourcev1beta2.HelmRepository:
+		return repoStatus(*obj)
+	case chartSource:
+		return chartSourceStatus(obj)
+	}
+	return nil
+}
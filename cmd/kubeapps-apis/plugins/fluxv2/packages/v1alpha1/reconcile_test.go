@@ -0,0 +1,239 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newReadyRelease(name, namespace string) helmv2.HelmRelease {
+	return newRelease(
+		metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+		&helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Name: "podinfo"},
+				},
+			},
+			Interval: defaultReconcileInterval,
+		},
+		&helmv2.HelmReleaseStatus{
+			ObservedGeneration: 1,
+			Conditions: []metav1.Condition{
+				{
+					Type:   fluxmeta.ReadyCondition,
+					Status: metav1.ConditionTrue,
+					Reason: helmv2.InstallSucceededReason,
+				},
+			},
+		})
+}
+
+func TestReconcileInstalledPackage(t *testing.T) {
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, []helmv2.HelmRelease{newReadyRelease("my-podinfo", "test")})
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+	ctx := context.Background()
+	ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := types.NamespacedName{Name: "my-podinfo", Namespace: "test"}
+
+	if _, err = s.ReconcileInstalledPackage(ctx, connect.NewRequest(&v1alpha1.ReconcileInstalledPackageRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+	})); err != nil {
+		t.Fatal(err)
+	}
+	var rel helmv2.HelmRelease
+	if err = ctrlClient.Get(ctx, key, &rel); err != nil {
+		t.Fatal(err)
+	}
+	token := rel.GetAnnotations()[fluxmeta.ReconcileRequestAnnotation]
+	if token == "" {
+		t.Fatalf("expected the annotation [%s] to be set", fluxmeta.ReconcileRequestAnnotation)
+	}
+
+	// flux does not handle the request before the timeout
+	defer func(interval time.Duration) { reconcileWaitInterval = interval }(reconcileWaitInterval)
+	reconcileWaitInterval = 10 * time.Millisecond
+	_, err = s.ReconcileInstalledPackage(ctx, connect.NewRequest(&v1alpha1.ReconcileInstalledPackageRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+		Wait:                true,
+		TimeoutSeconds:      1,
+	}))
+	if got, want := connect.CodeOf(err), connect.CodeDeadlineExceeded; got != want {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if err = ctrlClient.Get(ctx, key, &rel); err != nil {
+		t.Fatal(err)
+	}
+	token = rel.GetAnnotations()[fluxmeta.ReconcileRequestAnnotation]
+
+	// flux handles the request while waiting
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			var rel helmv2.HelmRelease
+			if err := ctrlClient.Get(ctx, key, &rel); err != nil {
+				return
+			}
+			if requested := rel.GetAnnotations()[fluxmeta.ReconcileRequestAnnotation]; requested != token {
+				rel.Status.LastHandledReconcileAt = requested
+				_ = ctrlClient.Update(ctx, &rel)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	response, err := s.ReconcileInstalledPackage(ctx, connect.NewRequest(&v1alpha1.ReconcileInstalledPackageRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+		Wait:                true,
+		TimeoutSeconds:      5,
+	}))
+	<-done
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.Msg.GetStatus().GetReady() {
+		t.Errorf("got: %v, want a ready status", response.Msg.GetStatus())
+	}
+}
+
+func TestSuspendAndResumeInstalledPackage(t *testing.T) {
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, []helmv2.HelmRelease{newReadyRelease("my-podinfo", "test")})
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+	ctx := context.Background()
+	ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := types.NamespacedName{Name: "my-podinfo", Namespace: "test"}
+
+	if _, err = s.SuspendInstalledPackage(ctx, connect.NewRequest(&v1alpha1.SuspendInstalledPackageRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+	})); err != nil {
+		t.Fatal(err)
+	}
+	var rel helmv2.HelmRelease
+	if err = ctrlClient.Get(ctx, key, &rel); err != nil {
+		t.Fatal(err)
+	}
+	if !rel.Spec.Suspend {
+		t.Errorf("expected the release to be suspended")
+	}
+	// the rest of the spec is left intact
+	if got, want := rel.Spec.Chart.Spec.Chart, "podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	// the reconciliation of a suspended release can't be requested
+	_, err = s.ReconcileInstalledPackage(ctx, connect.NewRequest(&v1alpha1.ReconcileInstalledPackageRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+	}))
+	if got, want := connect.CodeOf(err), connect.CodeFailedPrecondition; got != want {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+
+	if _, err = s.ResumeInstalledPackage(ctx, connect.NewRequest(&v1alpha1.ResumeInstalledPackageRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "test"),
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err = ctrlClient.Get(ctx, key, &rel); err != nil {
+		t.Fatal(err)
+	}
+	if rel.Spec.Suspend {
+		t.Errorf("expected the release to be resumed")
+	}
+
+	_, err = s.SuspendInstalledPackage(ctx, connect.NewRequest(&v1alpha1.SuspendInstalledPackageRequest{
+		InstalledPackageRef: installedRef("my-podinfo", "other"),
+	}))
+	if got, want := connect.CodeOf(err), connect.CodeNotFound; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestSuspendAndReconcilePackageRepository(t *testing.T) {
+	s, _, err := newServerWithRepos(t, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+	ctx := context.Background()
+	ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ctrlClient.Create(ctx, newReadyGitRepository("podinfo", "default", "")); err != nil {
+		t.Fatal(err)
+	}
+	key := types.NamespacedName{Name: "podinfo", Namespace: "default"}
+	repoRef := &corev1.PackageRepositoryReference{
+		Context:    &corev1.Context{Namespace: "default", Cluster: KubeappsCluster},
+		Identifier: "podinfo",
+		Plugin:     fluxPlugin,
+	}
+
+	if _, err = s.SuspendPackageRepository(ctx, connect.NewRequest(&v1alpha1.SuspendPackageRepositoryRequest{
+		PackageRepoRef: repoRef,
+	})); err != nil {
+		t.Fatal(err)
+	}
+	var gitRepo sourcev1.GitRepository
+	if err = ctrlClient.Get(ctx, key, &gitRepo); err != nil {
+		t.Fatal(err)
+	}
+	if !gitRepo.Spec.Suspend {
+		t.Errorf("expected the repository to be suspended")
+	}
+
+	_, err = s.ReconcilePackageRepository(ctx, connect.NewRequest(&v1alpha1.ReconcilePackageRepositoryRequest{
+		PackageRepoRef: repoRef,
+	}))
+	if got, want := connect.CodeOf(err), connect.CodeFailedPrecondition; got != want {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+
+	if _, err = s.ResumePackageRepository(ctx, connect.NewRequest(&v1alpha1.ResumePackageRepositoryRequest{
+		PackageRepoRef: repoRef,
+	})); err != nil {
+		t.Fatal(err)
+	}
+	response, err := s.ReconcilePackageRepository(ctx, connect.NewRequest(&v1alpha1.ReconcilePackageRepositoryRequest{
+		PackageRepoRef: repoRef,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.Msg.GetStatus().GetReady() {
+		t.Errorf("got: %v, want a ready status", response.Msg.GetStatus())
+	}
+	if err = ctrlClient.Get(ctx, key, &gitRepo); err != nil {
+		t.Fatal(err)
+	}
+	if gitRepo.Spec.Suspend {
+		t.Errorf("expected the repository to be resumed")
+	}
+	if gitRepo.GetAnnotations()[fluxmeta.ReconcileRequestAnnotation] == "" {
+		t.Errorf("expected the annotation [%s] to be set", fluxmeta.ReconcileRequestAnnotation)
+	}
+}
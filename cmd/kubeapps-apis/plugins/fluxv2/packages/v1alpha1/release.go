@@ -12,16 +12,19 @@ import (
 	"time"
 
 	"github.com/bufbuild/connect-go"
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	httpclient "github.com/vmware-tanzu/kubeapps/pkg/http-client"
 	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
+	"google.golang.org/protobuf/types/known/anypb"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
@@ -30,17 +33,31 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	log "k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 )
 
 var (
 	// default reconcile interval is 1 min
 	defaultReconcileInterval = metav1.Duration{Duration: 1 * time.Minute}
+
+	// installedPackageEventTypes maps the HelmRelease watch events onto
+	// the installed package events
+	installedPackageEventTypes = map[watch.EventType]corev1.WatchInstalledPackagesResponse_EventType{
+		watch.Added:    corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+		watch.Modified: corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+		watch.Deleted:  corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED,
+	}
 )
 
+// getLastReleaseFailedReason is the reason of the failure to observe the last
+// release, which was reported by the helm-controller before the v2 API.
+const getLastReleaseFailedReason = "GetLastReleaseFailed"
+
 // namespace maybe "", in which case releases from all namespaces are returned
-func (s *Server) listReleasesInCluster(ctx context.Context, headers http.Header, namespace string) ([]helmv2beta2.HelmRelease, error) {
+func (s *Server) listReleasesInCluster(ctx context.Context, headers http.Header, namespace string) ([]helmv2.HelmRelease, error) {
 	client, err := s.getClient(headers, namespace)
 	if err != nil {
 		return nil, err
@@ -53,7 +70,7 @@ func (s *Server) listReleasesInCluster(ctx context.Context, headers http.Header,
 	// 2) there is a "consistent snapshot" problem, where the client doesn't want to
 	// see any results created/updated/deleted after the first request is issued
 	// To fix this, we must make use of resourceVersion := relList.GetResourceVersion()
-	var relList helmv2beta2.HelmReleaseList
+	var relList helmv2.HelmReleaseList
 	if err = client.List(ctx, &relList); err != nil {
 		return nil, connecterror.FromK8sError("list", "HelmRelease", namespace+"/*", err)
 	} else {
@@ -61,13 +78,26 @@ func (s *Server) listReleasesInCluster(ctx context.Context, headers http.Header,
 	}
 }
 
-func (s *Server) getReleaseInCluster(ctx context.Context, headers http.Header, key types.NamespacedName) (*helmv2beta2.HelmRelease, error) {
+// namespace maybe "", in which case releases from all namespaces are watched
+func (s *Server) watchReleasesInCluster(ctx context.Context, headers http.Header, namespace string) (watch.Interface, error) {
+	client, err := s.clientGetter.ControllerRuntime(headers, s.kubeappsCluster)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := client.Watch(ctx, &helmv2.HelmReleaseList{}, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, connecterror.FromK8sError("watch", "HelmRelease", namespace+"/*", err)
+	}
+	return watcher, nil
+}
+
+func (s *Server) getReleaseInCluster(ctx context.Context, headers http.Header, key types.NamespacedName) (*helmv2.HelmRelease, error) {
 	client, err := s.getClient(headers, key.Namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	var rel helmv2beta2.HelmRelease
+	var rel helmv2.HelmRelease
 	if err = client.Get(ctx, key, &rel); err != nil {
 		return nil, connecterror.FromK8sError("get", "HelmRelease", key.String(), err)
 	}
@@ -79,17 +109,27 @@ func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, headers htt
 	if err != nil {
 		return nil, err
 	}
+	// the Kustomizations are paginated after the HelmReleases
+	kustomizationsFromCluster, err := s.listKustomizationsInCluster(ctx, headers, namespace)
+	if err != nil {
+		return nil, err
+	}
 
 	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
-	if len(releasesFromCluster) > 0 {
+	if total := len(releasesFromCluster) + len(kustomizationsFromCluster); total > 0 {
 		startAt := -1
 		if pageSize > 0 {
 			startAt = itemOffset
 		}
 
-		for i, r := range releasesFromCluster {
+		for i := 0; i < total; i++ {
 			if startAt <= i {
-				summary, err := s.installedPkgSummaryFromRelease(ctx, headers, r)
+				var summary *corev1.InstalledPackageSummary
+				if i < len(releasesFromCluster) {
+					summary, err = s.installedPkgSummaryFromRelease(ctx, headers, releasesFromCluster[i])
+				} else {
+					summary = s.installedPkgSummaryFromKustomization(kustomizationsFromCluster[i-len(releasesFromCluster)])
+				}
 				if err != nil {
 					return nil, err
 				} else if summary == nil {
@@ -106,7 +146,7 @@ func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, headers htt
 	return installedPkgSummaries, nil
 }
 
-func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, headers http.Header, rel helmv2beta2.HelmRelease) (*corev1.InstalledPackageSummary, error) {
+func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, headers http.Header, rel helmv2.HelmRelease) (*corev1.InstalledPackageSummary, error) {
 	name, err := common.NamespacedName(&rel)
 	if err != nil {
 		return nil, err
@@ -115,7 +155,7 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, headers htt
 	var latestPkgVersion *corev1.PackageAppVersion
 	var pkgVersion *corev1.VersionReference
 
-	version := rel.Spec.Chart.Spec.Version
+	version := releaseChartVersion(&rel)
 	if version != "" {
 		pkgVersion = &corev1.VersionReference{
 			Version: version,
@@ -125,14 +165,16 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, headers htt
 	var pkgDetail *corev1.AvailablePackageDetail
 
 	// see https://fluxcd.io/docs/components/helm/helmreleases/
+	// flux creates no HelmChart for a chartRef, as the chart is the artifact of the source
 	helmChartRef := rel.Status.HelmChart
-	if helmChartRef == "" {
+	if helmChartRef == "" && !rel.HasChartRef() {
 		log.Warningf("Missing element status.helmChart on HelmRelease [%s]", name)
 	}
 
-	repoName := rel.Spec.Chart.Spec.SourceRef.Name
-	repoNamespace := rel.Spec.Chart.Spec.SourceRef.Namespace
-	chartName := rel.Spec.Chart.Spec.Chart
+	sourceRef := releaseChartSourceRef(&rel)
+	repoName := sourceRef.Name
+	repoNamespace := sourceRef.Namespace
+	chartName := chartNameFromRelease(&rel)
 
 	if repoName != "" && helmChartRef != "" && chartName != "" {
 		parts := strings.Split(helmChartRef, "/")
@@ -181,6 +223,15 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, headers htt
 			PkgVersion: chartFromCache.ChartVersions[0].Version,
 			AppVersion: chartFromCache.ChartVersions[0].AppVersion,
 		}
+		if pkgDetail == nil && rel.HasChartRef() {
+			// the chart of the source is the one being installed
+			pkgDetail = &corev1.AvailablePackageDetail{
+				DisplayName:      chartFromCache.Name,
+				IconUrl:          chartFromCache.Icon,
+				ShortDescription: chartFromCache.Description,
+				Version:          latestPkgVersion,
+			}
+		}
 	}
 
 	return &corev1.InstalledPackageSummary{
@@ -218,7 +269,7 @@ func (s *Server) installedPackageDetail(ctx context.Context, headers http.Header
 	log.V(4).Infof("Release from installedPackageDetail:\n[%s]", common.PrettyPrint(rel))
 
 	var pkgVersionRef *corev1.VersionReference
-	version := rel.Spec.Chart.Spec.Version
+	version := releaseChartVersion(rel)
 	if version != "" {
 		pkgVersionRef = &corev1.VersionReference{
 			Version: version,
@@ -234,9 +285,11 @@ func (s *Server) installedPackageDetail(ctx context.Context, headers http.Header
 	// TODO (gfichtenholt) what about ValuesFrom []ValuesReference `json:"valuesFrom,omitempty"`?
 	// ValuesReference maybe a config map or a secret
 
-	// this will only be present if install/upgrade succeeded
-	// TODO(agamez): flux upgrade - migrate to Status.History, see https://github.com/fluxcd/flux2/releases/tag/v2.2.0
-	pkgVersion := rel.Status.LastAppliedRevision
+	// the latest release of the history is only deployed if install/upgrade succeeded
+	pkgVersion := ""
+	if snapshot := rel.Status.History.Latest(); snapshot != nil && snapshot.Status == release.StatusDeployed.String() {
+		pkgVersion = snapshot.ChartVersion
+	}
 	if pkgVersion == "" {
 		// this is the back-up option: will be there if the reconciliation is in progress or has failed
 		pkgVersion = rel.Status.LastAttemptedRevision
@@ -265,6 +318,14 @@ func (s *Server) installedPackageDetail(ctx context.Context, headers http.Header
 		log.Warningf("Failed to get helm release due to %v", err)
 	}
 
+	// only returned when the release sets any of the flux-specific options
+	var customDetail *anypb.Any
+	if detail := helmReleaseCustomDetail(rel); detail != nil {
+		if customDetail, err = anypb.New(detail); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to encode the custom detail of the release [%s] due to: %w", key, err))
+		}
+	}
+
 	return &corev1.InstalledPackageDetail{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
@@ -285,10 +346,11 @@ func (s *Server) installedPackageDetail(ctx context.Context, headers http.Header
 		AvailablePackageRef:   availablePackageRef,
 		PostInstallationNotes: postInstallNotes,
 		Status:                installedPackageStatus(*rel),
+		CustomDetail:          customDetail,
 	}, nil
 }
 
-func (s *Server) getReleaseViaHelmApi(headers http.Header, key types.NamespacedName, rel *helmv2beta2.HelmRelease) (*release.Release, error) {
+func (s *Server) getReleaseViaHelmApi(headers http.Header, key types.NamespacedName, rel *helmv2.HelmRelease) (*release.Release, error) {
 	// post installation notes can only be retrieved via helm APIs, flux doesn't do it
 	// see discussion in https://cloud-native.slack.com/archives/CLAJ40HV3/p1629244025187100
 	if s.actionConfigGetter == nil {
@@ -311,11 +373,15 @@ func (s *Server) getReleaseViaHelmApi(headers http.Header, key types.NamespacedN
 	return release, nil
 }
 
-func (s *Server) newRelease(ctx context.Context, headers http.Header, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string) (*corev1.InstalledPackageReference, error) {
+func (s *Server) newRelease(ctx context.Context, headers http.Header, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string, customDetail *anypb.Any) (*corev1.InstalledPackageReference, error) {
 	repoName, chartName, err := pkgutils.SplitPackageIdentifier(packageRef.Identifier)
 	if err != nil {
 		return nil, err
 	}
+	detail, err := helmReleaseCustomDetailFromAny(customDetail)
+	if err != nil {
+		return nil, err
+	}
 
 	repo := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: repoName}
 	chart, err := s.getChartModel(ctx, headers, repo, chartName)
@@ -342,7 +408,7 @@ func (s *Server) newRelease(ctx context.Context, headers http.Header, packageRef
 		}
 	}
 
-	fluxRelease, err := s.newFluxHelmRelease(chart, targetName, versionExpr, reconcile, values)
+	fluxRelease, err := s.newFluxHelmRelease(chart, targetName, versionExpr, reconcile, values, detail)
 	if err != nil {
 		return nil, err
 	}
@@ -369,7 +435,7 @@ func (s *Server) newRelease(ctx context.Context, headers http.Header, packageRef
 	}, nil
 }
 
-func (s *Server) updateRelease(ctx context.Context, headers http.Header, packageRef *corev1.InstalledPackageReference, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string) (*corev1.InstalledPackageReference, error) {
+func (s *Server) updateRelease(ctx context.Context, headers http.Header, packageRef *corev1.InstalledPackageReference, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string, customDetail *anypb.Any) (*corev1.InstalledPackageReference, error) {
 	key := types.NamespacedName{Name: packageRef.Identifier, Namespace: packageRef.Context.Namespace}
 
 	rel, err := s.getReleaseInCluster(ctx, headers, key)
@@ -408,9 +474,10 @@ func (s *Server) updateRelease(ctx context.Context, headers http.Header, package
 		if err != nil {
 			return nil, err
 		}
+	}
+	// a chartRef has no version, it is the one of the chart of the source
+	if rel.Spec.Chart != nil {
 		rel.Spec.Chart.Spec.Version = versionExpr
-	} else {
-		rel.Spec.Chart.Spec.Version = ""
 	}
 
 	if valuesString != "" {
@@ -454,11 +521,23 @@ func (s *Server) updateRelease(ctx context.Context, headers http.Header, package
 		rel.Spec.ServiceAccountName = ""
 	}
 
+	// the dependencies and the install/upgrade options are left intact unless
+	// a custom detail is provided
+	if customDetail != nil {
+		detail, err := helmReleaseCustomDetailFromAny(customDetail)
+		if err != nil {
+			return nil, err
+		}
+		if err = applyHelmReleaseCustomDetail(rel, detail); err != nil {
+			return nil, err
+		}
+	}
+
 	// get rid of the status field, since now there will be a new reconciliation
 	// process and the current status no longer applies. metadata and spec I want
 	// to keep, as they may have had added labels and/or annotations and/or
 	// even other changes made by the user.
-	rel.Status = helmv2beta2.HelmReleaseStatus{}
+	rel.Status = helmv2.HelmReleaseStatus{}
 
 	client, err := s.getClient(headers, packageRef.Context.Namespace)
 	if err != nil {
@@ -489,7 +568,7 @@ func (s *Server) deleteRelease(ctx context.Context, headers http.Header, package
 
 	log.V(4).Infof("Deleting release: [%s]", packageRef.Identifier)
 
-	rel := &helmv2beta2.HelmRelease{
+	rel := &helmv2.HelmRelease{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      packageRef.Identifier,
 			Namespace: packageRef.Context.Namespace,
@@ -507,27 +586,49 @@ func (s *Server) deleteRelease(ctx context.Context, headers http.Header, package
 //  2. metadata.namespace, where this HelmRelease CRD will exist, same as (3) below
 //     per https://github.com/vmware-tanzu/kubeapps/pull/3640#issuecomment-949315105
 //  3. spec.targetNamespace, where flux will install any artifacts from the release
-func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.NamespacedName, versionExpr string, reconcile *corev1.ReconciliationOptions, values map[string]interface{}) (*helmv2beta2.HelmRelease, error) {
-	fluxRelease := &helmv2beta2.HelmRelease{
+func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.NamespacedName, versionExpr string, reconcile *corev1.ReconciliationOptions, values map[string]interface{}, detail *v1alpha1.FluxHelmReleaseCustomDetail) (*helmv2.HelmRelease, error) {
+	chartRef, sourceKind := chart.Name, sourcev1beta2.HelmRepositoryKind
+	switch chart.Repo.Type {
+	case gitRepositoryType, bucketType:
+		// the charts of these sources are referenced by their path in the artifact
+		// and the version is ignored by flux, as only one is available
+		// ref https://fluxcd.io/flux/components/helm/helmreleases/#chart-template
+		if len(chart.ChartVersions) == 0 || len(chart.ChartVersions[0].URLs) == 0 {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Missing path of chart [%s]", chart.ID))
+		}
+		chartRef, versionExpr = chart.ChartVersions[0].URLs[0], ""
+		sourceKind = sourcev1.GitRepositoryKind
+		if chart.Repo.Type == bucketType {
+			sourceKind = sourcev1beta2.BucketKind
+		}
+	}
+	fluxRelease := &helmv2.HelmRelease{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      targetName.Name,
 			Namespace: targetName.Namespace,
 		},
-		Spec: helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
-					Chart: chart.Name,
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
-						Name:      chart.Repo.Name,
-						Kind:      sourcev1beta2.HelmRepositoryKind,
-						Namespace: chart.Repo.Namespace,
-					},
+	}
+	if chart.Repo.Type == ociRepositoryType {
+		// the artifact of an OCIRepository is the chart itself, which is
+		// referenced as a whole rather than by a chart template
+		// ref https://fluxcd.io/flux/components/helm/helmreleases/#chart-reference
+		fluxRelease.Spec.ChartRef = &helmv2.CrossNamespaceSourceReference{
+			Kind:      sourcev1beta2.OCIRepositoryKind,
+			Name:      chart.Repo.Name,
+			Namespace: chart.Repo.Namespace,
+		}
+	} else {
+		fluxRelease.Spec.Chart = &helmv2.HelmChartTemplate{
+			Spec: helmv2.HelmChartTemplateSpec{
+				Chart: chartRef,
+				SourceRef: helmv2.CrossNamespaceObjectReference{
+					Name:      chart.Repo.Name,
+					Kind:      sourceKind,
+					Namespace: chart.Repo.Namespace,
 				},
+				Version: versionExpr,
 			},
-		},
-	}
-	if versionExpr != "" {
-		fluxRelease.Spec.Chart.Spec.Version = versionExpr
+		}
 	}
 
 	reconcileInterval := defaultReconcileInterval // unless explicitly specified
@@ -551,6 +652,9 @@ func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.Namesp
 		}
 		fluxRelease.Spec.Values = &v1.JSON{Raw: byteArray}
 	}
+	if err := applyHelmReleaseCustomDetail(fluxRelease, detail); err != nil {
+		return nil, err
+	}
 
 	// required fields, without which flux controller will fail to create the CRD
 	fluxRelease.Spec.Interval = reconcileInterval
@@ -567,6 +671,125 @@ func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.Namesp
 	return fluxRelease, nil
 }
 
+func helmReleaseCustomDetailFromAny(customDetail *anypb.Any) (*v1alpha1.FluxHelmReleaseCustomDetail, error) {
+	detail := &v1alpha1.FluxHelmReleaseCustomDetail{}
+	if customDetail != nil {
+		if err := customDetail.UnmarshalTo(detail); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The customDetail could not be parsed due to: %w", err))
+		}
+	}
+	return detail, nil
+}
+
+// applyHelmReleaseCustomDetail sets the dependencies and the install/upgrade
+// options of the release to the ones of the custom detail, leaving the rest
+// of the install/upgrade configuration, if any, intact
+func applyHelmReleaseCustomDetail(rel *helmv2.HelmRelease, detail *v1alpha1.FluxHelmReleaseCustomDetail) error {
+	var dependsOn []fluxmeta.NamespacedObjectReference
+	for _, dep := range detail.GetDependsOn() {
+		if dep.GetName() == "" {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The name of the dependencies is required"))
+		}
+		dependsOn = append(dependsOn, fluxmeta.NamespacedObjectReference{
+			Name:      dep.GetName(),
+			Namespace: dep.GetNamespace(),
+		})
+	}
+	installCRDs, err := helmReleaseCRDsPolicy(detail.GetInstall().GetCrds())
+	if err != nil {
+		return err
+	}
+	upgradeCRDs, err := helmReleaseCRDsPolicy(detail.GetUpgrade().GetCrds())
+	if err != nil {
+		return err
+	}
+
+	rel.Spec.DependsOn = dependsOn
+
+	install := detail.GetInstall()
+	if install.GetCrds() != "" || install.GetRemediationRetries() != 0 || rel.Spec.Install != nil {
+		if rel.Spec.Install == nil {
+			rel.Spec.Install = &helmv2.Install{}
+		}
+		rel.Spec.Install.CRDs = installCRDs
+		if retries := int(install.GetRemediationRetries()); retries != 0 || rel.Spec.Install.Remediation != nil {
+			if rel.Spec.Install.Remediation == nil {
+				rel.Spec.Install.Remediation = &helmv2.InstallRemediation{}
+			}
+			rel.Spec.Install.Remediation.Retries = retries
+		}
+	}
+
+	upgrade := detail.GetUpgrade()
+	if upgrade.GetCrds() != "" || upgrade.GetRemediationRetries() != 0 || upgrade.GetCleanupOnFail() || rel.Spec.Upgrade != nil {
+		if rel.Spec.Upgrade == nil {
+			rel.Spec.Upgrade = &helmv2.Upgrade{}
+		}
+		rel.Spec.Upgrade.CRDs = upgradeCRDs
+		rel.Spec.Upgrade.CleanupOnFail = upgrade.GetCleanupOnFail()
+		if retries := int(upgrade.GetRemediationRetries()); retries != 0 || rel.Spec.Upgrade.Remediation != nil {
+			if rel.Spec.Upgrade.Remediation == nil {
+				rel.Spec.Upgrade.Remediation = &helmv2.UpgradeRemediation{}
+			}
+			rel.Spec.Upgrade.Remediation.Retries = retries
+		}
+	}
+	return nil
+}
+
+// "" leaves the choice to flux, which creates the CRDs on install and skips
+// them on upgrade
+func helmReleaseCRDsPolicy(policy string) (helmv2.CRDsPolicy, error) {
+	switch crds := helmv2.CRDsPolicy(policy); crds {
+	case "", helmv2.Skip, helmv2.Create, helmv2.CreateReplace:
+		return crds, nil
+	default:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Invalid CRDs policy [%s], must be one of [%s, %s, %s]",
+			policy, helmv2.Skip, helmv2.Create, helmv2.CreateReplace))
+	}
+}
+
+// helmReleaseCustomDetail returns the flux-specific options of the release, or
+// nil when none are set
+func helmReleaseCustomDetail(rel *helmv2.HelmRelease) *v1alpha1.FluxHelmReleaseCustomDetail {
+	detail := &v1alpha1.FluxHelmReleaseCustomDetail{}
+	for _, dep := range rel.Spec.DependsOn {
+		detail.DependsOn = append(detail.DependsOn, &v1alpha1.FluxDependencyReference{
+			Name:      dep.Name,
+			Namespace: dep.Namespace,
+		})
+	}
+	if install := rel.Spec.Install; install != nil {
+		var retries int32
+		if install.Remediation != nil {
+			retries = int32(install.Remediation.Retries)
+		}
+		if install.CRDs != "" || retries != 0 {
+			detail.Install = &v1alpha1.FluxHelmReleaseInstall{
+				Crds:               string(install.CRDs),
+				RemediationRetries: retries,
+			}
+		}
+	}
+	if upgrade := rel.Spec.Upgrade; upgrade != nil {
+		var retries int32
+		if upgrade.Remediation != nil {
+			retries = int32(upgrade.Remediation.Retries)
+		}
+		if upgrade.CRDs != "" || retries != 0 || upgrade.CleanupOnFail {
+			detail.Upgrade = &v1alpha1.FluxHelmReleaseUpgrade{
+				Crds:               string(upgrade.CRDs),
+				RemediationRetries: retries,
+				CleanupOnFail:      upgrade.CleanupOnFail,
+			}
+		}
+	}
+	if len(detail.DependsOn) == 0 && detail.Install == nil && detail.Upgrade == nil {
+		return nil
+	}
+	return detail
+}
+
 // returns 3 things:
 // - ready:  whether the HelmRelease object is in a ready state
 // - reason: one of SUCCESS/FAILURE/PENDING/UNSPECIFIED,
@@ -581,7 +804,7 @@ func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.Namesp
 //     when install completes with success
 //   - "reason" field: failure only when flux returns "InstallFailed" reason
 //     otherwise pending or unspecified when there are no status conditions to go by
-func isHelmReleaseReady(rel helmv2beta2.HelmRelease) (ready bool, status corev1.InstalledPackageStatus_StatusReason, userReason string) {
+func isHelmReleaseReady(rel helmv2.HelmRelease) (ready bool, status corev1.InstalledPackageStatus_StatusReason, userReason string) {
 	if !checkReleaseGeneration(rel) {
 		// according to https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
 		// observedGeneration represents the .metadata.generation that the condition was set based upon.
@@ -601,9 +824,9 @@ func isHelmReleaseReady(rel helmv2beta2.HelmRelease) (ready bool, status corev1.
 			//   "reason": "InstallFailed"
 			// i.e. not super useful
 			userReason = readyCond.Reason
-			if userReason == helmv2beta2.InstallFailedReason ||
-				userReason == helmv2beta2.UpgradeFailedReason ||
-				userReason == helmv2beta2.GetLastReleaseFailedReason {
+			if userReason == helmv2.InstallFailedReason ||
+				userReason == helmv2.UpgradeFailedReason ||
+				userReason == getLastReleaseFailedReason {
 				isInstallFailed = true
 			}
 		}
@@ -627,7 +850,7 @@ func isHelmReleaseReady(rel helmv2beta2.HelmRelease) (ready bool, status corev1.
 	return false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING, userReason
 }
 
-func installedPackageStatus(rel helmv2beta2.HelmRelease) *corev1.InstalledPackageStatus {
+func installedPackageStatus(rel helmv2.HelmRelease) *corev1.InstalledPackageStatus {
 	ready, reason, userReason := isHelmReleaseReady(rel)
 	return &corev1.InstalledPackageStatus{
 		Ready:      ready,
@@ -636,7 +859,7 @@ func installedPackageStatus(rel helmv2beta2.HelmRelease) *corev1.InstalledPackag
 	}
 }
 
-func installedPackageReconciliationOptions(rel *helmv2beta2.HelmRelease) *corev1.ReconciliationOptions {
+func installedPackageReconciliationOptions(rel *helmv2.HelmRelease) *corev1.ReconciliationOptions {
 	reconciliationOptions := &corev1.ReconciliationOptions{}
 	reconciliationOptions.Interval = pkgutils.FromDuration(&rel.Spec.Interval)
 	reconciliationOptions.Suspend = rel.Spec.Suspend
@@ -644,16 +867,20 @@ func installedPackageReconciliationOptions(rel *helmv2beta2.HelmRelease) *corev1
 	return reconciliationOptions
 }
 
-func installedPackageAvailablePackageRef(rel *helmv2beta2.HelmRelease) (*corev1.AvailablePackageReference, error) {
-	repoName := rel.Spec.Chart.Spec.SourceRef.Name
+func installedPackageAvailablePackageRef(rel *helmv2.HelmRelease) (*corev1.AvailablePackageReference, error) {
+	sourceRef := releaseChartSourceRef(rel)
+	repoName := sourceRef.Name
 	if repoName == "" {
+		if rel.HasChartRef() {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Missing required field spec.chartRef.name"))
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Missing required field spec.chart.spec.sourceRef.name"))
 	}
-	chartName := rel.Spec.Chart.Spec.Chart
-	if chartName == "" {
+	if !rel.HasChartRef() && rel.Spec.Chart.Spec.Chart == "" {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Missing required field spec.chart.spec.chart"))
 	}
-	repoNamespace := rel.Spec.Chart.Spec.SourceRef.Namespace
+	chartName := chartNameFromRelease(rel)
+	repoNamespace := sourceRef.Namespace
 	// CrossNamespaceObjectReference namespace is optional, so
 	if repoNamespace == "" {
 		name, err := common.NamespacedName(rel)
@@ -669,8 +896,54 @@ func installedPackageAvailablePackageRef(rel *helmv2beta2.HelmRelease) (*corev1.
 	}, nil
 }
 
+// chartNameFromRelease returns the name of the chart of a release, which for a
+// GitRepository or a Bucket source is derived from its path in the artifact.
+// The chartRef of an OCIRepository doesn't name the chart, which is known once
+// installed. Until then, the name of the source is used, same as for a chart at
+// the root of the artifact of the other sources.
+func chartNameFromRelease(rel *helmv2.HelmRelease) string {
+	if rel.HasChartRef() {
+		if snapshot := rel.Status.History.Latest(); snapshot != nil && snapshot.ChartName != "" {
+			return snapshot.ChartName
+		}
+		return rel.Spec.ChartRef.Name
+	} else if rel.Spec.Chart == nil {
+		return ""
+	}
+	switch rel.Spec.Chart.Spec.SourceRef.Kind {
+	case sourcev1.GitRepositoryKind, sourcev1beta2.BucketKind:
+		return chartNameFromPath(rel.Spec.Chart.Spec.SourceRef.Name, rel.Spec.Chart.Spec.Chart)
+	default:
+		return rel.Spec.Chart.Spec.Chart
+	}
+}
+
+// releaseChartSourceRef returns the reference to the source of the chart of a
+// release, either from its chart template or from its chartRef
+func releaseChartSourceRef(rel *helmv2.HelmRelease) helmv2.CrossNamespaceObjectReference {
+	if rel.HasChartRef() {
+		return helmv2.CrossNamespaceObjectReference{
+			Kind:      rel.Spec.ChartRef.Kind,
+			Name:      rel.Spec.ChartRef.Name,
+			Namespace: rel.Spec.ChartRef.Namespace,
+		}
+	} else if rel.Spec.Chart == nil {
+		return helmv2.CrossNamespaceObjectReference{}
+	}
+	return rel.Spec.Chart.Spec.SourceRef
+}
+
+// releaseChartVersion returns the version of the chart template of a release,
+// as a chartRef has none
+func releaseChartVersion(rel *helmv2.HelmRelease) string {
+	if rel.Spec.Chart == nil {
+		return ""
+	}
+	return rel.Spec.Chart.Spec.Version
+}
+
 // ref https://fluxcd.io/docs/components/helm/helmreleases/
-func helmReleaseName(key types.NamespacedName, rel *helmv2beta2.HelmRelease) types.NamespacedName {
+func helmReleaseName(key types.NamespacedName, rel *helmv2.HelmRelease) types.NamespacedName {
 	helmReleaseName := rel.Spec.ReleaseName
 	// according to docs ReleaseName is optional and defaults to a composition of
 	// '[TargetNamespace-]Name'.
@@ -691,7 +964,7 @@ func helmReleaseName(key types.NamespacedName, rel *helmv2beta2.HelmRelease) typ
 	return types.NamespacedName{Name: helmReleaseName, Namespace: helmReleaseNamespace}
 }
 
-func checkReleaseGeneration(rel helmv2beta2.HelmRelease) bool {
+func checkReleaseGeneration(rel helmv2.HelmRelease) bool {
 	generation := rel.GetGeneration()
 	observedGeneration := rel.Status.ObservedGeneration
 	return generation > 0 && generation == observedGeneration
@@ -12,7 +12,7 @@ import (
 	"time"
 
 	"github.com/bufbuild/connect-go"
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
@@ -20,10 +20,13 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs/resourcerefstest"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
 	"helm.sh/helm/v3/pkg/release"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -43,7 +46,7 @@ type testSpecGetInstalledPackages struct {
 	releaseValues             *v1.JSON
 	releaseSuspend            bool
 	releaseServiceAccountName string
-	releaseStatus             helmv2beta2.HelmReleaseStatus
+	releaseStatus             helmv2.HelmReleaseStatus
 	// only used to test edge cases now, most tests should not set this
 	targetNamespace string
 }
@@ -511,7 +514,7 @@ func TestCreateInstalledPackage(t *testing.T) {
 		existingObjs            testSpecCreateInstalledPackage
 		expectedStatusCode      codes.Code
 		expectedResponse        *corev1.CreateInstalledPackageResponse
-		expectedRelease         *helmv2beta2.HelmRelease
+		expectedRelease         *helmv2.HelmRelease
 		defaultUpgradePolicyStr string
 	}{
 		{
@@ -574,6 +577,18 @@ func TestCreateInstalledPackage(t *testing.T) {
 			expectedResponse:   create_installed_package_resp_my_podinfo,
 			expectedRelease:    flux_helm_release_values,
 		},
+		{
+			name:    "create package (custom detail)",
+			request: create_package_custom_detail_req(),
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     testYaml("podinfo-index.yaml"),
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse:   create_installed_package_resp_my_podinfo,
+			expectedRelease:    flux_helm_release_custom_detail,
+		},
 		{
 			name:    "create with specific version (upgrade policy none)",
 			request: create_package_for_test_of_upgrade_policy,
@@ -686,12 +701,12 @@ func TestCreateInstalledPackage(t *testing.T) {
 				t.Fatal(err)
 			} else {
 				key := types.NamespacedName{Namespace: tc.request.TargetContext.Namespace, Name: tc.request.Name}
-				var actualRel helmv2beta2.HelmRelease
+				var actualRel helmv2.HelmRelease
 				if err = ctrlClient.Get(context.Background(), key, &actualRel); err != nil {
 					t.Fatal(err)
 				} else {
 					// Values are JSON string and need to be compared as such
-					opts = cmpopts.IgnoreFields(helmv2beta2.HelmReleaseSpec{}, "Values")
+					opts = cmpopts.IgnoreFields(helmv2.HelmReleaseSpec{}, "Values")
 
 					// Manually setting TypeMeta, as the fakeclient doesn't do it anymore:
 					// https://github.com/kubernetes-sigs/controller-runtime/pull/2633
@@ -714,7 +729,7 @@ func TestUpdateInstalledPackage(t *testing.T) {
 		existingK8sObjs         *testSpecGetInstalledPackages
 		expectedErrorCode       connect.Code
 		expectedResponse        *corev1.UpdateInstalledPackageResponse
-		expectedRelease         *helmv2beta2.HelmRelease
+		expectedRelease         *helmv2.HelmRelease
 		defaultUpgradePolicyStr string
 	}{
 		{
@@ -835,6 +850,20 @@ func TestUpdateInstalledPackage(t *testing.T) {
 			existingK8sObjs:   &redis_existing_spec_pending,
 			expectedErrorCode: connect.CodeInternal,
 		},
+		{
+			name: "update package with an invalid CRDs policy errors",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: my_redis_ref,
+				CustomDetail: func() *anypb.Any {
+					customDetail, _ := anypb.New(&v1alpha1.FluxHelmReleaseCustomDetail{
+						Upgrade: &v1alpha1.FluxHelmReleaseUpgrade{Crds: "Replace"},
+					})
+					return customDetail
+				}(),
+			},
+			existingK8sObjs:   &redis_existing_spec_completed,
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
 
 		// test case update installed package that has failed reconciliation will be done
 		// in integration test
@@ -894,7 +923,7 @@ func TestUpdateInstalledPackage(t *testing.T) {
 				Name:      tc.expectedResponse.InstalledPackageRef.Identifier,
 			}
 			ctx := context.Background()
-			var actualRel helmv2beta2.HelmRelease
+			var actualRel helmv2.HelmRelease
 			if ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster); err != nil {
 				t.Fatal(err)
 			} else if err = ctrlClient.Get(ctx, key, &actualRel); err != nil {
@@ -902,7 +931,7 @@ func TestUpdateInstalledPackage(t *testing.T) {
 			}
 
 			// Values are JSON string and need to be compared as such
-			opts = cmpopts.IgnoreFields(helmv2beta2.HelmReleaseSpec{}, "Values")
+			opts = cmpopts.IgnoreFields(helmv2.HelmReleaseSpec{}, "Values")
 
 			// Manually setting TypeMeta, as the fakeclient doesn't do it anymore:
 			// https://github.com/kubernetes-sigs/controller-runtime/pull/2633
@@ -985,7 +1014,7 @@ func TestDeleteInstalledPackage(t *testing.T) {
 				Name:      tc.request.InstalledPackageRef.Identifier,
 			}
 			ctx := context.Background()
-			var actualRel helmv2beta2.HelmRelease
+			var actualRel helmv2.HelmRelease
 			if ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster); err != nil {
 				t.Fatal(err)
 			} else if err = ctrlClient.Get(ctx, key, &actualRel); !errors.IsNotFound(err) {
@@ -1129,7 +1158,7 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 	}
 }
 
-func newChartsAndReleases(t *testing.T, existingK8sObjs []testSpecGetInstalledPackages) (charts []sourcev1beta2.HelmChart, releases []helmv2beta2.HelmRelease, cleanup func()) {
+func newChartsAndReleases(t *testing.T, existingK8sObjs []testSpecGetInstalledPackages) (charts []sourcev1beta2.HelmChart, releases []helmv2.HelmRelease, cleanup func()) {
 	httpServers := []*httptest.Server{}
 	cleanup = func() {
 		for _, ts := range httpServers {
@@ -1137,7 +1166,7 @@ func newChartsAndReleases(t *testing.T, existingK8sObjs []testSpecGetInstalledPa
 		}
 	}
 	charts = []sourcev1beta2.HelmChart{}
-	releases = []helmv2beta2.HelmRelease{}
+	releases = []helmv2.HelmRelease{}
 
 	for _, existing := range existingK8sObjs {
 		tarGzBytes, err := os.ReadFile(existing.chartTarGz)
@@ -1183,12 +1212,12 @@ func newChartsAndReleases(t *testing.T, existingK8sObjs []testSpecGetInstalledPa
 		chart := newChart(existing.chartName, existing.repoNamespace, chartSpec, chartStatus)
 		charts = append(charts, chart)
 
-		releaseSpec := &helmv2beta2.HelmReleaseSpec{
-			Chart: helmv2beta2.HelmChartTemplate{
-				Spec: helmv2beta2.HelmChartTemplateSpec{
+		releaseSpec := &helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
 					Chart:   existing.chartName,
 					Version: existing.chartSpecVersion,
-					SourceRef: helmv2beta2.CrossNamespaceObjectReference{
+					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Name:      existing.repoName,
 						Kind:      sourcev1beta2.HelmRepositoryKind,
 						Namespace: existing.repoNamespace,
@@ -1217,8 +1246,8 @@ func newChartsAndReleases(t *testing.T, existingK8sObjs []testSpecGetInstalledPa
 	return charts, releases, cleanup
 }
 
-func newRelease(meta metav1.ObjectMeta, spec *helmv2beta2.HelmReleaseSpec, status *helmv2beta2.HelmReleaseStatus) helmv2beta2.HelmRelease {
-	helmRelease := helmv2beta2.HelmRelease{
+func newRelease(meta metav1.ObjectMeta, spec *helmv2.HelmReleaseSpec, status *helmv2.HelmReleaseStatus) helmv2.HelmRelease {
+	helmRelease := helmv2.HelmRelease{
 		ObjectMeta: meta,
 	}
 
@@ -1231,3 +1260,55 @@ func newRelease(meta metav1.ObjectMeta, spec *helmv2beta2.HelmReleaseSpec, statu
 	}
 	return helmRelease
 }
+
+func TestHelmReleaseCustomDetail(t *testing.T) {
+	ignoreTestFailures := true
+	rel := &helmv2.HelmRelease{
+		Spec: helmv2.HelmReleaseSpec{
+			Install: &helmv2.Install{
+				DisableWait: true,
+				Remediation: &helmv2.InstallRemediation{IgnoreTestFailures: &ignoreTestFailures},
+			},
+		},
+	}
+	if got := helmReleaseCustomDetail(rel); got != nil {
+		t.Errorf("got: %v, want: nil", got)
+	}
+
+	detail := &v1alpha1.FluxHelmReleaseCustomDetail{
+		DependsOn: []*v1alpha1.FluxDependencyReference{{Name: "my-redis", Namespace: "db"}},
+		Install: &v1alpha1.FluxHelmReleaseInstall{
+			Crds:               string(helmv2.Skip),
+			RemediationRetries: 2,
+		},
+		Upgrade: &v1alpha1.FluxHelmReleaseUpgrade{
+			Crds:          string(helmv2.CreateReplace),
+			CleanupOnFail: true,
+		},
+	}
+	if err := applyHelmReleaseCustomDetail(rel, detail); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := helmReleaseCustomDetail(rel), detail; !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+	}
+	// the install options not exposed by the custom detail are left intact
+	if !rel.Spec.Install.DisableWait || !rel.Spec.Install.Remediation.MustIgnoreTestFailures(false) {
+		t.Errorf("unexpected install options: %+v", rel.Spec.Install)
+	}
+
+	// an empty custom detail resets the options
+	if err := applyHelmReleaseCustomDetail(rel, &v1alpha1.FluxHelmReleaseCustomDetail{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := helmReleaseCustomDetail(rel); got != nil {
+		t.Errorf("got: %v, want: nil", got)
+	}
+
+	err := applyHelmReleaseCustomDetail(rel, &v1alpha1.FluxHelmReleaseCustomDetail{
+		DependsOn: []*v1alpha1.FluxDependencyReference{{Namespace: "db"}},
+	})
+	if got, want := connect.CodeOf(err), connect.CodeInvalidArgument; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
@@ -167,7 +167,11 @@ func (s *Server) getChartsForRepos(ctx context.Context, headers http.Header, ns
 		return nil, err
 	}
 
-	chartsTyped := make(map[string][]models.Chart)
+	// the charts of GitRepositories and Buckets are not in the repo cache
+	chartsTyped, err := s.getChartsForChartSources(ctx, headers, ns, match)
+	if err != nil {
+		return nil, err
+	}
 	for key, value := range chartsUntyped {
 		if value == nil {
 			chartsTyped[key] = nil
@@ -232,7 +236,9 @@ func (s *Server) newRepo(ctx context.Context, request *connect.Request[corev1.Ad
 	}
 
 	typ := request.Msg.GetType()
-	if typ != "helm" && typ != sourcev1beta2.HelmRepositoryTypeOCI {
+	if typ == gitRepositoryType || typ == bucketType || typ == ociRepositoryType {
+		return s.newChartSource(ctx, request)
+	} else if typ != "helm" && typ != sourcev1beta2.HelmRepositoryTypeOCI {
 		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Repository type [%s] not supported", typ))
 	}
 
@@ -302,7 +308,13 @@ func (s *Server) repoDetail(ctx context.Context, headers http.Header, repoRef *c
 	key := types.NamespacedName{Namespace: repoRef.Context.Namespace, Name: repoRef.Identifier}
 
 	repo, err := s.getRepoInCluster(ctx, headers, key)
-	if err != nil {
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// not a HelmRepository, but it may be a GitRepository or a Bucket
+		if src, srcErr := s.getChartSourceInCluster(ctx, headers, key); srcErr == nil {
+			return s.chartSourceDetail(ctx, headers, src)
+		}
+		return nil, err
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -358,10 +370,13 @@ func (s *Server) repoDetail(ctx context.Context, headers http.Header, repoRef *c
 func (s *Server) repoSummaries(ctx context.Context, headers http.Header, ns string) ([]*corev1.PackageRepositorySummary, error) {
 	summaries := []*corev1.PackageRepositorySummary{}
 	var repos []sourcev1beta2.HelmRepository
+	var sources []chartSource
 	var err error
 	if ns == apiv1.NamespaceAll {
 		if repos, err = s.listReposInNamespace(ctx, headers, ns); err != nil {
 			return nil, err
+		} else if sources, err = s.listChartSourcesInNamespace(ctx, headers, ns); err != nil {
+			return nil, err
 		}
 	} else {
 		// here, the right semantics are different than that of availablePackageSummaries()
@@ -374,6 +389,8 @@ func (s *Server) repoSummaries(ctx context.Context, headers http.Header, ns stri
 			return nil, err
 		} else if err = client.List(ctx, &repoList); err != nil {
 			return nil, connecterror.FromK8sError("list", "HelmRepository", "", err)
+		} else if sources, err = s.listChartSourcesForUser(ctx, headers, ns); err != nil {
+			return nil, err
 		} else {
 			repos = repoList.Items
 		}
@@ -406,13 +423,21 @@ func (s *Server) repoSummaries(ctx context.Context, headers http.Header, ns stri
 		}
 		summaries = append(summaries, summary)
 	}
+	for _, src := range sources {
+		summaries = append(summaries, s.chartSourceSummary(src))
+	}
 	return summaries, nil
 }
 
 func (s *Server) updateRepo(ctx context.Context, repoRef *corev1.PackageRepositoryReference, request *connect.Request[corev1.UpdatePackageRepositoryRequest]) (*corev1.PackageRepositoryReference, error) {
 	key := types.NamespacedName{Namespace: repoRef.GetContext().GetNamespace(), Name: repoRef.GetIdentifier()}
 	repo, err := s.getRepoInCluster(ctx, request.Header(), key)
-	if err != nil {
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		if src, srcErr := s.getChartSourceInCluster(ctx, request.Header(), key); srcErr == nil {
+			return s.updateChartSource(ctx, src, request)
+		}
+		return nil, err
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -536,6 +561,13 @@ func (s *Server) deleteRepo(ctx context.Context, headers http.Header, repoRef *c
 		},
 	}
 	if err = client.Delete(ctx, repo); err != nil {
+		if ctrlclient.IgnoreNotFound(err) == nil {
+			// not a HelmRepository, but it may be a GitRepository or a Bucket
+			key := types.NamespacedName{Namespace: repoRef.Context.Namespace, Name: repoRef.Identifier}
+			if src, srcErr := s.getChartSourceInCluster(ctx, headers, key); srcErr == nil {
+				return s.deleteChartSource(ctx, headers, src)
+			}
+		}
 		return connecterror.FromK8sError("delete", "HelmRepository", repoRef.Identifier, err)
 	} else {
 		return nil
@@ -855,12 +887,18 @@ func isRepoReady(repo sourcev1beta2.HelmRepository) bool {
 // docs:
 // 1. https://fluxcd.io/docs/components/source/helmrepositories/#status-examples
 func isHelmRepositoryReady(repo sourcev1beta2.HelmRepository) (complete bool, success bool, reason string) {
+	return isSourceReady(repo.GetConditions(), checkRepoGeneration(repo))
+}
+
+// isSourceReady is the implementation of isHelmRepositoryReady() shared with the
+// other flux sources, given whether the current generation has been observed
+func isSourceReady(conditions []metav1.Condition, generationObserved bool) (complete bool, success bool, reason string) {
 	// flux source-controller v1beta2 API made a change so that we can no longer
 	// rely on a simple "metadata.generation" vs "status.observedGeneration" check for a
 	// quick answer. The resource may now exist with "observedGeneration": -1 either in
 	// pending or in a failed state. We need to distinguish between the two. Personally,
 	// feels like a mistake to me.
-	readyCond := meta.FindStatusCondition(repo.GetConditions(), fluxmeta.ReadyCondition)
+	readyCond := meta.FindStatusCondition(conditions, fluxmeta.ReadyCondition)
 	if readyCond != nil {
 		if readyCond.Reason != "" {
 			// this could be something like "reason": "Succeeded" i.e. not super-useful
@@ -873,7 +911,7 @@ func isHelmRepositoryReady(repo sourcev1beta2.HelmRepository) (complete bool, su
 		}
 		switch readyCond.Status {
 		case metav1.ConditionTrue:
-			return checkRepoGeneration(repo), true, reason
+			return generationObserved, true, reason
 		case metav1.ConditionFalse:
 			return true, false, reason
 			// metav1.ConditionUnknown falls through
@@ -884,6 +922,10 @@ func isHelmRepositoryReady(repo sourcev1beta2.HelmRepository) (complete bool, su
 
 func repoStatus(repo sourcev1beta2.HelmRepository) *corev1.PackageRepositoryStatus {
 	complete, success, reason := isHelmRepositoryReady(repo)
+	return sourceStatus(complete, success, reason)
+}
+
+func sourceStatus(complete, success bool, reason string) *corev1.PackageRepositoryStatus {
 	s := &corev1.PackageRepositoryStatus{
 		Ready:      complete && success,
 		Reason:     corev1.PackageRepositoryStatus_STATUS_REASON_UNSPECIFIED,
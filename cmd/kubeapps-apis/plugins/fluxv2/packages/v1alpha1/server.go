@@ -12,14 +12,19 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resources"
 
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
 
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
@@ -44,6 +49,7 @@ var _ corev1connect.RepositoriesServiceHandler = (*Server)(nil)
 type Server struct {
 	v1alpha1.UnimplementedFluxV2PackagesServiceServer
 	v1alpha1.UnimplementedFluxV2RepositoriesServiceServer
+	pkgutils.CorePackagesRPCs
 
 	// kubeappsCluster specifies the cluster on which Kubeapps is installed.
 	kubeappsCluster string
@@ -61,6 +67,8 @@ type Server struct {
 
 	repoCache  *cache.NamespacedResourceWatcherCache
 	chartCache *cache.ChartCache
+	// the charts of GitRepositories and Buckets, which are not in the repo cache
+	chartSourceIndex *chartSourceIndex
 
 	pluginConfig *common.FluxPluginConfig
 }
@@ -93,7 +101,15 @@ func NewServer(configGetter core.KubernetesConfigGetter, kubeappsCluster string,
 		if err != nil {
 			log.Fatalf("%s", err)
 		}
-		err = helmv2beta2.AddToScheme(scheme)
+		err = sourcev1.AddToScheme(scheme)
+		if err != nil {
+			log.Fatalf("%s", err)
+		}
+		err = helmv2.AddToScheme(scheme)
+		if err != nil {
+			log.Fatalf("%s", err)
+		}
+		err = kustomizev1.AddToScheme(scheme)
 		if err != nil {
 			log.Fatalf("%s", err)
 		}
@@ -139,11 +155,12 @@ func NewServer(configGetter core.KubernetesConfigGetter, kubeappsCluster string,
 				clientGetter:               clientProvider,
 				serviceAccountClientGetter: backgroundClientGetter,
 				actionConfigGetter: helm.NewHelmActionConfigGetter(
-					configGetter, kubeappsCluster),
-				repoCache:       repoCache,
-				chartCache:      chartCache,
-				kubeappsCluster: kubeappsCluster,
-				pluginConfig:    pluginConfig,
+					configGetter, kubeappsCluster, agent.StorageForSecrets),
+				repoCache:        repoCache,
+				chartCache:       chartCache,
+				chartSourceIndex: newChartSourceIndex(maxChartSourceIndexSize),
+				kubeappsCluster:  kubeappsCluster,
+				pluginConfig:     pluginConfig,
 			}, nil
 		}
 	}
@@ -353,8 +370,14 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *connect
 		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]", cluster))
 	}
 
-	key := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
-	pkgDetail, err := s.installedPackageDetail(ctx, request.Header(), key)
+	var pkgDetail *corev1.InstalledPackageDetail
+	var err error
+	if isKustomizationIdentifier(packageRef.Identifier) {
+		pkgDetail, err = s.kustomizationDetail(ctx, request.Header(), kustomizationKey(packageRef))
+	} else {
+		key := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
+		pkgDetail, err = s.installedPackageDetail(ctx, request.Header(), key)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -389,9 +412,30 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *connect.Re
 	if cluster != "" && cluster != s.kubeappsCluster {
 		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.TargetContext.Cluster: [%v]", request.Msg.TargetContext.Cluster))
 	}
+	if request.Msg.DryRun {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.DryRun: [%v]", request.Msg.DryRun))
+	}
 
 	name := types.NamespacedName{Name: request.Msg.Name, Namespace: request.Msg.TargetContext.Namespace}
 
+	if isKustomizationCustomDetail(request.Msg.CustomDetail) {
+		if installedRef, err := s.newKustomization(
+			ctx,
+			request.Header(),
+			request.Msg.AvailablePackageRef,
+			name,
+			request.Msg.PkgVersionReference,
+			request.Msg.ReconciliationOptions,
+			request.Msg.Values,
+			request.Msg.CustomDetail); err != nil {
+			return nil, err
+		} else {
+			return connect.NewResponse(&corev1.CreateInstalledPackageResponse{
+				InstalledPackageRef: installedRef,
+			}), nil
+		}
+	}
+
 	if installedRef, err := s.newRelease(
 		ctx,
 		request.Header(),
@@ -399,7 +443,8 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *connect.Re
 		name,
 		request.Msg.PkgVersionReference,
 		request.Msg.ReconciliationOptions,
-		request.Msg.Values); err != nil {
+		request.Msg.Values,
+		request.Msg.CustomDetail); err != nil {
 		return nil, err
 	} else {
 		return connect.NewResponse(&corev1.CreateInstalledPackageResponse{
@@ -421,6 +466,26 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Re
 	if cluster != "" && cluster != s.kubeappsCluster {
 		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.installedPackageRef.Context.Cluster: [%v]", cluster))
 	}
+	if request.Msg.DryRun {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.DryRun: [%v]", request.Msg.DryRun))
+	}
+
+	if isKustomizationIdentifier(installedPackageRef.Identifier) {
+		if installedRef, err := s.updateKustomization(
+			ctx,
+			request.Header(),
+			installedPackageRef,
+			request.Msg.PkgVersionReference,
+			request.Msg.ReconciliationOptions,
+			request.Msg.Values,
+			request.Msg.CustomDetail); err != nil {
+			return nil, err
+		} else {
+			return connect.NewResponse(&corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: installedRef,
+			}), nil
+		}
+	}
 
 	if installedRef, err := s.updateRelease(
 		ctx,
@@ -428,7 +493,8 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Re
 		installedPackageRef,
 		request.Msg.PkgVersionReference,
 		request.Msg.ReconciliationOptions,
-		request.Msg.Values); err != nil {
+		request.Msg.Values,
+		request.Msg.CustomDetail); err != nil {
 		return nil, err
 	} else {
 		return connect.NewResponse(&corev1.UpdateInstalledPackageResponse{
@@ -451,7 +517,11 @@ func (s *Server) DeleteInstalledPackage(ctx context.Context, request *connect.Re
 		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.installedPackageRef.Context.Cluster: [%v]", cluster))
 	}
 
-	if err := s.deleteRelease(ctx, request.Header(), request.Msg.InstalledPackageRef); err != nil {
+	deleteFn := s.deleteRelease
+	if isKustomizationIdentifier(installedPackageRef.Identifier) {
+		deleteFn = s.deleteKustomization
+	}
+	if err := deleteFn(ctx, request.Header(), request.Msg.InstalledPackageRef); err != nil {
 		return nil, err
 	} else {
 		return connect.NewResponse(&corev1.DeleteInstalledPackageResponse{}), nil
@@ -465,13 +535,22 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 	identifier := pkgRef.GetIdentifier()
 	log.InfoS("+fluxv2 GetInstalledPackageResourceRefs", "cluster", pkgRef.GetContext().GetCluster(), "namespace", pkgRef.GetContext().GetNamespace(), "id", identifier)
 
-	key := types.NamespacedName{Namespace: pkgRef.Context.Namespace, Name: identifier}
-	rel, err := s.getReleaseInCluster(ctx, request.Header(), key)
-	if err != nil {
-		return nil, err
+	var key types.NamespacedName
+	var refs []*corev1.ResourceRef
+	var err error
+	if isKustomizationIdentifier(identifier) {
+		// the resources applied by a Kustomization are recorded in its inventory
+		key = kustomizationKey(pkgRef)
+		refs, err = s.kustomizationResourceRefs(ctx, request.Header(), key)
+	} else {
+		key = types.NamespacedName{Namespace: pkgRef.Context.Namespace, Name: identifier}
+		var rel *helmv2.HelmRelease
+		if rel, err = s.getReleaseInCluster(ctx, request.Header(), key); err != nil {
+			return nil, err
+		}
+		hrName := helmReleaseName(key, rel)
+		refs, err = resourcerefs.GetInstalledPackageResourceRefs(request.Header(), hrName, s.actionConfigGetter)
 	}
-	hrName := helmReleaseName(key, rel)
-	refs, err := resourcerefs.GetInstalledPackageResourceRefs(request.Header(), hrName, s.actionConfigGetter)
 	if err != nil {
 		return nil, err
 	} else {
@@ -494,6 +573,68 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 	}
 }
 
+// WatchInstalledPackages streams the changes of the installed packages managed by the 'fluxv2' plugin
+func (s *Server) WatchInstalledPackages(ctx context.Context, request *connect.Request[corev1.WatchInstalledPackagesRequest], stream *connect.ServerStream[corev1.WatchInstalledPackagesResponse]) error {
+	return s.WatchInstalledPackageEvents(ctx, request.Header(), request.Msg, stream.Send)
+}
+
+// WatchInstalledPackageEvents watches the flux HelmReleases of the requested
+// context, sending an event for each change until the context is done.
+func (s *Server) WatchInstalledPackageEvents(ctx context.Context, headers http.Header, request *corev1.WatchInstalledPackagesRequest, send func(*corev1.WatchInstalledPackagesResponse) error) error {
+	cluster := request.GetContext().GetCluster()
+	namespace := request.GetContext().GetNamespace()
+	log.InfoS("+fluxv2 WatchInstalledPackages", "cluster", cluster, "namespace", namespace)
+
+	if cluster != "" && cluster != s.kubeappsCluster {
+		return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.Context.Cluster: [%v]", cluster))
+	}
+
+	watcher, err := s.watchReleasesInCluster(ctx, headers, namespace)
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	for {
+		var event watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok = <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+		}
+
+		if event.Type == watch.Error {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to watch the HelmReleases: %w", k8serrors.FromObject(event.Object)))
+		}
+		eventType, ok := installedPackageEventTypes[event.Type]
+		if !ok {
+			continue
+		}
+		rel, ok := event.Object.(*helmv2.HelmRelease)
+		if !ok {
+			continue
+		}
+		summary, err := s.installedPkgSummaryFromRelease(ctx, headers, *rel)
+		if err != nil {
+			log.Warningf("Failed to get the installed package summary for HelmRelease [%s/%s] due to: %+v", rel.Namespace, rel.Name, err)
+			continue
+		} else if summary == nil {
+			// not ready yet
+			continue
+		}
+		if err := send(&corev1.WatchInstalledPackagesResponse{
+			EventType:               eventType,
+			InstalledPackageSummary: summary,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
 func (s *Server) AddPackageRepository(ctx context.Context, request *connect.Request[corev1.AddPackageRepositoryRequest]) (*connect.Response[corev1.AddPackageRepositoryResponse], error) {
 	if request == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("No request provided"))
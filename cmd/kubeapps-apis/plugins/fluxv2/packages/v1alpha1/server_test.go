@@ -13,7 +13,7 @@ import (
 	"time"
 
 	"github.com/bufbuild/connect-go"
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/go-redis/redis/v8"
@@ -243,7 +243,7 @@ func newServerWithRepos(t *testing.T, repos []sourcev1beta2.HelmRepository, char
 	return newServer(t, clientGetter, nil, repos, charts)
 }
 
-func newServerWithChartsAndReleases(t *testing.T, actionConfig *action.Configuration, charts []sourcev1beta2.HelmChart, releases []helmv2beta2.HelmRelease) (*Server, redismock.ClientMock, error) {
+func newServerWithChartsAndReleases(t *testing.T, actionConfig *action.Configuration, charts []sourcev1beta2.HelmChart, releases []helmv2.HelmRelease) (*Server, redismock.ClientMock, error) {
 	typedClient := typfake.NewSimpleClientset()
 	// Creating an authorized clientGetter
 	typedClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
@@ -399,10 +399,11 @@ func newServer(t *testing.T,
 		actionConfigGetter: func(http.Header, string) (*action.Configuration, error) {
 			return actionConfig, nil
 		},
-		repoCache:       repoCache,
-		chartCache:      chartCache,
-		kubeappsCluster: KubeappsCluster,
-		pluginConfig:    common.NewDefaultPluginConfig(),
+		repoCache:        repoCache,
+		chartCache:       chartCache,
+		chartSourceIndex: newChartSourceIndex(maxChartSourceIndexSize),
+		kubeappsCluster:  KubeappsCluster,
+		pluginConfig:     common.NewDefaultPluginConfig(),
 	}
 	return s, mock, nil
 }
@@ -0,0 +1,1181 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bufbuild/connect-go"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/cache"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	httpclient "github.com/vmware-tanzu/kubeapps/pkg/http-client"
+	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
+	"google.golang.org/protobuf/types/known/anypb"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	log "k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Besides HelmRepositories, flux can install the charts found in the artifacts
+// of GitRepositories, Buckets and OCIRepositories, which are exposed as
+// repositories of types "git", "bucket" and "ocirepository". The artifact of an
+// OCIRepository is the chart pulled from the registry, which HelmReleases
+// reference with a chartRef rather than a chart template.
+// ref https://fluxcd.io/flux/components/helm/helmreleases/#chart-reference
+const (
+	fluxGitRepositories = "gitrepositories"
+	fluxBuckets         = "buckets"
+	fluxOCIRepositories = "ocirepositories"
+
+	gitRepositoryType = "git"
+	bucketType        = "bucket"
+	ociRepositoryType = "ocirepository"
+
+	defaultGitBranch = "master"
+
+	// the media type of the layer of a helm chart in an OCI registry, which
+	// OCIRepositories copy as is, so that their artifact is the chart tarball
+	// ref https://fluxcd.io/flux/components/source/ocirepositories/#layer-selector
+	helmChartContentMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+)
+
+// chartSource is a flux source other than a HelmRepository whose artifact is a
+// tarball containing charts, i.e. a *sourcev1.GitRepository, a *sourcev1beta2.Bucket
+// or a *sourcev1beta2.OCIRepository
+type chartSource interface {
+	ctrlclient.Object
+	GetArtifact() *sourcev1.Artifact
+	GetConditions() []metav1.Condition
+}
+
+// chartSourceSpec are the settings of a chart source requested when adding or
+// updating a repository
+type chartSourceSpec struct {
+	description  string
+	url          string
+	interval     metav1.Duration
+	secretName   string
+	customDetail *v1alpha1.FluxPackageRepositoryCustomDetail
+}
+
+const (
+	// the artifacts of chart sources are read in memory, so these limits prevent
+	// an oversized artifact, or one compressing a huge amount of data, from
+	// exhausting the memory of the plugin
+	maxChartSourceArtifactSize = 50 << 20
+	maxChartSourceFilesSize    = 200 << 20
+	// the maximum total size of the packaged chart tarballs kept in the index
+	maxChartSourceIndexSize = 100 << 20
+)
+
+// chartSourceIndex keeps the charts found in the artifacts of the chart sources
+// until the artifact changes. Unlike the charts of HelmRepositories, which are
+// indexed in background by the repo cache, these are indexed on demand, as
+// there are usually few of them, each with a handful of charts. When the total
+// size of the packaged charts exceeds the maximum size, the least recently used
+// entries are evicted, so the entries of the sources deleted out-of-band don't
+// accumulate.
+type chartSourceIndex struct {
+	mutex   sync.Mutex
+	maxSize int64
+	size    int64
+	// lru holds the *chartSourceIndexEntry from the most to the least recently used.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type chartSourceIndexEntry struct {
+	key    string
+	digest string
+	charts []models.Chart
+	// the packaged chart tarballs by chart name
+	tarballs map[string][]byte
+	size     int64
+}
+
+func newChartSourceIndex(maxSize int64) *chartSourceIndex {
+	return &chartSourceIndex{
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (i *chartSourceIndex) get(key, digest string) (chartSourceIndexEntry, bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	element, ok := i.entries[key]
+	if !ok {
+		return chartSourceIndexEntry{}, false
+	}
+	entry := element.Value.(*chartSourceIndexEntry)
+	if entry.digest != digest {
+		return chartSourceIndexEntry{}, false
+	}
+	i.lru.MoveToFront(element)
+	return *entry, true
+}
+
+// set adds the entry to the index, replacing the one of the same key and
+// evicting the least recently used entries if needed. An entry larger than
+// the index is not kept.
+func (i *chartSourceIndex) set(key string, entry chartSourceIndexEntry) {
+	entry.key = key
+	entry.size = 0
+	for _, tarball := range entry.tarballs {
+		entry.size += int64(len(tarball))
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if element, ok := i.entries[key]; ok {
+		i.remove(element)
+	}
+	if entry.size > i.maxSize {
+		log.Warningf("Not indexing the charts of [%s] as their size of [%d] bytes exceeds the size of the index", key, entry.size)
+		return
+	}
+	i.entries[key] = i.lru.PushFront(&entry)
+	i.size += entry.size
+	for i.size > i.maxSize {
+		i.remove(i.lru.Back())
+	}
+}
+
+func (i *chartSourceIndex) delete(key string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if element, ok := i.entries[key]; ok {
+		i.remove(element)
+	}
+}
+
+// remove removes an entry from the index. The mutex must be held.
+func (i *chartSourceIndex) remove(element *list.Element) {
+	entry := element.Value.(*chartSourceIndexEntry)
+	i.lru.Remove(element)
+	delete(i.entries, entry.key)
+	i.size -= entry.size
+}
+
+// getChartSourceInCluster returns the GitRepository, Bucket or OCIRepository with the given name
+func (s *Server) getChartSourceInCluster(ctx context.Context, headers http.Header, key types.NamespacedName) (chartSource, error) {
+	client, err := s.getClient(headers, key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var gitRepo sourcev1.GitRepository
+	if err = client.Get(ctx, key, &gitRepo); err == nil {
+		return &gitRepo, nil
+	} else if !isNotFoundOrNoMatch(err) {
+		return nil, connecterror.FromK8sError("get", sourcev1.GitRepositoryKind, key.String(), err)
+	}
+	var bucket sourcev1beta2.Bucket
+	if err = client.Get(ctx, key, &bucket); err == nil {
+		return &bucket, nil
+	} else if !isNotFoundOrNoMatch(err) {
+		return nil, connecterror.FromK8sError("get", sourcev1beta2.BucketKind, key.String(), err)
+	}
+	var ociRepo sourcev1beta2.OCIRepository
+	if err = client.Get(ctx, key, &ociRepo); err == nil {
+		return &ociRepo, nil
+	} else if !isNotFoundOrNoMatch(err) {
+		return nil, connecterror.FromK8sError("get", sourcev1beta2.OCIRepositoryKind, key.String(), err)
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find repository [%s]", key))
+}
+
+// returns the chart sources from specified namespace, which can be "", in
+// which case all namespaces (cluster-wide), excluding the ones that the caller
+// has no read access to, same as listReposInNamespace()
+func (s *Server) listChartSourcesInNamespace(ctx context.Context, headers http.Header, ns string) ([]chartSource, error) {
+	backgroundCtx := context.Background()
+	client, err := s.serviceAccountClientGetter.ControllerRuntime(backgroundCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []chartSource{}
+	for _, list := range chartSourceLists() {
+		if err := client.List(backgroundCtx, list, &ctrlclient.ListOptions{Namespace: ns}); err != nil {
+			if meta.IsNoMatchError(err) {
+				// the CRD is not installed
+				continue
+			}
+			return nil, connecterror.FromK8sError("list", chartSourceListKind(list), "", err)
+		}
+		items := chartSourceItems(list)
+		if len(items) == 0 {
+			continue
+		}
+		gvr := chartSourceGvr(items[0])
+		allowed := map[string]bool{}
+		for _, item := range items {
+			ok, found := allowed[item.GetNamespace()]
+			if !found {
+				if ok, err = s.hasAccessToNamespace(ctx, headers, gvr, item.GetNamespace()); err != nil {
+					return nil, err
+				}
+				allowed[item.GetNamespace()] = ok
+			}
+			if ok {
+				sources = append(sources, item)
+			}
+		}
+	}
+	return sources, nil
+}
+
+// returns the chart sources in the specified namespace with the caller's
+// credentials, so that a PermissionDenied error is raised if the caller has
+// no read access to it
+func (s *Server) listChartSourcesForUser(ctx context.Context, headers http.Header, ns string) ([]chartSource, error) {
+	client, err := s.getClient(headers, ns)
+	if err != nil {
+		return nil, err
+	}
+	sources := []chartSource{}
+	for _, list := range chartSourceLists() {
+		if err := client.List(ctx, list); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, connecterror.FromK8sError("list", chartSourceListKind(list), "", err)
+		}
+		sources = append(sources, chartSourceItems(list)...)
+	}
+	return sources, nil
+}
+
+// getChartsForChartSources returns the charts of the ready chart sources whose
+// name match the filter, keyed like the ones of the repo cache
+func (s *Server) getChartsForChartSources(ctx context.Context, headers http.Header, ns string, match []string) (map[string][]models.Chart, error) {
+	sources, err := s.listChartSourcesInNamespace(ctx, headers, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	charts := make(map[string][]models.Chart)
+	for _, src := range sources {
+		if !isChartSourceReady(src) || !matchesRepoFilter(src.GetName(), match) {
+			continue
+		}
+		entry, err := s.indexChartSource(src)
+		if err != nil {
+			// one broken source should not prevent listing the charts of the others
+			log.Errorf("Failed to index charts of %s [%s/%s] due to: %+v", chartSourceKind(src), src.GetNamespace(), src.GetName(), err)
+			continue
+		}
+		charts[chartSourceIndexKey(src)] = entry.charts
+	}
+	return charts, nil
+}
+
+// getChartSourceChartModel returns the model of the chart with the given name
+// from the chart source, or nil if either does not exist
+func (s *Server) getChartSourceChartModel(ctx context.Context, headers http.Header, repoName types.NamespacedName, chartName string) (*models.Chart, error) {
+	src, err := s.getChartSourceInCluster(ctx, headers, repoName)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	} else if !isChartSourceReady(src) {
+		return nil, nil
+	}
+	entry, err := s.indexChartSource(src)
+	if err != nil {
+		return nil, err
+	}
+	for _, chart := range entry.charts {
+		if chart.Name == chartName {
+			return &chart, nil
+		}
+	}
+	return nil, nil
+}
+
+// availableChartSourceChartDetail is the counterpart of availableChartDetail()
+// for the charts of chart sources, which only have the version currently found
+// in the artifact
+func (s *Server) availableChartSourceChartDetail(ctx context.Context, headers http.Header, packageRef *corev1.AvailablePackageReference, src chartSource, chartName, chartVersion string) (*corev1.AvailablePackageDetail, error) {
+	repoName := types.NamespacedName{Namespace: src.GetNamespace(), Name: src.GetName()}
+	if !isChartSourceReady(src) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Repository [%s] is not in Ready state", repoName))
+	} else if ok, err := s.hasAccessToNamespace(ctx, headers, common.GetChartsGvr(), repoName.Namespace); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("User has no [get] access for HelmCharts in namespace [%s]", repoName.Namespace))
+	}
+
+	entry, err := s.indexChartSource(src)
+	if err != nil {
+		return nil, err
+	}
+	tarball, ok := entry.tarballs[chartName]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Chart [%s] not found", chartName))
+	}
+
+	chartDetail, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(tarball))
+	if err != nil {
+		return nil, err
+	}
+	chartID := fmt.Sprintf("%s/%s", repoName.Name, chartName)
+	pkgDetail, err := availablePackageDetailFromChartDetail(chartID, chartDetail)
+	if err != nil {
+		return nil, err
+	}
+	if chartVersion != "" && chartVersion != pkgDetail.GetVersion().GetPkgVersion() {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Version [%s] of chart [%s] not found, the repository only has version [%s]", chartVersion, chartName, pkgDetail.GetVersion().GetPkgVersion()))
+	}
+
+	pkgDetail.RepoUrl = chartSourceURL(src)
+	pkgDetail.AvailablePackageRef.Context.Namespace = packageRef.Context.Namespace
+	pkgDetail.AvailablePackageRef.Context.Cluster = s.kubeappsCluster
+	return pkgDetail, nil
+}
+
+// indexChartSource returns the charts found in the artifact of the chart source,
+// downloading and indexing it unless it has already been for the same digest
+func (s *Server) indexChartSource(src chartSource) (*chartSourceIndexEntry, error) {
+	artifact := src.GetArtifact()
+	if artifact == nil || artifact.URL == "" || artifact.Digest == "" {
+		return nil, connect.NewError(connect.CodeInternal,
+			fmt.Errorf("expected field status.artifact not found on %s [%s/%s]", chartSourceKind(src), src.GetNamespace(), src.GetName()))
+	}
+
+	key := chartSourceIndexKey(src)
+	if entry, ok := s.chartSourceIndex.get(key, artifact.Digest); ok {
+		return &entry, nil
+	}
+
+	// as for the index of HelmRepositories, there is no need to provide any auth
+	// as the artifact is served by the source-controller in the local cluster
+	// e.g. http://source-controller.flux-system.svc.cluster.local./gitrepository/default/podinfo/5a3a7a1.tar.gz
+	reader, _, err := httpclient.GetStream(artifact.URL, httpclient.New(), nil)
+	if reader != nil {
+		defer reader.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	byteArray, err := readAtMost(reader, maxChartSourceArtifactSize)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to download the artifact of %s [%s/%s]: %w", chartSourceKind(src), src.GetNamespace(), src.GetName(), err))
+	}
+	charts, tarballs, err := chartsFromSourceArtifact(src, byteArray)
+	if err != nil {
+		return nil, err
+	}
+	entry := chartSourceIndexEntry{digest: artifact.Digest, charts: charts, tarballs: tarballs}
+	s.chartSourceIndex.set(key, entry)
+	log.Infof("Indexed [%d] charts of %s [%s/%s]", len(charts), chartSourceKind(src), src.GetNamespace(), src.GetName())
+	return &entry, nil
+}
+
+// chartsFromSourceArtifact finds the charts, i.e. the directories with a
+// Chart.yaml file, in the artifact tarball of a chart source, returning their
+// models along with the packaged chart tarballs. The charts within another
+// chart, such as its dependencies, are not included.
+func chartsFromSourceArtifact(src chartSource, artifact []byte) ([]models.Chart, map[string][]byte, error) {
+	files, err := filesFromTarball(artifact, maxChartSourceFilesSize)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to read the artifact of %s [%s/%s]: %w", chartSourceKind(src), src.GetNamespace(), src.GetName(), err))
+	}
+
+	chartDirs := []string{}
+	for name := range files {
+		if path.Base(name) == chartutil.ChartfileName {
+			chartDirs = append(chartDirs, path.Dir(name))
+		}
+	}
+	sort.Strings(chartDirs)
+
+	charts := []models.Chart{}
+	tarballs := map[string][]byte{}
+	var parents []string
+	for _, dir := range chartDirs {
+		if isWithinDirs(dir, parents) {
+			continue
+		}
+		parents = append(parents, dir)
+
+		metadata := &chart.Metadata{}
+		if err := yaml.Unmarshal(files[path.Join(dir, chartutil.ChartfileName)], metadata); err != nil {
+			log.Warningf("Skipping chart [%s] of %s [%s/%s] due to: %v", dir, chartSourceKind(src), src.GetNamespace(), src.GetName(), err)
+			continue
+		} else if err = metadata.Validate(); err != nil {
+			log.Warningf("Skipping chart [%s] of %s [%s/%s] due to: %v", dir, chartSourceKind(src), src.GetNamespace(), src.GetName(), err)
+			continue
+		}
+
+		name := chartNameFromPath(src.GetName(), dir)
+		if _, ok := tarballs[name]; ok {
+			log.Warningf("Skipping chart [%s] of %s [%s/%s] as there is another chart named [%s]", dir, chartSourceKind(src), src.GetNamespace(), src.GetName(), name)
+			continue
+		}
+		tarball, err := packageChartFiles(metadata.Name, dir, files)
+		if err != nil {
+			return nil, nil, err
+		}
+		digest, err := common.GetSha256(tarball)
+		if err != nil {
+			return nil, nil, err
+		}
+		tarballs[name] = tarball
+		charts = append(charts, newChartSourceChartModel(src, name, chartPathInSource(dir), digest, metadata))
+	}
+	sort.Slice(charts, func(i, j int) bool { return charts[i].ID < charts[j].ID })
+	return charts, tarballs, nil
+}
+
+func newChartSourceChartModel(src chartSource, name, chartPath, digest string, metadata *chart.Metadata) models.Chart {
+	maintainers := []chart.Maintainer{}
+	for _, m := range metadata.Maintainers {
+		if m != nil {
+			maintainers = append(maintainers, *m)
+		}
+	}
+	chartVersion := models.ChartVersion{
+		Version:    metadata.Version,
+		AppVersion: metadata.AppVersion,
+		Digest:     digest,
+		// flux references the charts of these sources by their path in the artifact
+		URLs: []string{chartPath},
+	}
+	if artifact := src.GetArtifact(); artifact != nil {
+		chartVersion.Created = artifact.LastUpdateTime.Time
+	}
+	return models.Chart{
+		ID:   fmt.Sprintf("%s/%s", src.GetName(), name),
+		Name: name,
+		Repo: &models.AppRepository{
+			Namespace: src.GetNamespace(),
+			Name:      src.GetName(),
+			URL:       chartSourceURL(src),
+			Type:      chartSourceRepoType(src),
+		},
+		Description:   metadata.Description,
+		Home:          metadata.Home,
+		Keywords:      metadata.Keywords,
+		Maintainers:   maintainers,
+		Sources:       metadata.Sources,
+		Icon:          metadata.Icon,
+		Category:      metadata.Annotations["category"],
+		ChartVersions: []models.ChartVersion{chartVersion},
+	}
+}
+
+// filesFromTarball returns the contents of the regular files of a gzipped
+// tarball by their clean relative path, failing if their total size exceeds
+// maxSize bytes
+func filesFromTarball(tarball []byte, maxSize int64) (map[string][]byte, error) {
+	gzr, err := gzip.NewReader(bytes.NewReader(tarball))
+	if err != nil {
+		return nil, err
+	}
+	defer gzr.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		if name == "" {
+			continue
+		}
+		content, err := readAtMost(tr, maxSize)
+		if err != nil {
+			return nil, err
+		}
+		maxSize -= int64(len(content))
+		files[name] = content
+	}
+	return files, nil
+}
+
+// readAtMost reads the reader until EOF, failing if it has more than limit bytes
+func readAtMost(r io.Reader, limit int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(content)) > limit {
+		return nil, fmt.Errorf("the content exceeds the limit of [%d] bytes", limit)
+	}
+	return content, nil
+}
+
+// packageChartFiles returns a gzipped tarball of the files of the chart in dir,
+// laid out as a packaged chart, i.e. under a directory named after the chart.
+// The files of the chart itself are written last so that they take precedence
+// over the ones of its dependencies when extracted by tarutil.
+func packageChartFiles(chartName, dir string, files map[string][]byte) ([]byte, error) {
+	var own, nested []string
+	for name := range files {
+		rel := name
+		if dir != "." {
+			if !strings.HasPrefix(name, dir+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(name, dir+"/")
+		}
+		if strings.Contains(rel, "/") {
+			nested = append(nested, name)
+		} else {
+			own = append(own, name)
+		}
+	}
+	sort.Strings(own)
+	sort.Strings(nested)
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for _, name := range append(nested, own...) {
+		rel := name
+		if dir != "." {
+			rel = strings.TrimPrefix(name, dir+"/")
+		}
+		header := &tar.Header{
+			Name:     path.Join(chartName, rel),
+			Mode:     0644,
+			Size:     int64(len(files[name])),
+			Typeflag: tar.TypeReg,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		} else if _, err = tw.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	} else if err = gzw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func isWithinDirs(dir string, parents []string) bool {
+	for _, parent := range parents {
+		if parent == "." || strings.HasPrefix(dir, parent+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// chartNameFromPath returns the name of a chart of a chart source, which is the
+// last element of its path or the name of the source for a chart at the root
+func chartNameFromPath(sourceName, chartPath string) string {
+	if base := path.Base(path.Clean(chartPath)); base != "." && base != "/" {
+		return base
+	}
+	return sourceName
+}
+
+func chartPathInSource(dir string) string {
+	if dir == "." {
+		return "./"
+	}
+	return "./" + dir
+}
+
+func (s *Server) newChartSource(ctx context.Context, request *connect.Request[corev1.AddPackageRepositoryRequest]) (*connect.Response[corev1.PackageRepositoryReference], error) {
+	name := types.NamespacedName{Name: request.Msg.Name, Namespace: request.Msg.Context.Namespace}
+	typ := request.Msg.GetType()
+	spec, err := s.chartSourceSpecFromRequest(ctx, request.Header(), name.Namespace, typ,
+		request.Msg.GetDescription(), request.Msg.GetUrl(), request.Msg.GetInterval(),
+		request.Msg.GetTlsConfig(), request.Msg.GetAuth(), request.Msg.GetCustomDetail())
+	if err != nil {
+		return nil, err
+	}
+
+	var src chartSource
+	switch typ {
+	case gitRepositoryType:
+		src = &sourcev1.GitRepository{}
+	case ociRepositoryType:
+		src = &sourcev1beta2.OCIRepository{}
+	default:
+		src = &sourcev1beta2.Bucket{}
+	}
+	src.SetName(name.Name)
+	src.SetNamespace(name.Namespace)
+	if err = applyChartSourceSpec(src, spec); err != nil {
+		return nil, err
+	}
+
+	if client, err := s.getClient(request.Header(), name.Namespace); err != nil {
+		return nil, err
+	} else if err = client.Create(ctx, src); err != nil {
+		return nil, connecterror.FromK8sError("create", chartSourceKind(src), name.String(), err)
+	}
+	return connect.NewResponse(&corev1.PackageRepositoryReference{
+		Context: &corev1.Context{
+			Namespace: name.Namespace,
+			Cluster:   s.kubeappsCluster,
+		},
+		Identifier: name.Name,
+		Plugin:     GetPluginDetail(),
+	}), nil
+}
+
+func (s *Server) chartSourceDetail(ctx context.Context, headers http.Header, src chartSource) (*corev1.PackageRepositoryDetail, error) {
+	auth, err := s.getChartSourceAuth(ctx, headers, src)
+	if err != nil {
+		return nil, err
+	}
+	var customDetail *anypb.Any
+	if detail := chartSourceCustomDetail(src); detail != nil {
+		if customDetail, err = anypb.New(detail); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("The customDetail could not be marshalled due to: %w", err))
+		}
+	}
+	interval := chartSourceInterval(src)
+	return &corev1.PackageRepositoryDetail{
+		PackageRepoRef: &corev1.PackageRepositoryReference{
+			Context: &corev1.Context{
+				Namespace: src.GetNamespace(),
+				Cluster:   s.kubeappsCluster,
+			},
+			Identifier: src.GetName(),
+			Plugin:     GetPluginDetail(),
+		},
+		Name:            src.GetName(),
+		Description:     src.GetAnnotations()[k8sutils.AnnotationDescriptionKey],
+		NamespaceScoped: true,
+		Type:            chartSourceRepoType(src),
+		Url:             chartSourceURL(src),
+		Interval:        pkgutils.FromDuration(&interval),
+		Auth:            auth,
+		Status:          chartSourceStatus(src),
+		CustomDetail:    customDetail,
+	}, nil
+}
+
+func (s *Server) chartSourceSummary(src chartSource) *corev1.PackageRepositorySummary {
+	return &corev1.PackageRepositorySummary{
+		PackageRepoRef: &corev1.PackageRepositoryReference{
+			Context: &corev1.Context{
+				Namespace: src.GetNamespace(),
+				Cluster:   s.kubeappsCluster,
+			},
+			Identifier: src.GetName(),
+			Plugin:     GetPluginDetail(),
+		},
+		Name:            src.GetName(),
+		Description:     src.GetAnnotations()[k8sutils.AnnotationDescriptionKey],
+		NamespaceScoped: true,
+		Type:            chartSourceRepoType(src),
+		Url:             chartSourceURL(src),
+		Status:          chartSourceStatus(src),
+		RequiresAuth:    chartSourceSecretRef(src) != nil,
+	}
+}
+
+func (s *Server) updateChartSource(ctx context.Context, src chartSource, request *connect.Request[corev1.UpdatePackageRepositoryRequest]) (*corev1.PackageRepositoryReference, error) {
+	// same as for HelmRepositories, updates to sources pending reconciliation are not supported
+	if complete, _, _ := isChartSourceReadyWithReason(src); !complete {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Updates to repositories pending reconciliation are not supported"))
+	}
+
+	spec, err := s.chartSourceSpecFromRequest(ctx, request.Header(), src.GetNamespace(), chartSourceRepoType(src),
+		request.Msg.GetDescription(), request.Msg.GetUrl(), request.Msg.GetInterval(),
+		request.Msg.GetTlsConfig(), request.Msg.GetAuth(), request.Msg.GetCustomDetail())
+	if err != nil {
+		return nil, err
+	}
+	if spec.customDetail == nil {
+		// keep the current git reference, bucket or OCI settings
+		spec.customDetail = chartSourceCustomDetail(src)
+	}
+	if err = applyChartSourceSpec(src, spec); err != nil {
+		return nil, err
+	}
+
+	// as for HelmRepositories, get rid of the status field, since now there will be a new
+	// reconciliation process and the current status no longer applies
+	switch src := src.(type) {
+	case *sourcev1.GitRepository:
+		src.Status = sourcev1.GitRepositoryStatus{}
+	case *sourcev1beta2.Bucket:
+		src.Status = sourcev1beta2.BucketStatus{}
+	case *sourcev1beta2.OCIRepository:
+		src.Status = sourcev1beta2.OCIRepositoryStatus{}
+	}
+
+	key := types.NamespacedName{Namespace: src.GetNamespace(), Name: src.GetName()}
+	if client, err := s.getClient(request.Header(), key.Namespace); err != nil {
+		return nil, err
+	} else if err = client.Update(ctx, src); err != nil {
+		return nil, connecterror.FromK8sError("update", chartSourceKind(src), key.String(), err)
+	}
+	log.V(4).Infof("Updated repository: %s", common.PrettyPrint(src))
+
+	return &corev1.PackageRepositoryReference{
+		Context: &corev1.Context{
+			Namespace: key.Namespace,
+			Cluster:   s.kubeappsCluster,
+		},
+		Identifier: key.Name,
+		Plugin:     GetPluginDetail(),
+	}, nil
+}
+
+func (s *Server) deleteChartSource(ctx context.Context, headers http.Header, src chartSource) error {
+	client, err := s.getClient(headers, src.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if err = client.Delete(ctx, src); err != nil {
+		return connecterror.FromK8sError("delete", chartSourceKind(src), src.GetName(), err)
+	}
+	s.chartSourceIndex.delete(chartSourceIndexKey(src))
+	return nil
+}
+
+// chartSourceSpecFromRequest validates the settings of an add or update request
+// for a chart source. Only user-managed secrets are supported for authentication.
+func (s *Server) chartSourceSpecFromRequest(
+	ctx context.Context,
+	headers http.Header,
+	namespace string,
+	typ string,
+	description string,
+	repoUrl string,
+	interval string,
+	tlsConfig *corev1.PackageRepositoryTlsConfig,
+	auth *corev1.PackageRepositoryAuth,
+	customDetail *anypb.Any) (*chartSourceSpec, error) {
+	if repoUrl == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Repository url may not be empty"))
+	} else if tlsConfig != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("TLS configuration is not supported for repositories of type [%s]", typ))
+	}
+
+	spec := &chartSourceSpec{
+		description: description,
+		url:         repoUrl,
+		interval:    defaultPollInterval,
+	}
+	if interval != "" {
+		if duration, err := pkgutils.ToDuration(interval); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Interval is invalid: %w", err))
+		} else {
+			spec.interval = *duration
+		}
+	}
+
+	if customDetail != nil {
+		spec.customDetail = &v1alpha1.FluxPackageRepositoryCustomDetail{}
+		if err := customDetail.UnmarshalTo(spec.customDetail); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The customDetail could not be parsed due to: %w", err))
+		}
+	}
+	switch typ {
+	case gitRepositoryType:
+		if spec.customDetail.GetProvider() != "" || spec.customDetail.GetBucketName() != "" || spec.customDetail.GetBucketRegion() != "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Only the git reference can be specified for repositories of type [%s]", typ))
+		}
+	case bucketType:
+		if spec.customDetail.GetBucketName() == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The bucket name is required for repositories of type [%s]", typ))
+		} else if spec.customDetail.GetGitRef() != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("A git reference cannot be specified for repositories of type [%s]", typ))
+		}
+		switch spec.customDetail.GetProvider() {
+		case "", sourcev1beta2.GenericBucketProvider, sourcev1beta2.AmazonBucketProvider, sourcev1beta2.GoogleBucketProvider, sourcev1beta2.AzureBucketProvider:
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Bucket provider [%s] is not supported", spec.customDetail.GetProvider()))
+		}
+	case ociRepositoryType:
+		if !strings.HasPrefix(repoUrl, sourcev1beta2.OCIRepositoryPrefix) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The url of repositories of type [%s] must start with [%s]", typ, sourcev1beta2.OCIRepositoryPrefix))
+		} else if spec.customDetail.GetGitRef() != nil || spec.customDetail.GetBucketName() != "" || spec.customDetail.GetBucketRegion() != "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Only the OCI reference and provider can be specified for repositories of type [%s]", typ))
+		}
+		switch spec.customDetail.GetProvider() {
+		case "", sourcev1beta2.GenericOCIProvider, sourcev1beta2.AmazonOCIProvider, sourcev1beta2.GoogleOCIProvider, sourcev1beta2.AzureOCIProvider:
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("OCI provider [%s] is not supported", spec.customDetail.GetProvider()))
+		}
+	}
+	if typ != ociRepositoryType && spec.customDetail.GetOciRef() != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("An OCI reference cannot be specified for repositories of type [%s]", typ))
+	}
+
+	if auth != nil && auth.GetType() != corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_UNSPECIFIED {
+		if auth.GetSecretRef() == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Only referenced secrets are supported for repositories of type [%s]", typ))
+		}
+		secretName := auth.GetSecretRef().GetName()
+		if typedClient, err := s.clientGetter.Typed(headers, s.kubeappsCluster); err != nil {
+			return nil, err
+		} else if secret, err := typedClient.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{}); err != nil {
+			return nil, connecterror.FromK8sError("get", "secret", secretName, err)
+		} else if err = validateChartSourceSecret(typ, auth.GetType(), secret); err != nil {
+			return nil, err
+		}
+		spec.secretName = secretName
+	}
+	return spec, nil
+}
+
+// checks the fields of a user-managed secret expected by flux
+// ref https://fluxcd.io/flux/components/source/gitrepositories/#secret-reference
+// ref https://fluxcd.io/flux/components/source/buckets/#secret-reference
+// ref https://fluxcd.io/flux/components/source/ocirepositories/#secret-reference
+func validateChartSourceSecret(typ string, authType corev1.PackageRepositoryAuth_PackageRepositoryAuthType, secret *apiv1.Secret) error {
+	var fields []string
+	switch {
+	case typ == gitRepositoryType && authType == corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_BASIC_AUTH:
+		fields = []string{"username", "password"}
+	case typ == gitRepositoryType && authType == corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_SSH:
+		fields = []string{"identity", "known_hosts"}
+	case typ == bucketType && authType == corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_OPAQUE:
+		fields = []string{"accesskey", "secretkey"}
+	case typ == ociRepositoryType && authType == corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_DOCKER_CONFIG_JSON:
+		fields = []string{apiv1.DockerConfigJsonKey}
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Package repository authentication type %q is not supported for repositories of type [%s]", authType, typ))
+	}
+	for _, field := range fields {
+		if secret.Data[field] == nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Specified secret [%s] missing fields '%s'", secret.Name, strings.Join(fields, "' and/or '")))
+		}
+	}
+	return nil
+}
+
+// applyChartSourceSpec sets the spec of a GitRepository, Bucket or OCIRepository
+// ref https://fluxcd.io/flux/components/source/gitrepositories/
+// ref https://fluxcd.io/flux/components/source/buckets/
+// ref https://fluxcd.io/flux/components/source/ocirepositories/
+func applyChartSourceSpec(src chartSource, spec *chartSourceSpec) error {
+	var secretRef *fluxmeta.LocalObjectReference
+	if spec.secretName != "" {
+		secretRef = &fluxmeta.LocalObjectReference{Name: spec.secretName}
+	}
+	switch src := src.(type) {
+	case *sourcev1.GitRepository:
+		k8sutils.SetDescription(&src.ObjectMeta, spec.description)
+		src.Spec.URL = spec.url
+		src.Spec.Interval = spec.interval
+		src.Spec.SecretRef = secretRef
+		ref := spec.customDetail.GetGitRef()
+		src.Spec.Reference = &sourcev1.GitRepositoryRef{
+			Branch: ref.GetBranch(),
+			Tag:    ref.GetTag(),
+			SemVer: ref.GetSemver(),
+			Commit: ref.GetCommit(),
+		}
+		if *src.Spec.Reference == (sourcev1.GitRepositoryRef{}) {
+			src.Spec.Reference.Branch = defaultGitBranch
+		}
+	case *sourcev1beta2.Bucket:
+		k8sutils.SetDescription(&src.ObjectMeta, spec.description)
+		// flux expects the bucket endpoint without a scheme, with the insecure
+		// flag for plain http
+		endpoint, insecure := spec.url, false
+		if u, err := url.Parse(spec.url); err == nil && u.Host != "" {
+			if u.Scheme != "http" && u.Scheme != "https" {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unsupported scheme [%s] of the bucket endpoint", u.Scheme))
+			}
+			endpoint, insecure = u.Host, u.Scheme == "http"
+		}
+		src.Spec.Endpoint = endpoint
+		src.Spec.Insecure = insecure
+		src.Spec.Interval = spec.interval
+		src.Spec.SecretRef = secretRef
+		src.Spec.BucketName = spec.customDetail.GetBucketName()
+		src.Spec.Region = spec.customDetail.GetBucketRegion()
+		src.Spec.Provider = spec.customDetail.GetProvider()
+	case *sourcev1beta2.OCIRepository:
+		k8sutils.SetDescription(&src.ObjectMeta, spec.description)
+		src.Spec.URL = spec.url
+		src.Spec.Interval = spec.interval
+		src.Spec.SecretRef = secretRef
+		src.Spec.Provider = spec.customDetail.GetProvider()
+		src.Spec.Reference = nil
+		if ref := spec.customDetail.GetOciRef(); ref != nil {
+			src.Spec.Reference = &sourcev1beta2.OCIRepositoryRef{
+				Tag:    ref.GetTag(),
+				SemVer: ref.GetSemver(),
+				Digest: ref.GetDigest(),
+			}
+		}
+		src.Spec.LayerSelector = &sourcev1beta2.OCILayerSelector{
+			MediaType: helmChartContentMediaType,
+			Operation: sourcev1beta2.OCILayerCopy,
+		}
+	default:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unexpected repository of type: %T", src))
+	}
+	return nil
+}
+
+func (s *Server) getChartSourceAuth(ctx context.Context, headers http.Header, src chartSource) (*corev1.PackageRepositoryAuth, error) {
+	secretRef := chartSourceSecretRef(src)
+	if secretRef == nil {
+		return nil, nil
+	}
+	typedClient, err := s.clientGetter.Typed(headers, s.kubeappsCluster)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := typedClient.CoreV1().Secrets(src.GetNamespace()).Get(ctx, secretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, connecterror.FromK8sError("get", "secret", secretRef.Name, err)
+	}
+	auth := &corev1.PackageRepositoryAuth{
+		PackageRepoAuthOneOf: &corev1.PackageRepositoryAuth_SecretRef{
+			SecretRef: &corev1.SecretKeyReference{Name: secret.Name},
+		},
+	}
+	switch {
+	case secret.Data["identity"] != nil:
+		auth.Type = corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_SSH
+	case secret.Data["username"] != nil:
+		auth.Type = corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_BASIC_AUTH
+	case secret.Data["accesskey"] != nil:
+		auth.Type = corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_OPAQUE
+	case secret.Data[apiv1.DockerConfigJsonKey] != nil:
+		auth.Type = corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_DOCKER_CONFIG_JSON
+	}
+	return auth, nil
+}
+
+//
+// chart source utilities
+//
+
+func chartSourceLists() []ctrlclient.ObjectList {
+	return []ctrlclient.ObjectList{&sourcev1.GitRepositoryList{}, &sourcev1beta2.BucketList{}, &sourcev1beta2.OCIRepositoryList{}}
+}
+
+func chartSourceListKind(list ctrlclient.ObjectList) string {
+	switch list.(type) {
+	case *sourcev1.GitRepositoryList:
+		return sourcev1.GitRepositoryKind
+	case *sourcev1beta2.OCIRepositoryList:
+		return sourcev1beta2.OCIRepositoryKind
+	}
+	return sourcev1beta2.BucketKind
+}
+
+func chartSourceItems(list ctrlclient.ObjectList) []chartSource {
+	items := []chartSource{}
+	switch list := list.(type) {
+	case *sourcev1.GitRepositoryList:
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+	case *sourcev1beta2.BucketList:
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+	case *sourcev1beta2.OCIRepositoryList:
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+	}
+	return items
+}
+
+func chartSourceKind(src chartSource) string {
+	switch src.(type) {
+	case *sourcev1.GitRepository:
+		return sourcev1.GitRepositoryKind
+	case *sourcev1beta2.OCIRepository:
+		return sourcev1beta2.OCIRepositoryKind
+	}
+	return sourcev1beta2.BucketKind
+}
+
+func chartSourceRepoType(src chartSource) string {
+	switch src.(type) {
+	case *sourcev1.GitRepository:
+		return gitRepositoryType
+	case *sourcev1beta2.OCIRepository:
+		return ociRepositoryType
+	}
+	return bucketType
+}
+
+func chartSourceGvr(src chartSource) schema.GroupVersionResource {
+	switch src.(type) {
+	case *sourcev1.GitRepository:
+		return common.GetGitRepositoriesGvr()
+	case *sourcev1beta2.OCIRepository:
+		return common.GetOCIRepositoriesGvr()
+	}
+	return common.GetBucketsGvr()
+}
+
+// the key of a chart source in the index, with the same segments as the keys of the repo cache
+func chartSourceIndexKey(src chartSource) string {
+	resource := fluxBuckets
+	switch src.(type) {
+	case *sourcev1.GitRepository:
+		resource = fluxGitRepositories
+	case *sourcev1beta2.OCIRepository:
+		resource = fluxOCIRepositories
+	}
+	return strings.Join([]string{resource, src.GetNamespace(), src.GetName()}, cache.KeySegmentsSeparator)
+}
+
+func chartSourceURL(src chartSource) string {
+	switch src := src.(type) {
+	case *sourcev1.GitRepository:
+		return src.Spec.URL
+	case *sourcev1beta2.Bucket:
+		if src.Spec.Insecure {
+			return "http://" + src.Spec.Endpoint
+		}
+		return "https://" + src.Spec.Endpoint
+	case *sourcev1beta2.OCIRepository:
+		return src.Spec.URL
+	}
+	return ""
+}
+
+func chartSourceInterval(src chartSource) metav1.Duration {
+	switch src := src.(type) {
+	case *sourcev1.GitRepository:
+		return src.Spec.Interval
+	case *sourcev1beta2.Bucket:
+		return src.Spec.Interval
+	case *sourcev1beta2.OCIRepository:
+		return src.Spec.Interval
+	}
+	return metav1.Duration{}
+}
+
+func chartSourceSecretRef(src chartSource) *fluxmeta.LocalObjectReference {
+	switch src := src.(type) {
+	case *sourcev1.GitRepository:
+		return src.Spec.SecretRef
+	case *sourcev1beta2.Bucket:
+		return src.Spec.SecretRef
+	case *sourcev1beta2.OCIRepository:
+		return src.Spec.SecretRef
+	}
+	return nil
+}
+
+func chartSourceObservedGeneration(src chartSource) int64 {
+	switch src := src.(type) {
+	case *sourcev1.GitRepository:
+		return src.Status.ObservedGeneration
+	case *sourcev1beta2.Bucket:
+		return src.Status.ObservedGeneration
+	case *sourcev1beta2.OCIRepository:
+		return src.Status.ObservedGeneration
+	}
+	return 0
+}
+
+func chartSourceCustomDetail(src chartSource) *v1alpha1.FluxPackageRepositoryCustomDetail {
+	switch src := src.(type) {
+	case *sourcev1.GitRepository:
+		if ref := src.Spec.Reference; ref != nil {
+			return &v1alpha1.FluxPackageRepositoryCustomDetail{
+				GitRef: &v1alpha1.FluxGitRepositoryRef{
+					Branch: ref.Branch,
+					Tag:    ref.Tag,
+					Semver: ref.SemVer,
+					Commit: ref.Commit,
+				},
+			}
+		}
+	case *sourcev1beta2.Bucket:
+		return &v1alpha1.FluxPackageRepositoryCustomDetail{
+			Provider:     src.Spec.Provider,
+			BucketName:   src.Spec.BucketName,
+			BucketRegion: src.Spec.Region,
+		}
+	case *sourcev1beta2.OCIRepository:
+		detail := &v1alpha1.FluxPackageRepositoryCustomDetail{Provider: src.Spec.Provider}
+		if ref := src.Spec.Reference; ref != nil {
+			detail.OciRef = &v1alpha1.FluxOCIRepositoryRef{
+				Tag:    ref.Tag,
+				Semver: ref.SemVer,
+				Digest: ref.Digest,
+			}
+		}
+		return detail
+	}
+	return nil
+}
+
+func isChartSourceReady(src chartSource) bool {
+	complete, success, _ := isChartSourceReadyWithReason(src)
+	return complete && success
+}
+
+func isChartSourceReadyWithReason(src chartSource) (complete bool, success bool, reason string) {
+	generation := src.GetGeneration()
+	return isSourceReady(src.GetConditions(), generation > 0 && generation == chartSourceObservedGeneration(src))
+}
+
+func chartSourceStatus(src chartSource) *corev1.PackageRepositoryStatus {
+	complete, success, reason := isChartSourceReadyWithReason(src)
+	return sourceStatus(complete, success, reason)
+}
+
+func matchesRepoFilter(name string, match []string) bool {
+	if len(match) == 0 {
+		return true
+	}
+	for _, m := range match {
+		if matched, err := regexp.MatchString(m, name); matched && err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotFoundOrNoMatch(err error) bool {
+	return ctrlclient.IgnoreNotFound(err) == nil || meta.IsNoMatchError(err)
+}
@@ -0,0 +1,577 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
+	"google.golang.org/protobuf/types/known/anypb"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newTestSourceArtifact(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		} else if _, err = tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	} else if err = gzw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func newReadyGitRepository(name, namespace, artifactUrl string) *sourcev1.GitRepository {
+	return &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			Generation: 1,
+		},
+		Spec: sourcev1.GitRepositorySpec{
+			URL:       "https://github.com/stefanprodan/podinfo",
+			Interval:  defaultPollInterval,
+			Reference: &sourcev1.GitRepositoryRef{Branch: "master"},
+		},
+		Status: sourcev1.GitRepositoryStatus{
+			ObservedGeneration: 1,
+			Artifact: &sourcev1.Artifact{
+				URL:    artifactUrl,
+				Digest: "sha256:0123",
+			},
+			Conditions: []metav1.Condition{
+				{
+					Type:   fluxmeta.ReadyCondition,
+					Status: metav1.ConditionTrue,
+					Reason: fluxmeta.SucceededReason,
+				},
+			},
+		},
+	}
+}
+
+var testSourceArtifactFiles = map[string]string{
+	"README.md":                                    "# podinfo",
+	"charts/podinfo/Chart.yaml":                    "apiVersion: v2\nname: podinfo\nversion: 6.5.0\nappVersion: 6.5.0\ndescription: Podinfo Helm chart\n",
+	"charts/podinfo/values.yaml":                   "replicaCount: 1\n",
+	"charts/podinfo/charts/redis/Chart.yaml":       "apiVersion: v2\nname: redis\nversion: 1.0.0\n",
+	"charts/podinfo/charts/redis/values.yaml":      "architecture: standalone\n",
+	"charts/podinfo/templates/deployment.yaml":     "kind: Deployment\n",
+	"deploy/broken/Chart.yaml":                     "apiVersion: v2\n",
+	"deploy/other/nginx/Chart.yaml":                "apiVersion: v2\nname: my-nginx\nversion: 0.1.0\n",
+	"deploy/other/nginx/templates/deployment.yaml": "kind: Deployment\n",
+}
+
+func TestChartsFromSourceArtifact(t *testing.T) {
+	src := newReadyGitRepository("podinfo", "default", "http://source-controller/podinfo.tar.gz")
+	charts, tarballs, err := chartsFromSourceArtifact(src, newTestSourceArtifact(t, testSourceArtifactFiles))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type chartSummary struct {
+		ID, Name, RepoType, Version, Path string
+	}
+	got := []chartSummary{}
+	for _, chart := range charts {
+		got = append(got, chartSummary{chart.ID, chart.Name, chart.Repo.Type, chart.ChartVersions[0].Version, chart.ChartVersions[0].URLs[0]})
+	}
+	// the nested redis chart and the broken chart are skipped
+	want := []chartSummary{
+		{"podinfo/nginx", "nginx", gitRepositoryType, "0.1.0", "./deploy/other/nginx"},
+		{"podinfo/podinfo", "podinfo", gitRepositoryType, "6.5.0", "./charts/podinfo"},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	detail, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(tarballs["podinfo"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := detail["values"], "replicaCount: 1\n"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := detail["chartYaml"], testSourceArtifactFiles["charts/podinfo/Chart.yaml"]; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestChartsFromSourceArtifactAtRoot(t *testing.T) {
+	src := newReadyGitRepository("my-chart", "default", "http://source-controller/my-chart.tar.gz")
+	charts, _, err := chartsFromSourceArtifact(src, newTestSourceArtifact(t, map[string]string{
+		"Chart.yaml":              "apiVersion: v2\nname: foo\nversion: 1.0.0\n",
+		"charts/redis/Chart.yaml": "apiVersion: v2\nname: redis\nversion: 1.0.0\n",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(charts) != 1 {
+		t.Fatalf("got: %d charts, want: 1", len(charts))
+	}
+	if got, want := charts[0].Name, "my-chart"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := charts[0].ChartVersions[0].URLs[0], "./"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestChartsFromOCIRepositoryArtifact(t *testing.T) {
+	src := &sourcev1beta2.OCIRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-podinfo", Namespace: "default"},
+		Spec:       sourcev1beta2.OCIRepositorySpec{URL: "oci://ghcr.io/stefanprodan/charts/podinfo"},
+	}
+	// the artifact is the chart tarball copied from the registry
+	charts, _, err := chartsFromSourceArtifact(src, newTestSourceArtifact(t, map[string]string{
+		"podinfo/Chart.yaml":  testSourceArtifactFiles["charts/podinfo/Chart.yaml"],
+		"podinfo/values.yaml": "replicaCount: 1\n",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(charts) != 1 {
+		t.Fatalf("got: %d charts, want: 1", len(charts))
+	}
+	if got, want := charts[0].ID, "my-podinfo/podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := charts[0].Repo.Type, ociRepositoryType; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := charts[0].Repo.URL, "oci://ghcr.io/stefanprodan/charts/podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestFilesFromTarballSizeLimit(t *testing.T) {
+	// highly compressible content, as found in a decompression bomb
+	artifact := newTestSourceArtifact(t, map[string]string{
+		"a.yaml": strings.Repeat("a", 600),
+		"b.yaml": strings.Repeat("b", 600),
+	})
+	if _, err := filesFromTarball(artifact, 1200); err != nil {
+		t.Fatalf("got: %v, want no error for files within the limit", err)
+	}
+	if _, err := filesFromTarball(artifact, 1000); err == nil {
+		t.Errorf("got no error, want an error for files exceeding the limit")
+	}
+}
+
+func TestChartSourceIndexEviction(t *testing.T) {
+	newEntry := func(digest string, size int) chartSourceIndexEntry {
+		return chartSourceIndexEntry{digest: digest, tarballs: map[string][]byte{"chart": make([]byte, size)}}
+	}
+	index := newChartSourceIndex(100)
+	index.set("gitrepositories:default:a", newEntry("1", 40))
+	index.set("gitrepositories:default:b", newEntry("1", 40))
+	// a is now the most recently used entry
+	if _, ok := index.get("gitrepositories:default:a", "1"); !ok {
+		t.Fatalf("got no entry for a")
+	}
+	index.set("gitrepositories:default:c", newEntry("1", 40))
+
+	for key, want := range map[string]bool{
+		"gitrepositories:default:a": true,
+		"gitrepositories:default:b": false,
+		"gitrepositories:default:c": true,
+	} {
+		if _, got := index.get(key, "1"); got != want {
+			t.Errorf("%s: got: %t, want: %t", key, got, want)
+		}
+	}
+	if got, want := index.size, int64(80); got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+
+	// a new artifact replaces the entry, and one larger than the index is not kept
+	index.set("gitrepositories:default:a", newEntry("2", 200))
+	if _, ok := index.get("gitrepositories:default:a", "2"); ok {
+		t.Errorf("got an entry larger than the index")
+	}
+	if got, want := index.size, int64(40); got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
+
+func TestIndexChartSourceArtifactSizeLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(make([]byte, maxChartSourceArtifactSize+1))
+	}))
+	defer ts.Close()
+
+	s := &Server{chartSourceIndex: newChartSourceIndex(maxChartSourceIndexSize)}
+	_, err := s.indexChartSource(newReadyGitRepository("podinfo", "default", ts.URL))
+	if got, want := connect.CodeOf(err), connect.CodeInternal; got != want {
+		t.Errorf("got: %v, want: %v, err: %v", got, want, err)
+	}
+}
+
+func TestAddChartSourcePackageRepository(t *testing.T) {
+	gitRef, err := anypb.New(&v1alpha1.FluxPackageRepositoryCustomDetail{
+		GitRef: &v1alpha1.FluxGitRepositoryRef{Tag: "6.5.0"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bucketDetail, err := anypb.New(&v1alpha1.FluxPackageRepositoryCustomDetail{
+		BucketName:   "charts",
+		BucketRegion: "us-east-1",
+		Provider:     sourcev1beta2.AmazonBucketProvider,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ociDetail, err := anypb.New(&v1alpha1.FluxPackageRepositoryCustomDetail{
+		OciRef:   &v1alpha1.FluxOCIRepositoryRef{Semver: "6.5.x"},
+		Provider: sourcev1beta2.GenericOCIProvider,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretRef := func(authType corev1.PackageRepositoryAuth_PackageRepositoryAuthType) *corev1.PackageRepositoryAuth {
+		return &corev1.PackageRepositoryAuth{
+			Type: authType,
+			PackageRepoAuthOneOf: &corev1.PackageRepositoryAuth_SecretRef{
+				SecretRef: &corev1.SecretKeyReference{Name: "secret-1"},
+			},
+		}
+	}
+	newRequest := func(typ, url string, customDetail *anypb.Any, auth *corev1.PackageRepositoryAuth) *corev1.AddPackageRepositoryRequest {
+		return &corev1.AddPackageRepositoryRequest{
+			Name:            "bar",
+			Context:         &corev1.Context{Namespace: "foo"},
+			Type:            typ,
+			Url:             url,
+			NamespaceScoped: true,
+			CustomDetail:    customDetail,
+			Auth:            auth,
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		request        *corev1.AddPackageRepositoryRequest
+		existingSecret *apiv1.Secret
+		expectedObject chartSource
+		errorCode      connect.Code
+	}{
+		{
+			name:    "git repository with the default branch",
+			request: newRequest(gitRepositoryType, "https://github.com/stefanprodan/podinfo", nil, nil),
+			expectedObject: &sourcev1.GitRepository{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo", ResourceVersion: "1"},
+				Spec: sourcev1.GitRepositorySpec{
+					URL:       "https://github.com/stefanprodan/podinfo",
+					Interval:  defaultPollInterval,
+					Reference: &sourcev1.GitRepositoryRef{Branch: defaultGitBranch},
+				},
+			},
+		},
+		{
+			name:           "git repository with a tag and basic auth",
+			request:        newRequest(gitRepositoryType, "https://github.com/stefanprodan/podinfo", gitRef, secretRef(corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_BASIC_AUTH)),
+			existingSecret: newBasicAuthSecret(types.NamespacedName{Name: "secret-1", Namespace: "foo"}, "baz", "zot"),
+			expectedObject: &sourcev1.GitRepository{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo", ResourceVersion: "1"},
+				Spec: sourcev1.GitRepositorySpec{
+					URL:       "https://github.com/stefanprodan/podinfo",
+					Interval:  defaultPollInterval,
+					Reference: &sourcev1.GitRepositoryRef{Tag: "6.5.0"},
+					SecretRef: &fluxmeta.LocalObjectReference{Name: "secret-1"},
+				},
+			},
+		},
+		{
+			name:    "bucket with an insecure endpoint",
+			request: newRequest(bucketType, "http://minio.minio:9000", bucketDetail, nil),
+			expectedObject: &sourcev1beta2.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo", ResourceVersion: "1"},
+				Spec: sourcev1beta2.BucketSpec{
+					Provider:   sourcev1beta2.AmazonBucketProvider,
+					BucketName: "charts",
+					Endpoint:   "minio.minio:9000",
+					Insecure:   true,
+					Region:     "us-east-1",
+					Interval:   defaultPollInterval,
+				},
+			},
+		},
+		{
+			name:           "oci repository with a semver range and docker config secret",
+			request:        newRequest(ociRepositoryType, "oci://ghcr.io/stefanprodan/charts/podinfo", ociDetail, secretRef(corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_DOCKER_CONFIG_JSON)),
+			existingSecret: newDockerConfigJsonSecret(types.NamespacedName{Name: "secret-1", Namespace: "foo"}, "ghcr.io", "baz", "zot"),
+			expectedObject: &sourcev1beta2.OCIRepository{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "foo", ResourceVersion: "1"},
+				Spec: sourcev1beta2.OCIRepositorySpec{
+					URL:       "oci://ghcr.io/stefanprodan/charts/podinfo",
+					Reference: &sourcev1beta2.OCIRepositoryRef{SemVer: "6.5.x"},
+					LayerSelector: &sourcev1beta2.OCILayerSelector{
+						MediaType: helmChartContentMediaType,
+						Operation: sourcev1beta2.OCILayerCopy,
+					},
+					Provider:  sourcev1beta2.GenericOCIProvider,
+					SecretRef: &fluxmeta.LocalObjectReference{Name: "secret-1"},
+					Interval:  defaultPollInterval,
+				},
+			},
+		},
+		{
+			name:      "errors for a bucket without name",
+			request:   newRequest(bucketType, "https://s3.amazonaws.com", nil, nil),
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "errors for a git repository with bucket settings",
+			request:   newRequest(gitRepositoryType, "https://github.com/stefanprodan/podinfo", bucketDetail, nil),
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "errors for a git repository with kubeapps managed secrets",
+			request: newRequest(gitRepositoryType, "https://github.com/stefanprodan/podinfo", nil, &corev1.PackageRepositoryAuth{
+				Type: corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_BASIC_AUTH,
+				PackageRepoAuthOneOf: &corev1.PackageRepositoryAuth_UsernamePassword{
+					UsernamePassword: &corev1.UsernamePassword{Username: "baz", Password: "zot"},
+				},
+			}),
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:           "errors for a bucket with a basic auth secret",
+			request:        newRequest(bucketType, "https://s3.amazonaws.com", bucketDetail, secretRef(corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_BASIC_AUTH)),
+			existingSecret: newBasicAuthSecret(types.NamespacedName{Name: "secret-1", Namespace: "foo"}, "baz", "zot"),
+			errorCode:      connect.CodeInvalidArgument,
+		},
+		{
+			name:      "errors for a missing secret",
+			request:   newRequest(gitRepositoryType, "https://github.com/stefanprodan/podinfo", nil, secretRef(corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_SSH)),
+			errorCode: connect.CodeNotFound,
+		},
+		{
+			name:      "errors for an oci repository without the oci scheme",
+			request:   newRequest(ociRepositoryType, "https://ghcr.io/stefanprodan/charts/podinfo", nil, nil),
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "errors for an oci repository with bucket settings",
+			request:   newRequest(ociRepositoryType, "oci://ghcr.io/stefanprodan/charts/podinfo", bucketDetail, nil),
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "errors for a git repository with an oci reference",
+			request:   newRequest(gitRepositoryType, "https://github.com/stefanprodan/podinfo", ociDetail, nil),
+			errorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secrets := []runtime.Object{}
+			if tc.existingSecret != nil {
+				secrets = append(secrets, tc.existingSecret)
+			}
+			s, _, err := newServerWithRepos(t, nil, nil, secrets)
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+
+			ctx := context.Background()
+			response, err := s.AddPackageRepository(ctx, connect.NewRequest(tc.request))
+			if got, want := connect.CodeOf(err), tc.errorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			} else if err == nil && tc.errorCode != 0 {
+				t.Fatalf("got: nil, want: error with code %+v", tc.errorCode)
+			}
+			if tc.errorCode != 0 {
+				return
+			}
+			if got, want := response.Msg.GetPackageRepoRef().GetIdentifier(), "bar"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			src, err := s.getChartSourceInCluster(ctx, http.Header{}, types.NamespacedName{Name: "bar", Namespace: "foo"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := src, tc.expectedObject; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackagesFromChartSource(t *testing.T) {
+	artifact := newTestSourceArtifact(t, testSourceArtifactFiles)
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write(artifact)
+	}))
+	defer ts.Close()
+
+	s, mock, err := newServerWithRepos(t, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+	ctx := context.Background()
+	ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ctrlClient.Create(ctx, newReadyGitRepository("podinfo", "default", ts.URL)); err != nil {
+		t.Fatal(err)
+	}
+
+	summaries, err := s.GetAvailablePackageSummaries(ctx, connect.NewRequest(&corev1.GetAvailablePackageSummariesRequest{
+		Context: &corev1.Context{Namespace: "default"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, summary := range summaries.Msg.AvailablePackageSummaries {
+		got = append(got, summary.GetAvailablePackageRef().GetIdentifier())
+	}
+	if want := []string{"podinfo/nginx", "podinfo/podinfo"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// the key of the HelmRepository with the same name is looked up first
+	key, err := redisKeyForRepoNamespacedName(types.NamespacedName{Name: "podinfo", Namespace: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectGet(key).RedisNil()
+
+	detail, err := s.GetAvailablePackageDetail(ctx, connect.NewRequest(&corev1.GetAvailablePackageDetailRequest{
+		AvailablePackageRef: availableRef("podinfo/podinfo", "default"),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := detail.Msg.AvailablePackageDetail.GetVersion().GetPkgVersion(), "6.5.0"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := detail.Msg.AvailablePackageDetail.GetRepoUrl(), "https://github.com/stefanprodan/podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := detail.Msg.AvailablePackageDetail.GetDefaultValues(), "replicaCount: 1\n"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	// the artifact is only indexed once for the same digest
+	if got, want := requests, 1; got != want {
+		t.Errorf("got: %d artifact requests, want: %d", got, want)
+	}
+}
+
+func TestNewFluxHelmReleaseFromChartSource(t *testing.T) {
+	s := &Server{pluginConfig: common.NewDefaultPluginConfig()}
+	chart := &models.Chart{
+		ID:   "podinfo/podinfo",
+		Name: "podinfo",
+		Repo: &models.AppRepository{Name: "podinfo", Namespace: "default", Type: bucketType},
+		ChartVersions: []models.ChartVersion{
+			{Version: "6.5.0", URLs: []string{"./charts/podinfo"}},
+		},
+	}
+	rel, err := s.newFluxHelmRelease(chart, types.NamespacedName{Name: "my-podinfo", Namespace: "test"}, ">=6.0.0", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rel.Spec.Chart.Spec.Chart, "./charts/podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := rel.Spec.Chart.Spec.SourceRef.Kind, sourcev1beta2.BucketKind; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got := rel.Spec.Chart.Spec.Version; got != "" {
+		t.Errorf("got: %q, want no version", got)
+	}
+
+	ref, err := installedPackageAvailablePackageRef(rel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ref.GetIdentifier(), "podinfo/podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestNewFluxHelmReleaseFromOCIRepository(t *testing.T) {
+	s := &Server{pluginConfig: common.NewDefaultPluginConfig()}
+	chart := &models.Chart{
+		ID:   "my-podinfo/podinfo",
+		Name: "podinfo",
+		Repo: &models.AppRepository{Name: "my-podinfo", Namespace: "default", Type: ociRepositoryType},
+		ChartVersions: []models.ChartVersion{
+			{Version: "6.5.0", URLs: []string{"./podinfo"}},
+		},
+	}
+	rel, err := s.newFluxHelmRelease(chart, types.NamespacedName{Name: "my-podinfo", Namespace: "test"}, ">=6.0.0", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel.Spec.Chart != nil {
+		t.Errorf("got: %+v, want no chart template", rel.Spec.Chart)
+	}
+	want := &helmv2.CrossNamespaceSourceReference{
+		Kind:      sourcev1beta2.OCIRepositoryKind,
+		Name:      "my-podinfo",
+		Namespace: "default",
+	}
+	if got := rel.Spec.ChartRef; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if got := releaseChartVersion(rel); got != "" {
+		t.Errorf("got: %q, want no version", got)
+	}
+
+	// the name of the chart is only known once installed
+	ref, err := installedPackageAvailablePackageRef(rel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ref.GetIdentifier(), "my-podinfo/my-podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	rel.Status.History = helmv2.Snapshots{{ChartName: "podinfo", ChartVersion: "6.5.0"}}
+	if ref, err = installedPackageAvailablePackageRef(rel); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ref.GetIdentifier(), "my-podinfo/podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := ref.GetContext().GetNamespace(), "default"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
@@ -19,7 +19,9 @@ import (
 
 	k8stesting "k8s.io/client-go/testing"
 
-	helmv2beta2 "github.com/fluxcd/helm-controller/api/v2beta2"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -353,19 +355,27 @@ func repoRef(id, namespace string) *corev1.PackageRepositoryReference {
 	}
 }
 
-func newCtrlClient(repos []sourcev1beta2.HelmRepository, charts []sourcev1beta2.HelmChart, releases []helmv2beta2.HelmRelease) withWatchWrapper {
+func newCtrlClient(repos []sourcev1beta2.HelmRepository, charts []sourcev1beta2.HelmChart, releases []helmv2.HelmRelease) withWatchWrapper {
 	// register the flux GitOps Toolkit schema definitions
 	scheme := runtime.NewScheme()
 	err := sourcev1beta2.AddToScheme(scheme)
 	if err != nil {
 		log.Fatal(err)
 	}
-	err = helmv2beta2.AddToScheme(scheme)
+	err = sourcev1.AddToScheme(scheme)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = helmv2.AddToScheme(scheme)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = kustomizev1.AddToScheme(scheme)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	rm := apimeta.NewDefaultRESTMapper([]schema.GroupVersion{sourcev1beta2.GroupVersion, helmv2beta2.GroupVersion})
+	rm := apimeta.NewDefaultRESTMapper([]schema.GroupVersion{sourcev1beta2.GroupVersion, sourcev1.GroupVersion, helmv2.GroupVersion, kustomizev1.GroupVersion})
 	rm.Add(schema.GroupVersionKind{
 		Group:   sourcev1beta2.GroupVersion.Group,
 		Version: sourcev1beta2.GroupVersion.Version,
@@ -377,9 +387,29 @@ func newCtrlClient(repos []sourcev1beta2.HelmRepository, charts []sourcev1beta2.
 		Kind:    sourcev1beta2.HelmChartKind},
 		apimeta.RESTScopeNamespace)
 	rm.Add(schema.GroupVersionKind{
-		Group:   helmv2beta2.GroupVersion.Group,
-		Version: helmv2beta2.GroupVersion.Version,
-		Kind:    helmv2beta2.HelmReleaseKind},
+		Group:   sourcev1beta2.GroupVersion.Group,
+		Version: sourcev1beta2.GroupVersion.Version,
+		Kind:    sourcev1beta2.BucketKind},
+		apimeta.RESTScopeNamespace)
+	rm.Add(schema.GroupVersionKind{
+		Group:   sourcev1beta2.GroupVersion.Group,
+		Version: sourcev1beta2.GroupVersion.Version,
+		Kind:    sourcev1beta2.OCIRepositoryKind},
+		apimeta.RESTScopeNamespace)
+	rm.Add(schema.GroupVersionKind{
+		Group:   sourcev1.GroupVersion.Group,
+		Version: sourcev1.GroupVersion.Version,
+		Kind:    sourcev1.GitRepositoryKind},
+		apimeta.RESTScopeNamespace)
+	rm.Add(schema.GroupVersionKind{
+		Group:   helmv2.GroupVersion.Group,
+		Version: helmv2.GroupVersion.Version,
+		Kind:    helmv2.HelmReleaseKind},
+		apimeta.RESTScopeNamespace)
+	rm.Add(schema.GroupVersionKind{
+		Group:   kustomizev1.GroupVersion.Group,
+		Version: kustomizev1.GroupVersion.Version,
+		Kind:    kustomizev1.KustomizationKind},
 		apimeta.RESTScopeNamespace)
 
 	ctrlClientBuilder := ctrlfake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(rm)
@@ -391,7 +421,7 @@ func newCtrlClient(repos []sourcev1beta2.HelmRepository, charts []sourcev1beta2.
 		initLists = append(initLists, &sourcev1beta2.HelmChartList{Items: charts})
 	}
 	if len(releases) > 0 {
-		initLists = append(initLists, &helmv2beta2.HelmReleaseList{Items: releases})
+		initLists = append(initLists, &helmv2.HelmReleaseList{Items: releases})
 	}
 	if len(initLists) > 0 {
 		ctrlClientBuilder = ctrlClientBuilder.WithLists(initLists...)
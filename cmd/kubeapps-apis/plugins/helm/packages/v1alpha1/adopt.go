@@ -0,0 +1,250 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bufbuild/connect-go"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// Helm does not store a back-reference to the chart used to create a
+	// release (https://github.com/helm/helm/issues/6464), so the available
+	// package of an adopted release is stored as annotations of the secret
+	// storing the release revision, which is also labeled so that the adopted
+	// releases of a namespace can be listed at once.
+	adoptedReleaseLabel                    = "kubeapps.dev/adopted"
+	adoptedPackageIdentifierAnnotation     = "kubeapps.dev/available-package-identifier"
+	adoptedPackageRepoNamespaceAnnotation  = "kubeapps.dev/available-package-namespace"
+	adoptedPackageRegistrySecretAnnotation = "kubeapps.dev/available-package-registry-secret"
+)
+
+// releaseAdoption is the available package associated with a release.
+type releaseAdoption struct {
+	availablePackageRef *corev1.AvailablePackageReference
+	// registrySecret is the docker-config secret, in the namespace of the
+	// available package, with the credentials of the registry of a package
+	// installed from an OCI reference, if any.
+	registrySecret string
+}
+
+// AdoptInstalledPackage associates a release with an available package, so
+// that it can be updated even when no chart matches the release.
+func (s *Server) AdoptInstalledPackage(ctx context.Context, request *connect.Request[helmv1.AdoptInstalledPackageRequest]) (*connect.Response[helmv1.AdoptInstalledPackageResponse], error) {
+	installedRef := request.Msg.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	log.InfoS("+helm AdoptInstalledPackage", "cluster", installedRef.GetContext().GetCluster(), "namespace", installedRef.GetContext().GetNamespace(), "id", releaseName)
+
+	availablePkgRef := request.Msg.GetAvailablePackageRef()
+	repoNamespace := availablePkgRef.GetContext().GetNamespace()
+	if repoNamespace == "" || availablePkgRef.GetIdentifier() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Required context or identifier not provided in the AvailablePackageRef"))
+	}
+	chartID, err := pkgutils.GetUnescapedPackageID(availablePkgRef.GetIdentifier())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// The chart and the credentials of the repository are used by the later
+	// updates of the release, so the user must have access to its namespace.
+	if err := s.hasAccessToNamespace(request.Header(), s.globalPackagingCluster, repoNamespace); err != nil {
+		return nil, err
+	}
+
+	// The available package must be known for its versions to be used.
+	if _, err := s.manager.GetChart(repoNamespace, chartID); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find the available package %q in the namespace %q: %w", chartID, repoNamespace, err))
+	}
+
+	actionConfig, err := s.actionConfigGetter(request.Header(), installedRef.GetContext())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
+	}
+	release, err := agent.GetRelease(actionConfig, releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find Helm release %q in namespace %q: %w", releaseName, installedRef.GetContext().GetNamespace(), err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to run Helm get action: %w", err))
+	}
+
+	cluster := installedRef.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	adoption := &releaseAdoption{
+		availablePackageRef: &corev1.AvailablePackageReference{
+			Context: &corev1.Context{
+				Cluster:   s.globalPackagingCluster,
+				Namespace: repoNamespace,
+			},
+			Identifier: chartID,
+			Plugin:     GetPluginDetail(),
+		},
+	}
+	if err := s.annotateReleaseWithAvailablePackageRef(ctx, request.Header(), cluster, release.Namespace, release.Name, release.Version, adoption); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&helmv1.AdoptInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: release.Namespace,
+			},
+			Identifier: release.Name,
+			Plugin:     GetPluginDetail(),
+		},
+	}), nil
+}
+
+// releaseStorageName returns the name of the secret or configmap in which Helm
+// stores a revision of a release.
+func releaseStorageName(releaseName string, revision int) string {
+	return fmt.Sprintf("%s.%s.v%d", storage.HelmStorageType, releaseName, revision)
+}
+
+// adoptedReleaseKey returns the key of a release in the map of adopted releases.
+func adoptedReleaseKey(namespace, releaseName string) string {
+	return namespace + "/" + releaseName
+}
+
+// annotateReleaseWithAvailablePackageRef stores the available package of a
+// release in the secret or configmap of the given release revision.
+func (s *Server) annotateReleaseWithAvailablePackageRef(ctx context.Context, headers http.Header, cluster, namespace, releaseName string, revision int, adoption *releaseAdoption) error {
+	annotations := map[string]string{
+		adoptedPackageIdentifierAnnotation:    adoption.availablePackageRef.GetIdentifier(),
+		adoptedPackageRepoNamespaceAnnotation: adoption.availablePackageRef.GetContext().GetNamespace(),
+	}
+	if adoption.registrySecret != "" {
+		annotations[adoptedPackageRegistrySecretAnnotation] = adoption.registrySecret
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{
+				adoptedReleaseLabel: "true",
+			},
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to marshal the release annotations: %w", err))
+	}
+
+	storageName := releaseStorageName(releaseName, revision)
+	if err := s.patchReleaseStorageObject(ctx, headers, cluster, namespace, storageName, patch); err != nil {
+		return connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to annotate the release storage %q in the namespace %q: %w", storageName, namespace, err))
+	}
+	return nil
+}
+
+// keepReleaseAdoption annotates a new revision of an adopted release with its
+// available package. As the new revision is already deployed, a failure is
+// only logged.
+func (s *Server) keepReleaseAdoption(ctx context.Context, headers http.Header, cluster, namespace, releaseName string, revision int, adoption *releaseAdoption) {
+	if err := s.annotateReleaseWithAvailablePackageRef(ctx, headers, cluster, namespace, releaseName, revision, adoption); err != nil {
+		log.Errorf("+helm unable to keep the adoption of the release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+}
+
+// getAdoptedAvailablePackageRefs returns the available package of each
+// adopted release in the namespace, or in all namespaces if none, keyed by
+// adoptedReleaseKey.
+func (s *Server) getAdoptedAvailablePackageRefs(ctx context.Context, headers http.Header, cluster, namespace string) (map[string]*corev1.AvailablePackageReference, error) {
+	adoptions, err := s.getReleaseAdoptions(ctx, headers, cluster, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	adoptedRefs := map[string]*corev1.AvailablePackageReference{}
+	for key, adoption := range adoptions {
+		adoptedRefs[key] = adoption.availablePackageRef
+	}
+	return adoptedRefs, nil
+}
+
+// getReleaseAdoptions returns the adoption of each adopted release in the
+// namespace, or in all namespaces if none, keyed by adoptedReleaseKey. If a
+// release name is given, only that release is looked up.
+// As Helm does not keep the annotations when updating the secret of a previous
+// revision, the latest annotated revision of each release is used. Releases
+// stored by the SQL driver cannot be adopted.
+func (s *Server) getReleaseAdoptions(ctx context.Context, headers http.Header, cluster, namespace, releaseName string) (map[string]*releaseAdoption, error) {
+	adoptions := map[string]*releaseAdoption{}
+	if s.pluginConfig.StorageDriverForCluster(cluster) == agent.StorageDriverSQL {
+		return adoptions, nil
+	}
+
+	selector := fmt.Sprintf("%s,%s=true", helmReleaseStorageSelector, adoptedReleaseLabel)
+	if releaseName != "" {
+		selector = fmt.Sprintf("%s,name=%s", selector, releaseName)
+	}
+	objects, err := s.listReleaseStorageObjects(ctx, headers, cluster, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	adoptedRevisions := map[string]int{}
+	for _, object := range objects {
+		identifier := object.Annotations[adoptedPackageIdentifierAnnotation]
+		repoNamespace := object.Annotations[adoptedPackageRepoNamespaceAnnotation]
+		if identifier == "" || repoNamespace == "" {
+			continue
+		}
+		revision, err := strconv.Atoi(object.Labels["version"])
+		if err != nil {
+			log.Warningf("+helm ignoring the release storage %q with an invalid version: %v", object.Name, err)
+			continue
+		}
+		key := adoptedReleaseKey(object.Namespace, object.Labels["name"])
+		if previous, ok := adoptedRevisions[key]; ok && previous > revision {
+			continue
+		}
+		adoptedRevisions[key] = revision
+		adoptions[key] = &releaseAdoption{
+			availablePackageRef: &corev1.AvailablePackageReference{
+				Context: &corev1.Context{
+					Cluster:   s.globalPackagingCluster,
+					Namespace: repoNamespace,
+				},
+				Identifier: identifier,
+				Plugin:     GetPluginDetail(),
+			},
+			registrySecret: object.Annotations[adoptedPackageRegistrySecretAnnotation],
+		}
+	}
+	return adoptions, nil
+}
+
+// getReleaseAdoption returns the adoption of a release if it was adopted, or
+// nil otherwise.
+func (s *Server) getReleaseAdoption(ctx context.Context, headers http.Header, cluster, namespace, releaseName string) (*releaseAdoption, error) {
+	adoptions, err := s.getReleaseAdoptions(ctx, headers, cluster, namespace, releaseName)
+	if err != nil {
+		return nil, err
+	}
+	return adoptions[adoptedReleaseKey(namespace, releaseName)], nil
+}
+
+// getAdoptedAvailablePackageRef returns the available package of a release
+// if it was adopted, or nil otherwise.
+func (s *Server) getAdoptedAvailablePackageRef(ctx context.Context, headers http.Header, cluster, namespace, releaseName string) (*corev1.AvailablePackageReference, error) {
+	adoption, err := s.getReleaseAdoption(ctx, headers, cluster, namespace, releaseName)
+	if err != nil || adoption == nil {
+		return nil, err
+	}
+	return adoption.availablePackageRef, nil
+}
@@ -0,0 +1,386 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	"helm.sh/helm/v3/pkg/release"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newReleaseSecret returns the secret storing a revision of a release,
+// annotated with the given available package, if any.
+func newReleaseSecret(releaseName, namespace string, revision int, adoptedIdentifier, adoptedNamespace string) *apiv1.Secret {
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseStorageName(releaseName, revision),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"owner":   "helm",
+				"name":    releaseName,
+				"version": fmt.Sprintf("%d", revision),
+			},
+		},
+	}
+	if adoptedIdentifier != "" {
+		secret.Labels[adoptedReleaseLabel] = "true"
+		secret.Annotations = map[string]string{
+			adoptedPackageIdentifierAnnotation:    adoptedIdentifier,
+			adoptedPackageRepoNamespaceAnnotation: adoptedNamespace,
+		}
+	}
+	return secret
+}
+
+// expectGetChart sets the expectation of a chart being retrieved.
+func expectGetChart(t *testing.T, mock sqlmock.Sqlmock, chart *models.Chart) {
+	chartJSON, err := json.Marshal(chart)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	rows := sqlmock.NewRows([]string{"info"})
+	rows.AddRow(string(chartJSON))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM charts WHERE repo_namespace = $1 AND chart_id = $2")).
+		WithArgs(chart.Repo.Namespace, chart.ID).
+		WillReturnRows(rows)
+}
+
+func TestAdoptInstalledPackage(t *testing.T) {
+	adoptedChart := &models.Chart{
+		ID:   "bitnami/apache",
+		Name: "apache",
+		Repo: &models.AppRepository{
+			Namespace: globalPackagingNamespace,
+		},
+		ChartVersions: []models.ChartVersion{{Version: "1.18.4"}, {Version: "1.18.3"}},
+	}
+	existingReleases := []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.2",
+			status:       release.StatusSuperseded,
+			version:      1,
+		},
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      2,
+		},
+	}
+	installedPackageRef := &corev1.InstalledPackageReference{
+		Context: &corev1.Context{
+			Cluster:   globalPackagingCluster,
+			Namespace: "default",
+		},
+		Identifier: "my-apache",
+	}
+
+	testCases := []struct {
+		name               string
+		request            *helmv1.AdoptInstalledPackageRequest
+		existingChart      *models.Chart
+		expectedResponse   *helmv1.AdoptInstalledPackageResponse
+		expectedErrorCode  connect.Code
+		expectedAnnotation map[string]string
+	}{
+		{
+			name: "adopts a release, annotating the secret of its current revision",
+			request: &helmv1.AdoptInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "bitnami/apache",
+				},
+			},
+			existingChart: adoptedChart,
+			expectedResponse: &helmv1.AdoptInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   globalPackagingCluster,
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedAnnotation: map[string]string{
+				adoptedPackageIdentifierAnnotation:    "bitnami/apache",
+				adoptedPackageRepoNamespaceAnnotation: globalPackagingNamespace,
+			},
+		},
+		{
+			name: "returns invalid argument without the namespace of the available package",
+			request: &helmv1.AdoptInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "bitnami/apache",
+				},
+			},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "returns invalid argument for an identifier without a repository",
+			request: &helmv1.AdoptInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "apache",
+				},
+			},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "returns permission denied for an available package in an inaccessible namespace",
+			request: &helmv1.AdoptInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: "private-repos"},
+					Identifier: "bitnami/apache",
+				},
+			},
+			expectedErrorCode: connect.CodePermissionDenied,
+		},
+		{
+			name: "returns not found for an unknown available package",
+			request: &helmv1.AdoptInstalledPackageRequest{
+				InstalledPackageRef: installedPackageRef,
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "bitnami/apache",
+				},
+			},
+			expectedErrorCode: connect.CodeNotFound,
+		},
+		{
+			name: "returns not found for an unknown release",
+			request: &helmv1.AdoptInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    installedPackageRef.Context,
+					Identifier: "other-release",
+				},
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "bitnami/apache",
+				},
+			},
+			existingChart:     adoptedChart,
+			expectedErrorCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", existingReleases, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			clientSet := typfake.NewSimpleClientset(
+				newReleaseSecret("my-apache", "default", 1, "", ""),
+				newReleaseSecret("my-apache", "default", 2, "", ""),
+			)
+			clientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				return true, &authorizationv1.SelfSubjectAccessReview{
+					Status: authorizationv1.SubjectAccessReviewStatus{Allowed: review.Spec.ResourceAttributes.Namespace != "private-repos"},
+				}, nil
+			})
+			server.clientGetter = clientgetter.NewBuilder().WithTyped(clientSet).Build()
+
+			if tc.existingChart != nil {
+				expectGetChart(t, mock, tc.existingChart)
+			} else {
+				mock.ExpectQuery("SELECT info FROM charts").WillReturnError(sql.ErrNoRows)
+			}
+
+			response, err := server.AdoptInstalledPackage(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(helmv1.AdoptInstalledPackageResponse{}, corev1.InstalledPackageReference{}, corev1.Context{}, plugins.Plugin{})
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			secret, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), releaseStorageName("my-apache", 2), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := secret.Annotations, tc.expectedAnnotation; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := secret.Labels[adoptedReleaseLabel], "true"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageDetailAdopted(t *testing.T) {
+	existingReleases := []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      3,
+		},
+	}
+
+	testCases := []struct {
+		name                        string
+		existingSecrets             []k8sruntime.Object
+		expectedAvailablePackageRef *corev1.AvailablePackageReference
+		expectedLatestVersion       *corev1.PackageAppVersion
+	}{
+		{
+			name: "uses the available package of the latest annotated revision",
+			existingSecrets: []k8sruntime.Object{
+				newReleaseSecret("my-apache", "default", 1, "bitnami/other", globalPackagingNamespace),
+				newReleaseSecret("my-apache", "default", 2, "bitnami/apache", globalPackagingNamespace),
+				newReleaseSecret("my-apache", "default", 3, "", ""),
+				newReleaseSecret("other-release", "default", 4, "bitnami/other", globalPackagingNamespace),
+			},
+			expectedAvailablePackageRef: &corev1.AvailablePackageReference{
+				Context: &corev1.Context{
+					Cluster:   globalPackagingCluster,
+					Namespace: globalPackagingNamespace,
+				},
+				Identifier: "bitnami/apache",
+				Plugin:     GetPluginDetail(),
+			},
+			expectedLatestVersion: &corev1.PackageAppVersion{
+				PkgVersion: "1.18.4",
+				AppVersion: DefaultAppVersion,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", existingReleases, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			server.clientGetter = clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset(tc.existingSecrets...)).Build()
+
+			expectGetChart(t, mock, &models.Chart{
+				ID:   "bitnami/apache",
+				Name: "apache",
+				Repo: &models.AppRepository{
+					Namespace: globalPackagingNamespace,
+				},
+				ChartVersions: []models.ChartVersion{
+					{Version: "1.18.3", AppVersion: DefaultAppVersion},
+					{Version: "1.18.4", AppVersion: DefaultAppVersion},
+				},
+			})
+
+			response, err := server.GetInstalledPackageDetail(context.Background(), connect.NewRequest(&corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   globalPackagingCluster,
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			}))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.PackageAppVersion{})
+			detail := response.Msg.GetInstalledPackageDetail()
+			if got, want := detail.GetAvailablePackageRef(), tc.expectedAvailablePackageRef; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+			if got, want := detail.GetLatestVersion(), tc.expectedLatestVersion; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageSummariesAdopted(t *testing.T) {
+	existingReleases := []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      1,
+		},
+	}
+	actionConfig := newActionConfigFixture(t, "default", existingReleases, nil)
+	server, mock, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+	server.clientGetter = clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset(
+		newReleaseSecret("my-apache", "default", 1, "bitnami/apache", globalPackagingNamespace),
+	)).Build()
+
+	// The adopted release uses the versions of its available package rather
+	// than querying for a chart matching the release.
+	expectGetChart(t, mock, &models.Chart{
+		ID:   "bitnami/apache",
+		Name: "apache",
+		Repo: &models.AppRepository{
+			Namespace: globalPackagingNamespace,
+		},
+		ChartVersions: []models.ChartVersion{
+			{Version: "1.18.4", AppVersion: DefaultAppVersion},
+			{Version: "1.18.3", AppVersion: DefaultAppVersion},
+		},
+	})
+
+	response, err := server.GetInstalledPackageSummaries(context.Background(), connect.NewRequest(&corev1.GetInstalledPackageSummariesRequest{
+		Context: &corev1.Context{Namespace: "default"},
+	}))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	summaries := response.Msg.GetInstalledPackageSummaries()
+	if got, want := len(summaries), 1; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+	if got, want := summaries[0].GetLatestVersion().GetPkgVersion(), "1.18.4"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
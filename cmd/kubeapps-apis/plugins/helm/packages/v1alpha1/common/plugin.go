@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 )
 
@@ -16,10 +17,78 @@ const (
 	DefaultGlobalPackagingNamespace       = ""
 )
 
+// The types of the post-renderers which can be configured for the helm plugin.
+const (
+	PostRendererTypeLabels           = "labels"
+	PostRendererTypePatches          = "patches"
+	PostRendererTypeImagePullSecrets = "imagePullSecrets"
+)
+
 type HelmPluginConfig struct {
 	VersionsInSummary        pkgutils.VersionsInSummary
 	TimeoutSeconds           int32
 	GlobalPackagingNamespace string
+	// PostRenderers are applied, in order, to the manifests of every install
+	// and upgrade, after the registry secrets of the package repository.
+	PostRenderers []PostRendererConfig
+	// StorageDriver is the Helm storage driver of the releases: secret,
+	// configmap or sql. ClusterStorageDrivers overrides it for some clusters.
+	StorageDriver         string
+	ClusterStorageDrivers map[string]string
+	// ChartCache configures the cache of the chart tarballs downloaded to
+	// install and upgrade packages.
+	ChartCache ChartCacheConfig
+}
+
+// ChartCacheConfig configures the cache of chart tarballs, which is disabled
+// unless MaxSizeBytes is set.
+type ChartCacheConfig struct {
+	// Directory in which the tarballs are stored. They are kept in memory if empty.
+	Directory string `json:"directory,omitempty"`
+	// MaxSizeBytes is the total size of the cached tarballs, beyond which the
+	// least recently used ones are evicted.
+	MaxSizeBytes int64 `json:"maxSizeBytes,omitempty"`
+}
+
+// StorageDriverForCluster returns the Helm storage driver of the releases of the cluster.
+func (c *HelmPluginConfig) StorageDriverForCluster(cluster string) string {
+	if driver := c.ClusterStorageDrivers[cluster]; driver != "" {
+		return driver
+	}
+	if c.StorageDriver != "" {
+		return c.StorageDriver
+	}
+	return agent.StorageDriverSecret
+}
+
+// PostRendererConfig configures a post-renderer of the helm plugin.
+type PostRendererConfig struct {
+	// Type is one of labels, patches or imagePullSecrets.
+	Type string `json:"type"`
+	// Namespaces restricts the post-renderer to the releases of these
+	// namespaces. The post-renderer applies to every namespace if empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Labels and Annotations are added by the labels post-renderer.
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ConfigMaps are the names of the ConfigMaps, in the namespace of Kubeapps,
+	// whose entries are the strategic merge patches of the patches post-renderer.
+	ConfigMaps []string `json:"configMaps,omitempty"`
+	// ImagePullSecrets are added to every pod by the imagePullSecrets post-renderer.
+	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
+}
+
+// AppliesTo returns whether the post-renderer applies to the releases of the namespace.
+func (c PostRendererConfig) AppliesTo(namespace string) bool {
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range c.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
 }
 
 func NewDefaultPluginConfig() *HelmPluginConfig {
@@ -54,7 +123,11 @@ func ParsePluginConfig(pluginConfigPath string) (*HelmPluginConfig, error) {
 			Helm struct {
 				Packages struct {
 					V1alpha1 struct {
-						GlobalPackagingNamespace string `json:"globalPackagingNamespace"`
+						GlobalPackagingNamespace string               `json:"globalPackagingNamespace"`
+						PostRenderers            []PostRendererConfig `json:"postRenderers"`
+						StorageDriver            string               `json:"storageDriver"`
+						ClusterStorageDrivers    map[string]string    `json:"clusterStorageDrivers"`
+						ChartCache               ChartCacheConfig     `json:"chartCache"`
 					} `json:"v1alpha1"`
 				} `json:"packages"`
 			} `json:"helm"`
@@ -72,10 +145,38 @@ func ParsePluginConfig(pluginConfigPath string) (*HelmPluginConfig, error) {
 		return nil, fmt.Errorf("unable to unmarshal pluginconfig: %q error: %w", string(pluginConfig), err)
 	}
 
+	for i, postRenderer := range config.Helm.Packages.V1alpha1.PostRenderers {
+		switch postRenderer.Type {
+		case PostRendererTypeLabels, PostRendererTypePatches, PostRendererTypeImagePullSecrets:
+		default:
+			return nil, fmt.Errorf("unsupported type %q of the post-renderer %d, expected one of %q, %q or %q", postRenderer.Type, i, PostRendererTypeLabels, PostRendererTypePatches, PostRendererTypeImagePullSecrets)
+		}
+	}
+
+	storageDrivers := []string{config.Helm.Packages.V1alpha1.StorageDriver}
+	for _, driver := range config.Helm.Packages.V1alpha1.ClusterStorageDrivers {
+		storageDrivers = append(storageDrivers, driver)
+	}
+	for _, driver := range storageDrivers {
+		switch driver {
+		case "", agent.StorageDriverSecret, agent.StorageDriverConfigMap, agent.StorageDriverSQL:
+		default:
+			return nil, fmt.Errorf("unsupported helm storage driver %q, expected one of %q, %q or %q", driver, agent.StorageDriverSecret, agent.StorageDriverConfigMap, agent.StorageDriverSQL)
+		}
+	}
+
+	if config.Helm.Packages.V1alpha1.ChartCache.MaxSizeBytes < 0 {
+		return nil, fmt.Errorf("invalid chart cache size %d, expected a positive number of bytes", config.Helm.Packages.V1alpha1.ChartCache.MaxSizeBytes)
+	}
+
 	// return configured value
 	return &HelmPluginConfig{
 		VersionsInSummary:        config.Core.Packages.V1alpha1.VersionsInSummary,
 		TimeoutSeconds:           config.Core.Packages.V1alpha1.TimeoutSeconds,
 		GlobalPackagingNamespace: config.Helm.Packages.V1alpha1.GlobalPackagingNamespace,
+		PostRenderers:            config.Helm.Packages.V1alpha1.PostRenderers,
+		StorageDriver:            config.Helm.Packages.V1alpha1.StorageDriver,
+		ClusterStorageDrivers:    config.Helm.Packages.V1alpha1.ClusterStorageDrivers,
+		ChartCache:               config.Helm.Packages.V1alpha1.ChartCache,
 	}, nil
 }
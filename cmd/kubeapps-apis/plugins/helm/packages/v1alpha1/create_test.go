@@ -13,6 +13,9 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"google.golang.org/protobuf/types/known/anypb"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/release"
@@ -79,6 +82,43 @@ func TestCreateInstalledPackage(t *testing.T) {
 				Namespace: "default",
 			},
 		},
+		{
+			name: "renders the installed package without creating it for a dry run",
+			releaseStub: releaseStub{
+				chartID:       "bitnami/apache",
+				latestVersion: "1.18.3",
+			},
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.3",
+				},
+				Values: "{\"foo\": \"bar\"}",
+				DryRun: true,
+			},
+			expectedResponse: &corev1.CreateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+				RenderedPackage: &corev1.RenderedPackage{
+					ResourceRefs: []*corev1.ResourceRef{},
+				},
+			},
+		},
 		{
 			name: "returns invalid if available package ref invalid",
 			request: &corev1.CreateInstalledPackageRequest{
@@ -96,6 +136,7 @@ func TestCreateInstalledPackage(t *testing.T) {
 	ignoredUnexported := cmpopts.IgnoreUnexported(
 		corev1.CreateInstalledPackageResponse{},
 		corev1.InstalledPackageReference{},
+		corev1.RenderedPackage{},
 		corev1.Context{},
 		plugins.Plugin{},
 		chart.Chart{},
@@ -144,6 +185,17 @@ func TestCreateInstalledPackage(t *testing.T) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported, ignoredFields))
 				}
 			}
+
+			if tc.request.GetDryRun() {
+				// Verify that nothing was stored by Helm.
+				releases, err := actionConfig.Releases.Driver.List(func(*release.Release) bool { return true })
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if got, want := len(releases), 0; got != want {
+					t.Fatalf("got: %d, want: %d", got, want)
+				}
+			}
 		})
 	}
 }
@@ -197,7 +249,7 @@ func TestTimeoutCreateInstalledPackage(t *testing.T) {
 			var effectiveNs string
 			// stub createRelease function
 			server.createReleaseFunc = func(config *action.Configuration, name string, namespace string, valueString string, ch *chart.Chart,
-				registrySecrets map[string]string, timeout int32) (*release.Release, error) {
+				registrySecrets map[string]string, postRenderers agent.PostRendererChain, timeout int32, dryRun bool, waitOptions agent.WaitOptions) (*release.Release, error) {
 				effectiveConfig = config
 				effectiveTimeout = timeout
 				effectiveName = name
@@ -229,3 +281,96 @@ func TestTimeoutCreateInstalledPackage(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitOptionsCreateInstalledPackage(t *testing.T) {
+	customDetail := func(t *testing.T, customData *helmv1.InstalledPackageRequestCustomDataHelm) *anypb.Any {
+		customDetail, err := anypb.New(customData)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return customDetail
+	}
+	invalidCustomDetail, err := anypb.New(&corev1.Context{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name                string
+		customDetail        *anypb.Any
+		expectedWaitOptions agent.WaitOptions
+		expectedErrorCode   connect.Code
+	}{
+		{
+			name:                "No wait options are passed to the release creation function without custom detail",
+			expectedWaitOptions: agent.WaitOptions{},
+		},
+		{
+			name: "Wait options are passed to the release creation function",
+			customDetail: customDetail(t, &helmv1.InstalledPackageRequestCustomDataHelm{
+				Wait:        true,
+				WaitForJobs: true,
+			}),
+			expectedWaitOptions: agent.WaitOptions{Wait: true, WaitForJobs: true},
+		},
+		{
+			name:              "Returns invalid argument for a custom detail of another type",
+			customDetail:      invalidCustomDetail,
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.3",
+				},
+				CustomDetail: tc.customDetail,
+			}
+			actionConfig := newActionConfigFixture(t, request.GetTargetContext().GetNamespace(), nil, nil)
+			server, mockDB, cleanup := makeServer(t, true, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			defer cleanup()
+
+			var effectiveWaitOptions agent.WaitOptions
+			// stub createRelease function
+			server.createReleaseFunc = func(config *action.Configuration, name string, namespace string, valueString string, ch *chart.Chart,
+				registrySecrets map[string]string, postRenderers agent.PostRendererChain, timeout int32, dryRun bool, waitOptions agent.WaitOptions) (*release.Release, error) {
+				effectiveWaitOptions = waitOptions
+				return &release.Release{}, nil
+			}
+
+			populateAssetDB(t, mockDB, []releaseStub{{
+				chartID:       "bitnami/apache",
+				latestVersion: "1.18.3",
+			}})
+
+			_, err := server.CreateInstalledPackage(context.Background(), connect.NewRequest(request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+			if got, want := effectiveWaitOptions, tc.expectedWaitOptions; got != want {
+				t.Errorf("got: %+v, want: %+v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	log "k8s.io/klog/v2"
+)
+
+// GetInstalledPackageDrift returns the differences between the resources of the
+// current revision of an installed package and their live state, re-applying
+// the revision when requested.
+func (s *Server) GetInstalledPackageDrift(ctx context.Context, request *connect.Request[helmv1.GetInstalledPackageDriftRequest]) (*connect.Response[helmv1.GetInstalledPackageDriftResponse], error) {
+	installedRef := request.Msg.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	log.InfoS("+helm GetInstalledPackageDrift", "cluster", installedRef.GetContext().GetCluster(), "namespace", namespace, "id", releaseName, "repair", request.Msg.GetRepair())
+
+	// Create an action config for the installed pkg context.
+	actionConfig, err := s.actionConfigGetter(request.Header(), installedRef.GetContext())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
+	}
+
+	cluster := installedRef.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	rel, err := agent.GetRelease(actionConfig, releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find Helm release %q in namespace %q: %+w", releaseName, namespace, err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get Helm release %q in namespace %q: %w", releaseName, namespace, err))
+	}
+
+	drift, err := s.releaseDrift(ctx, request.Header(), cluster, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &helmv1.GetInstalledPackageDriftResponse{
+		ResourcesDrift: drift,
+	}
+	if request.Msg.GetRepair() && len(drift) > 0 {
+		// Rolling back to the current revision re-applies its manifest, Helm
+		// patching the live resources towards it.
+		repaired, err := s.rollbackRelease(ctx, request.Header(), actionConfig, cluster, namespace, releaseName, rel.Version)
+		if err != nil {
+			return nil, err
+		}
+		response.RepairedRevision = int32(repaired.Version)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// releaseDrift returns the resources of the release manifest whose live state
+// differs from the manifest, fetching them with the credentials of the user.
+func (s *Server) releaseDrift(ctx context.Context, headers http.Header, cluster string, rel *release.Release) ([]*helmv1.ResourceDiff, error) {
+	resources, err := manifestResources(rel.Manifest, rel.Namespace)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to decode the manifest of Helm release %q: %w", rel.Name, err))
+	}
+	if len(resources) == 0 {
+		return []*helmv1.ResourceDiff{}, nil
+	}
+
+	mapper, err := s.restMapperGetter(headers, cluster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the REST mapper of the cluster %q: %w", cluster, err))
+	}
+	dynamicClient, err := s.clientGetter.Dynamic(headers, cluster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the k8s client: %w", err))
+	}
+
+	drift := []*helmv1.ResourceDiff{}
+	for _, r := range resources {
+		gvk := schema.FromAPIVersionAndKind(r.ref.ApiVersion, r.ref.Kind)
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to find the API resource of the kind %q: %w", gvk.String(), err))
+		}
+		resourceClient := dynamicClient.Resource(mapping.Resource)
+		name := r.ref.Name
+		var liveObject map[string]interface{}
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			live, err := resourceClient.Namespace(r.ref.Namespace).Get(ctx, name, metav1.GetOptions{})
+			if err == nil {
+				liveObject = live.Object
+			} else if !k8serrors.IsNotFound(err) {
+				return nil, connecterror.FromK8sError("get", r.ref.Kind, r.ref.Namespace+"/"+name, err)
+			}
+		} else {
+			live, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+			if err == nil {
+				liveObject = live.Object
+			} else if !k8serrors.IsNotFound(err) {
+				return nil, connecterror.FromK8sError("get", r.ref.Kind, name, err)
+			}
+		}
+
+		ref := r.ref
+		if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+			ref = &corev1.ResourceRef{ApiVersion: r.ref.ApiVersion, Kind: r.ref.Kind, Name: name}
+		}
+		if liveObject == nil {
+			drift = append(drift, &helmv1.ResourceDiff{
+				ResourceRef: ref,
+				Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED,
+			})
+			continue
+		}
+
+		entries, err := diffLiveObject(r.object.(map[string]interface{}), liveObject)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to compare the live state of %s %q: %w", r.ref.Kind, name, err))
+		}
+		if len(entries) > 0 {
+			drift = append(drift, &helmv1.ResourceDiff{
+				ResourceRef: ref,
+				Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+				Entries:     entries,
+			})
+		}
+	}
+	return drift, nil
+}
+
+// diffLiveObject returns the differences between the desired state of a
+// resource, from a release manifest, and its live state. Only the fields set in
+// the manifest are compared, as the other ones are defaulted or managed by the
+// cluster and its controllers.
+func diffLiveObject(desired, live map[string]interface{}) ([]*helmv1.RevisionDiffEntry, error) {
+	normalizedDesired, err := normalizeForDiff(desired)
+	if err != nil {
+		return nil, err
+	}
+	normalizedLive, err := normalizeForDiff(live)
+	if err != nil {
+		return nil, err
+	}
+	desiredObject := compactDesired(normalizedDesired).(map[string]interface{})
+	delete(desiredObject, "status")
+
+	isSecret := desiredObject["kind"] == "Secret" && desiredObject["apiVersion"] == "v1"
+	if isSecret {
+		mergeSecretStringData(desiredObject)
+	}
+
+	entries, err := diffPath("", desiredObject, pruneToDesired(desiredObject, normalizedLive), []*helmv1.RevisionDiffEntry{})
+	if err != nil {
+		return nil, err
+	}
+	if isSecret {
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Path, "data.") {
+				entry.FromValue, entry.ToValue = redactDiffValue(entry.FromValue), redactDiffValue(entry.ToValue)
+			}
+		}
+	}
+	return entries, nil
+}
+
+// compactDesired removes the null and empty values of a manifest, which are
+// not stored by the API server.
+func compactDesired(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		compacted := map[string]interface{}{}
+		for k, v := range typed {
+			v = compactDesired(v)
+			if isEmptyDesired(v) {
+				continue
+			}
+			compacted[k] = v
+		}
+		return compacted
+	case []interface{}:
+		compacted := make([]interface{}, 0, len(typed))
+		for _, v := range typed {
+			compacted = append(compacted, compactDesired(v))
+		}
+		return compacted
+	}
+	return value
+}
+
+func isEmptyDesired(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(typed) == 0
+	case []interface{}:
+		return len(typed) == 0
+	}
+	return false
+}
+
+// pruneToDesired returns the live value restricted to the map keys present in
+// the desired value, so that only the fields of the manifest are compared.
+func pruneToDesired(desired, live interface{}) interface{} {
+	switch desiredTyped := desired.(type) {
+	case map[string]interface{}:
+		liveTyped, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		pruned := map[string]interface{}{}
+		for k, v := range desiredTyped {
+			if liveValue, ok := liveTyped[k]; ok {
+				pruned[k] = pruneToDesired(v, liveValue)
+			}
+		}
+		return pruned
+	case []interface{}:
+		liveTyped, ok := live.([]interface{})
+		if !ok {
+			return live
+		}
+		pruned := make([]interface{}, 0, len(liveTyped))
+		for i, v := range liveTyped {
+			if i < len(desiredTyped) {
+				v = pruneToDesired(desiredTyped[i], v)
+			}
+			pruned = append(pruned, v)
+		}
+		return pruned
+	}
+	return live
+}
+
+// mergeSecretStringData moves the stringData of a Secret to its data, as done
+// by the API server.
+func mergeSecretStringData(secret map[string]interface{}) {
+	stringData, ok := secret["stringData"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	data, ok := secret["data"].(map[string]interface{})
+	if !ok {
+		data = map[string]interface{}{}
+	}
+	for k, v := range stringData {
+		if s, ok := v.(string); ok {
+			data[k] = base64.StdEncoding.EncodeToString([]byte(s))
+		}
+	}
+	secret["data"] = data
+	delete(secret, "stringData")
+}
+
+func redactDiffValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	redacted, _ := json.Marshal(RedactedString)
+	return string(redacted)
+}
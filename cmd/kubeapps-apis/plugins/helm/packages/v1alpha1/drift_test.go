@@ -0,0 +1,284 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestDiffLiveObject(t *testing.T) {
+	testCases := []struct {
+		name     string
+		desired  map[string]interface{}
+		live     map[string]interface{}
+		expected []*helmv1.RevisionDiffEntry
+	}{
+		{
+			name: "it ignores the fields not set in the manifest",
+			desired: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "my-app", "annotations": map[string]interface{}{}},
+				"spec": map[string]interface{}{
+					"replicas": 2,
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "nginx", "image": "nginx:1.25", "resources": nil},
+							},
+						},
+					},
+				},
+			},
+			live: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":            "my-app",
+					"namespace":       "default",
+					"uid":             "1234",
+					"resourceVersion": "42",
+					"annotations":     map[string]interface{}{"deployment.kubernetes.io/revision": "1"},
+				},
+				"spec": map[string]interface{}{
+					"replicas":             int64(2),
+					"revisionHistoryLimit": int64(10),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "nginx", "image": "nginx:1.25", "imagePullPolicy": "IfNotPresent"},
+							},
+						},
+					},
+				},
+				"status": map[string]interface{}{"replicas": int64(2)},
+			},
+			expected: []*helmv1.RevisionDiffEntry{},
+		},
+		{
+			name: "it returns the changed and removed fields of the manifest",
+			desired: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "my-config", "labels": map[string]interface{}{"team": "payments"}},
+				"data":       map[string]interface{}{"color": "blue", "size": "2"},
+			},
+			live: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "my-config"},
+				"data":       map[string]interface{}{"color": "red", "size": "2", "extra": "added by hand"},
+			},
+			expected: []*helmv1.RevisionDiffEntry{
+				{Path: "data.color", Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED, FromValue: `"blue"`, ToValue: `"red"`},
+				{Path: "metadata.labels", Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED, FromValue: `{"team":"payments"}`},
+			},
+		},
+		{
+			name: "it compares the stringData of secrets and redacts their data",
+			desired: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": "my-secret"},
+				"stringData": map[string]interface{}{"password": "secret", "user": "admin"},
+			},
+			live: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": "my-secret"},
+				// base64 of "changed" and "admin"
+				"data": map[string]interface{}{"password": "Y2hhbmdlZA==", "user": "YWRtaW4="},
+				"type": "Opaque",
+			},
+			expected: []*helmv1.RevisionDiffEntry{
+				{Path: "data.password", Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED, FromValue: `"REDACTED"`, ToValue: `"REDACTED"`},
+			},
+		},
+	}
+
+	opts := cmpopts.IgnoreUnexported(helmv1.RevisionDiffEntry{})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := diffLiveObject(tc.desired, tc.live)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := entries, tc.expected; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageDrift(t *testing.T) {
+	manifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  color: blue
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-service
+spec:
+  ports:
+    - port: 80
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: my-role
+rules: []
+`
+	liveObjects := []k8sruntime.Object{
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "my-config", "namespace": "default"},
+			"data":       map[string]interface{}{"color": "red"},
+		}},
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata":   map[string]interface{}{"name": "my-role"},
+		}},
+	}
+	releaseRef := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Cluster: "default", Namespace: "default"},
+		Identifier: "my-apache",
+	}
+
+	testCases := []struct {
+		name                     string
+		request                  *helmv1.GetInstalledPackageDriftRequest
+		expectedResponse         *helmv1.GetInstalledPackageDriftResponse
+		expectedErrorCode        connect.Code
+		expectedReleaseRevisions int
+	}{
+		{
+			name:    "returns the changed and missing resources",
+			request: &helmv1.GetInstalledPackageDriftRequest{InstalledPackageRef: releaseRef},
+			expectedResponse: &helmv1.GetInstalledPackageDriftResponse{
+				ResourcesDrift: []*helmv1.ResourceDiff{
+					{
+						ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "ConfigMap", Name: "my-config", Namespace: "default"},
+						Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+						Entries: []*helmv1.RevisionDiffEntry{
+							{Path: "data.color", Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED, FromValue: `"blue"`, ToValue: `"red"`},
+						},
+					},
+					{
+						ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "Service", Name: "my-service", Namespace: "default"},
+						Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED,
+					},
+				},
+			},
+			expectedReleaseRevisions: 1,
+		},
+		{
+			name:    "repairs the drift by re-applying the current revision",
+			request: &helmv1.GetInstalledPackageDriftRequest{InstalledPackageRef: releaseRef, Repair: true},
+			expectedResponse: &helmv1.GetInstalledPackageDriftResponse{
+				ResourcesDrift: []*helmv1.ResourceDiff{
+					{
+						ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "ConfigMap", Name: "my-config", Namespace: "default"},
+						Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+						Entries: []*helmv1.RevisionDiffEntry{
+							{Path: "data.color", Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED, FromValue: `"blue"`, ToValue: `"red"`},
+						},
+					},
+					{
+						ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "Service", Name: "my-service", Namespace: "default"},
+						Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED,
+					},
+				},
+				RepairedRevision: 2,
+			},
+			expectedReleaseRevisions: 2,
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &helmv1.GetInstalledPackageDriftRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Namespace: "default"},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedErrorCode: connect.CodeNotFound,
+		},
+	}
+
+	opts := cmpopts.IgnoreUnexported(
+		helmv1.GetInstalledPackageDriftResponse{},
+		helmv1.ResourceDiff{},
+		helmv1.RevisionDiffEntry{},
+		corev1.ResourceRef{},
+	)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      1,
+					manifest:     manifest,
+				},
+			}, nil)
+			server, _, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			server.clientGetter = clientgetter.NewBuilder().
+				WithTyped(typfake.NewSimpleClientset()).
+				WithDynamic(dynfake.NewSimpleDynamicClient(k8sruntime.NewScheme(), liveObjects...)).
+				Build()
+			server.restMapperGetter = func(http.Header, string) (meta.RESTMapper, error) {
+				return testrestmapper.TestOnlyStaticRESTMapper(scheme.Scheme), nil
+			}
+
+			response, err := server.GetInstalledPackageDrift(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			revisions, err := actionConfig.Releases.History("my-apache")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(revisions), tc.expectedReleaseRevisions; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
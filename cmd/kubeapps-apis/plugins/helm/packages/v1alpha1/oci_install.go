@@ -0,0 +1,127 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	appRepov1 "github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1/utils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"google.golang.org/protobuf/types/known/anypb"
+	"helm.sh/helm/v3/pkg/chart"
+	corek8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog/v2"
+)
+
+// ociReferencePrefix is the prefix of an available package identifier which
+// is a raw OCI reference to a chart, rather than a chart of an AppRepository.
+const ociReferencePrefix = "oci://"
+
+// isOCIReference returns whether an available package identifier is a raw OCI
+// reference, such as `oci://registry/repo/chart:version`.
+func isOCIReference(identifier string) bool {
+	return strings.HasPrefix(identifier, ociReferencePrefix)
+}
+
+// parseOCIReference splits a raw OCI reference into the reference of the chart,
+// without the tag, and the version of the chart. The version is the tag of the
+// reference, if any, or the requested version otherwise.
+func parseOCIReference(identifier, requestedVersion string) (string, string, error) {
+	ref := strings.TrimPrefix(identifier, ociReferencePrefix)
+	if ref == "" || strings.Contains(ref, "@") {
+		return "", "", fmt.Errorf("Invalid OCI reference %q, expected %sregistry/repository/chart:version", identifier, ociReferencePrefix)
+	}
+	version := ""
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref, version = ref[:i], ref[i+1:]
+	}
+	if !strings.Contains(ref, "/") || strings.HasSuffix(ref, "/") {
+		return "", "", fmt.Errorf("Invalid OCI reference %q, expected %sregistry/repository/chart:version", identifier, ociReferencePrefix)
+	}
+	switch {
+	case version == "" && requestedVersion == "":
+		return "", "", fmt.Errorf("Unable to find the version of the OCI reference %q", identifier)
+	case version == "":
+		version = requestedVersion
+	case requestedVersion != "" && requestedVersion != version:
+		return "", "", fmt.Errorf("The requested version %q does not match the tag of the OCI reference %q", requestedVersion, identifier)
+	}
+	return ociReferencePrefix + ref, version, nil
+}
+
+// ociRegistrySecretFromCustomDetail returns the registry secret requested using
+// the custom detail of a create or update request, if any.
+func ociRegistrySecretFromCustomDetail(customDetail *anypb.Any) (string, error) {
+	if customDetail == nil {
+		return "", nil
+	}
+	customData := &helmv1.InstalledPackageRequestCustomDataHelm{}
+	if err := customDetail.UnmarshalTo(customData); err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to unmarshal the custom detail: %w", err))
+	}
+	return customData.GetOciRegistrySecret(), nil
+}
+
+// fetchChartFromOCIReference pulls the chart with the given version from a raw
+// OCI reference, using the credentials of the docker-config registry secret in
+// the namespace, if any.
+func (s *Server) fetchChartFromOCIReference(ctx context.Context, headers http.Header, ociRef, version, namespace, registrySecret string) (*chart.Chart, error) {
+	// The existing OCI chart client is based on having a typed AppRepository.
+	appRepo := &appRepov1.AppRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+		},
+		Spec: appRepov1.AppRepositorySpec{
+			Type: "oci",
+			URL:  ociRef,
+		},
+	}
+
+	var authSecret *corek8sv1.Secret
+	if registrySecret != "" {
+		typedClient, err := s.clientGetter.Typed(headers, s.globalPackagingCluster)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create kubernetes clientset: %w", err))
+		}
+		authSecret, err = typedClient.CoreV1().Secrets(namespace).Get(ctx, registrySecret, metav1.GetOptions{})
+		if err != nil {
+			return nil, connecterror.FromK8sError("get", "secret", registrySecret, err)
+		}
+		appRepo.Spec.Auth.Header = &appRepov1.AppRepositoryAuthHeader{
+			SecretKeyRef: corek8sv1.SecretKeySelector{
+				LocalObjectReference: corek8sv1.LocalObjectReference{Name: registrySecret},
+				Key:                  corek8sv1.DockerConfigJsonKey,
+			},
+		}
+	}
+
+	userAgentString := fmt.Sprintf("%s/%s/%s/%s", UserAgentPrefix, pluginDetail.Name, pluginDetail.Version, version)
+	tarballURL := fmt.Sprintf("%s:%s", ociRef, version)
+	log.InfoS("Fetching chart from OCI reference with user-agent", "ref", tarballURL, "userAgentString", userAgentString)
+
+	ch, err := utils.GetChart(
+		&utils.ChartDetails{
+			AppRepositoryResourceNamespace: namespace,
+			ChartName:                      path.Base(ociRef),
+			Version:                        version,
+			TarballURL:                     tarballURL,
+		},
+		appRepo,
+		nil, authSecret, nil,
+		s.chartClientFactory.New(tarballURL, userAgentString),
+		s.chartCache,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to fetch the chart %q: %w", tarballURL, err))
+	}
+	return ch, nil
+}
@@ -0,0 +1,323 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"google.golang.org/protobuf/types/known/anypb"
+	"helm.sh/helm/v3/pkg/release"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	testCases := []struct {
+		name             string
+		identifier       string
+		requestedVersion string
+		expectedRef      string
+		expectedVersion  string
+		expectedErr      bool
+	}{
+		{
+			name:            "uses the tag of the reference as version",
+			identifier:      "oci://registry.example.com/charts/apache:1.18.3",
+			expectedRef:     "oci://registry.example.com/charts/apache",
+			expectedVersion: "1.18.3",
+		},
+		{
+			name:             "uses the requested version for a reference without tag",
+			identifier:       "oci://registry.example.com:5000/charts/apache",
+			requestedVersion: "1.18.3",
+			expectedRef:      "oci://registry.example.com:5000/charts/apache",
+			expectedVersion:  "1.18.3",
+		},
+		{
+			name:             "accepts a requested version matching the tag",
+			identifier:       "oci://registry.example.com:5000/charts/apache:1.18.3",
+			requestedVersion: "1.18.3",
+			expectedRef:      "oci://registry.example.com:5000/charts/apache",
+			expectedVersion:  "1.18.3",
+		},
+		{
+			name:             "returns an error for a requested version not matching the tag",
+			identifier:       "oci://registry.example.com/charts/apache:1.18.3",
+			requestedVersion: "1.18.4",
+			expectedErr:      true,
+		},
+		{
+			name:        "returns an error without version",
+			identifier:  "oci://registry.example.com/charts/apache",
+			expectedErr: true,
+		},
+		{
+			name:        "returns an error without repository",
+			identifier:  "oci://registry.example.com:1.18.3",
+			expectedErr: true,
+		},
+		{
+			name:        "returns an error for a digest",
+			identifier:  "oci://registry.example.com/charts/apache@sha256:0123",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, version, err := parseOCIReference(tc.identifier, tc.requestedVersion)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := ref, tc.expectedRef; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := version, tc.expectedVersion; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+// newOCIRegistrySecret returns a docker-config secret for an OCI registry.
+func newOCIRegistrySecret(name, namespace string) *apiv1.Secret {
+	return &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: apiv1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			apiv1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"auth":"Zm9vOmJhcg=="}}}`),
+		},
+	}
+}
+
+func TestCreateInstalledPackageFromOCIReference(t *testing.T) {
+	registrySecretDetail, err := anypb.New(&helmv1.InstalledPackageRequestCustomDataHelm{
+		OciRegistrySecret: "registry-creds",
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name                string
+		request             *corev1.CreateInstalledPackageRequest
+		expectedChart       string
+		expectedVersion     string
+		expectedAnnotations map[string]string
+		expectedErrorCode   connect.Code
+	}{
+		{
+			name: "creates the installed package from a public OCI reference",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "oci://registry.example.com/charts/apache:1.18.3",
+				},
+				TargetContext: &corev1.Context{Namespace: "default"},
+				Name:          "my-apache",
+			},
+			expectedChart:   "apache",
+			expectedVersion: "1.18.3",
+			expectedAnnotations: map[string]string{
+				adoptedPackageIdentifierAnnotation:    "oci://registry.example.com/charts/apache",
+				adoptedPackageRepoNamespaceAnnotation: globalPackagingNamespace,
+			},
+		},
+		{
+			name: "creates the installed package from a private OCI reference",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "oci://registry.example.com/charts/apache",
+				},
+				TargetContext:       &corev1.Context{Namespace: "default"},
+				Name:                "my-apache",
+				PkgVersionReference: &corev1.VersionReference{Version: "1.18.4"},
+				CustomDetail:        registrySecretDetail,
+			},
+			expectedChart:   "apache",
+			expectedVersion: "1.18.4",
+			expectedAnnotations: map[string]string{
+				adoptedPackageIdentifierAnnotation:     "oci://registry.example.com/charts/apache",
+				adoptedPackageRepoNamespaceAnnotation:  globalPackagingNamespace,
+				adoptedPackageRegistrySecretAnnotation: "registry-creds",
+			},
+		},
+		{
+			name: "returns invalid argument for an OCI reference without version",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+					Identifier: "oci://registry.example.com/charts/apache",
+				},
+				TargetContext: &corev1.Context{Namespace: "default"},
+				Name:          "my-apache",
+			},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "returns not found for an unknown registry secret",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    &corev1.Context{Namespace: "other-namespace"},
+					Identifier: "oci://registry.example.com/charts/apache:1.18.3",
+				},
+				TargetContext: &corev1.Context{Namespace: "default"},
+				Name:          "my-apache",
+				CustomDetail:  registrySecretDetail,
+			},
+			expectedErrorCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, tc.request.GetTargetContext().GetNamespace(), nil, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			// Helm stores the release in memory, so the secret to be annotated
+			// is created beforehand.
+			clientSet := typfake.NewSimpleClientset(
+				newReleaseSecret("my-apache", "default", 1, "", ""),
+				newOCIRegistrySecret("registry-creds", globalPackagingNamespace),
+			)
+			server.clientGetter = clientgetter.NewBuilder().WithTyped(clientSet).Build()
+
+			_, err := server.CreateInstalledPackage(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+
+			releases, err := actionConfig.Releases.Driver.List(func(*release.Release) bool { return true })
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(releases), 1; got != want {
+				t.Fatalf("got: %d, want: %d", got, want)
+			}
+			if got, want := releases[0].Chart.Metadata.Name, tc.expectedChart; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := releases[0].Chart.Metadata.Version, tc.expectedVersion; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			secret, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), releaseStorageName("my-apache", 1), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := secret.Annotations, tc.expectedAnnotations; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestUpdateInstalledPackageFromOCIReference(t *testing.T) {
+	testCases := []struct {
+		name              string
+		request           *corev1.UpdateInstalledPackageRequest
+		expectedVersion   string
+		expectedErrorCode connect.Code
+	}{
+		{
+			name: "updates the installed package to the requested version",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Namespace: "default"},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{Version: "1.18.4"},
+				Values:              "{\"foo\": \"bar\"}",
+			},
+			expectedVersion: "1.18.4",
+		},
+		{
+			name: "keeps the installed version when no version is requested",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Namespace: "default"},
+					Identifier: "my-apache",
+				},
+				Values: "{\"foo\": \"bar\"}",
+			},
+			expectedVersion: "1.18.3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					version:      1,
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+				},
+			}, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			installedSecret := newReleaseSecret("my-apache", "default", 1, "oci://registry.example.com/charts/apache", globalPackagingNamespace)
+			installedSecret.Annotations[adoptedPackageRegistrySecretAnnotation] = "registry-creds"
+			clientSet := typfake.NewSimpleClientset(
+				installedSecret,
+				newReleaseSecret("my-apache", "default", 2, "", ""),
+				newOCIRegistrySecret("registry-creds", globalPackagingNamespace),
+			)
+			server.clientGetter = clientgetter.NewBuilder().WithTyped(clientSet).Build()
+
+			_, err := server.UpdateInstalledPackage(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+
+			rel, err := actionConfig.Releases.Get("my-apache", 2)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := rel.Chart.Metadata.Name, "apache"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := rel.Chart.Metadata.Version, tc.expectedVersion; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			// The new revision keeps the OCI reference and its registry secret.
+			secret, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), releaseStorageName("my-apache", 2), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := secret.Annotations, installedSecret.Annotations; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
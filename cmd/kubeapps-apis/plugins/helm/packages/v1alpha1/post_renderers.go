@@ -0,0 +1,76 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// postRenderersFor returns the post-renderers configured for the releases of
+// the namespace, in the order of the plugin config.
+func (s *Server) postRenderersFor(ctx context.Context, namespace string) (agent.PostRendererChain, error) {
+	var postRenderers agent.PostRendererChain
+	for _, config := range s.pluginConfig.PostRenderers {
+		if !config.AppliesTo(namespace) {
+			continue
+		}
+		switch config.Type {
+		case common.PostRendererTypeLabels:
+			postRenderers = append(postRenderers, agent.NewMetadataPostRenderer(config.Labels, config.Annotations))
+		case common.PostRendererTypeImagePullSecrets:
+			postRenderers = append(postRenderers, agent.NewImagePullSecretsPostRenderer(config.ImagePullSecrets))
+		case common.PostRendererTypePatches:
+			patches, err := s.getPostRendererPatches(ctx, config.ConfigMaps)
+			if err != nil {
+				return nil, err
+			}
+			postRenderer, err := agent.NewPatchesPostRenderer(patches)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Invalid patches in the ConfigMaps %q: %w", config.ConfigMaps, err))
+			}
+			postRenderers = append(postRenderers, postRenderer)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unsupported post-renderer type %q", config.Type))
+		}
+	}
+	return postRenderers, nil
+}
+
+// getPostRendererPatches returns the patches stored in the ConfigMaps of the
+// Kubeapps namespace. The ConfigMaps are read with the Kubeapps service account
+// as users are not expected to have access to the Kubeapps namespace.
+func (s *Server) getPostRendererPatches(ctx context.Context, configMapNames []string) ([]string, error) {
+	if len(configMapNames) == 0 {
+		return nil, nil
+	}
+	typedClient, err := s.localServiceAccountClientGetter.Typed(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create kubernetes clientset: %w", err))
+	}
+	patches := []string{}
+	for _, name := range configMapNames {
+		configMap, err := typedClient.CoreV1().ConfigMaps(s.kubeappsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, connecterror.FromK8sError("get", "configmap", name, err)
+		}
+		// The entries of a ConfigMap are applied in the order of their keys.
+		keys := make([]string, 0, len(configMap.Data))
+		for key := range configMap.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			patches = append(patches, configMap.Data[key])
+		}
+	}
+	return patches, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	corek8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestInstallPackageWithPostRenderers(t *testing.T) {
+	chartTarball, err := os.ReadFile("./utils/testdata/nginx-5.1.1-apiVersionV2.tgz")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	patches := &corek8sv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nginx-patches",
+			Namespace: kubeappsNamespace,
+		},
+		Data: map[string]string{
+			"replicas.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-nginx
+spec:
+  replicas: 7
+`,
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		namespace         string
+		postRenderers     []common.PostRendererConfig
+		expectedContent   []string
+		unexpectedContent []string
+		expectedErrorCode connect.Code
+	}{
+		{
+			name:      "applies the post-renderers in order",
+			namespace: "default",
+			postRenderers: []common.PostRendererConfig{
+				{Type: common.PostRendererTypePatches, ConfigMaps: []string{"nginx-patches"}},
+				{Type: common.PostRendererTypeLabels, Labels: map[string]string{"team": "payments"}},
+				{Type: common.PostRendererTypeImagePullSecrets, ImagePullSecrets: []string{"default-secret"}},
+			},
+			expectedContent: []string{"replicas: 7", "team: payments", "name: default-secret"},
+		},
+		{
+			name:      "skips the post-renderers of other namespaces",
+			namespace: "default",
+			postRenderers: []common.PostRendererConfig{
+				{Type: common.PostRendererTypeLabels, Namespaces: []string{"production"}, Labels: map[string]string{"team": "payments"}},
+			},
+			unexpectedContent: []string{"team: payments"},
+		},
+		{
+			name:      "returns not found for a missing patches ConfigMap",
+			namespace: "default",
+			postRenderers: []common.PostRendererConfig{
+				{Type: common.PostRendererTypePatches, ConfigMaps: []string{"missing-patches"}},
+			},
+			expectedErrorCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, tc.namespace, nil, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			server.localServiceAccountClientGetter = clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset(patches)).BuildFixedCluster()
+			server.pluginConfig.PostRenderers = tc.postRenderers
+
+			_, err := server.InstallPackageFromTarball(context.Background(), connect.NewRequest(&helmv1.InstallPackageFromTarballRequest{
+				TargetContext: &corev1.Context{Namespace: tc.namespace},
+				Name:          "my-nginx",
+				ChartTarball:  chartTarball,
+			}))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				if err == nil {
+					t.Fatalf("got: nil, want: %+v", tc.expectedErrorCode)
+				}
+				return
+			}
+
+			rel, err := actionConfig.Releases.Get("my-nginx", 1)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			for _, content := range tc.expectedContent {
+				if !strings.Contains(rel.Manifest, content) {
+					t.Errorf("the manifest does not contain %q:\n%s", content, rel.Manifest)
+				}
+			}
+			for _, content := range tc.unexpectedContent {
+				if strings.Contains(rel.Manifest, content) {
+					t.Errorf("the manifest contains %q:\n%s", content, rel.Manifest)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
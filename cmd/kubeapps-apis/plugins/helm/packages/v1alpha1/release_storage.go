@@ -0,0 +1,119 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"helm.sh/helm/v3/pkg/release"
+	corek8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// errNoReleaseStorageObjects returns the error of the features relying on the
+// kubernetes objects in which Helm stores releases, when the releases of the
+// cluster are stored in a database instead.
+func errNoReleaseStorageObjects(cluster string) error {
+	return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("The releases of the cluster %q are stored by the %q helm storage driver, which does not support this operation", cluster, agent.StorageDriverSQL))
+}
+
+// listReleaseStorageObjects returns the metadata of the Secrets or ConfigMaps,
+// depending on the storage driver of the cluster, in which Helm stores the
+// revisions of the releases matching the selector.
+func (s *Server) listReleaseStorageObjects(ctx context.Context, headers http.Header, cluster, namespace, selector string) ([]metav1.ObjectMeta, error) {
+	typedClient, err := s.clientGetter.Typed(headers, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []metav1.ObjectMeta{}
+	switch s.pluginConfig.StorageDriverForCluster(cluster) {
+	case agent.StorageDriverSQL:
+		return nil, errNoReleaseStorageObjects(cluster)
+	case agent.StorageDriverConfigMap:
+		configMaps, err := typedClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for _, configMap := range configMaps.Items {
+			objects = append(objects, configMap.ObjectMeta)
+		}
+	default:
+		secrets, err := typedClient.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range secrets.Items {
+			objects = append(objects, secret.ObjectMeta)
+		}
+	}
+	return objects, nil
+}
+
+// patchReleaseStorageObject applies a merge patch to the Secret or ConfigMap
+// in which Helm stores a revision of a release.
+func (s *Server) patchReleaseStorageObject(ctx context.Context, headers http.Header, cluster, namespace, name string, patch []byte) error {
+	typedClient, err := s.clientGetter.Typed(headers, cluster)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create kubernetes clientset: %w", err))
+	}
+
+	switch s.pluginConfig.StorageDriverForCluster(cluster) {
+	case agent.StorageDriverSQL:
+		return errNoReleaseStorageObjects(cluster)
+	case agent.StorageDriverConfigMap:
+		_, err = typedClient.CoreV1().ConfigMaps(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	default:
+		_, err = typedClient.CoreV1().Secrets(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
+	return err
+}
+
+// watchReleaseStorageObjects watches the Secrets or ConfigMaps in which Helm
+// stores the revisions of the releases.
+func (s *Server) watchReleaseStorageObjects(ctx context.Context, headers http.Header, cluster, namespace string) (watch.Interface, error) {
+	typedClient, err := s.clientGetter.Typed(headers, cluster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the k8s client: %w", err))
+	}
+
+	options := metav1.ListOptions{LabelSelector: helmReleaseStorageSelector}
+	var watcher watch.Interface
+	switch s.pluginConfig.StorageDriverForCluster(cluster) {
+	case agent.StorageDriverSQL:
+		return nil, errNoReleaseStorageObjects(cluster)
+	case agent.StorageDriverConfigMap:
+		watcher, err = typedClient.CoreV1().ConfigMaps(namespace).Watch(ctx, options)
+		if err != nil {
+			return nil, connecterror.FromK8sError("watch", "ConfigMap", namespace+"/*", err)
+		}
+	default:
+		watcher, err = typedClient.CoreV1().Secrets(namespace).Watch(ctx, options)
+		if err != nil {
+			return nil, connecterror.FromK8sError("watch", "Secret", namespace+"/*", err)
+		}
+	}
+	return watcher, nil
+}
+
+// releaseFromStorageObject decodes the release stored in a Secret or ConfigMap
+// watched by watchReleaseStorageObjects, returning false for other objects.
+func releaseFromStorageObject(object interface{}) (*release.Release, metav1.ObjectMeta, bool, error) {
+	switch o := object.(type) {
+	case *corek8sv1.Secret:
+		rel, err := decodeRelease(string(o.Data["release"]))
+		return rel, o.ObjectMeta, true, err
+	case *corek8sv1.ConfigMap:
+		rel, err := decodeRelease(o.Data["release"])
+		return rel, o.ObjectMeta, true, err
+	}
+	return nil, metav1.ObjectMeta{}, false, nil
+}
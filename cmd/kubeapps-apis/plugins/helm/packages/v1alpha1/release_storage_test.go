@@ -0,0 +1,146 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"helm.sh/helm/v3/pkg/release"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	typfake "k8s.io/client-go/kubernetes/fake"
+)
+
+// releaseConfigMap returns the configmap in which the helm configmaps storage
+// driver stores the given release revision.
+func releaseConfigMap(t *testing.T, name, namespace string, version int, status release.Status) *apiv1.ConfigMap {
+	secret := releaseSecret(t, name, namespace, version, status)
+	return &apiv1.ConfigMap{
+		ObjectMeta: secret.ObjectMeta,
+		Data: map[string]string{
+			"release": string(secret.Data["release"]),
+		},
+	}
+}
+
+func newServerWithStorageDriver(driver string, objects ...k8sruntime.Object) *Server {
+	pluginConfig := common.NewDefaultPluginConfig()
+	pluginConfig.ClusterStorageDrivers = map[string]string{"default": driver}
+	return &Server{
+		clientGetter:           clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset(objects...)).Build(),
+		globalPackagingCluster: "default",
+		pluginConfig:           pluginConfig,
+	}
+}
+
+func TestStorageDriverForCluster(t *testing.T) {
+	pluginConfig := common.NewDefaultPluginConfig()
+	if got, want := pluginConfig.StorageDriverForCluster("default"), agent.StorageDriverSecret; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	pluginConfig.StorageDriver = agent.StorageDriverConfigMap
+	pluginConfig.ClusterStorageDrivers = map[string]string{"other": agent.StorageDriverSQL}
+	if got, want := pluginConfig.StorageDriverForCluster("default"), agent.StorageDriverConfigMap; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := pluginConfig.StorageDriverForCluster("other"), agent.StorageDriverSQL; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestReleaseAdoptionWithConfigMapDriver(t *testing.T) {
+	configMap := releaseConfigMap(t, "my-apache", "default", 1, release.StatusDeployed)
+	server := newServerWithStorageDriver(agent.StorageDriverConfigMap, configMap)
+	adoption := &releaseAdoption{
+		availablePackageRef: &corev1.AvailablePackageReference{
+			Context:    &corev1.Context{Cluster: "default", Namespace: "kubeapps"},
+			Identifier: "bitnami/apache",
+		},
+	}
+
+	err := server.annotateReleaseWithAvailablePackageRef(context.Background(), http.Header{}, "default", "default", "my-apache", 1, adoption)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	got, err := server.getAdoptedAvailablePackageRef(context.Background(), http.Header{}, "default", "default", "my-apache")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got.GetIdentifier() != "bitnami/apache" || got.GetContext().GetNamespace() != "kubeapps" {
+		t.Errorf("got: %+v, want: the adopted bitnami/apache package", got)
+	}
+}
+
+func TestReleaseStorageWithSQLDriver(t *testing.T) {
+	server := newServerWithStorageDriver(agent.StorageDriverSQL)
+
+	adoptions, err := server.getReleaseAdoptions(context.Background(), http.Header{}, "default", "default", "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(adoptions) != 0 {
+		t.Errorf("got: %+v, want: no adoptions", adoptions)
+	}
+
+	err = server.annotateReleaseWithAvailablePackageRef(context.Background(), http.Header{}, "default", "default", "my-apache", 1, &releaseAdoption{})
+	if got, want := connect.CodeOf(err), connect.CodeFailedPrecondition; got != want {
+		t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	_, err = server.watchReleaseStorageObjects(context.Background(), http.Header{}, "default", "default")
+	if got, want := connect.CodeOf(err), connect.CodeFailedPrecondition; got != want {
+		t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
+
+func TestReleaseFromStorageObject(t *testing.T) {
+	testCases := []struct {
+		name   string
+		object interface{}
+		ok     bool
+	}{
+		{
+			name:   "decodes the release of a secret",
+			object: releaseSecret(t, "my-apache", "default", 2, release.StatusDeployed),
+			ok:     true,
+		},
+		{
+			name:   "decodes the release of a configmap",
+			object: releaseConfigMap(t, "my-apache", "default", 2, release.StatusDeployed),
+			ok:     true,
+		},
+		{
+			name:   "ignores other objects",
+			object: &metav1.Status{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rel, _, ok, err := releaseFromStorageObject(tc.object)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := ok, tc.ok; got != want {
+				t.Fatalf("got: %t, want: %t", got, want)
+			}
+			if !tc.ok {
+				return
+			}
+			if rel.Name != "my-apache" || rel.Version != 2 {
+				t.Errorf("got: %s v%d, want: my-apache v2", rel.Name, rel.Version)
+			}
+		})
+	}
+}
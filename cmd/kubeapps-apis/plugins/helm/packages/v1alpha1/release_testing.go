@@ -0,0 +1,191 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corek8sv1 "k8s.io/api/core/v1"
+	log "k8s.io/klog/v2"
+)
+
+// RunInstalledPackageTests runs the tests of an installed package managed by the 'helm' plugin
+func (s *Server) RunInstalledPackageTests(ctx context.Context, request *connect.Request[helmv1.RunInstalledPackageTestsRequest], stream *connect.ServerStream[helmv1.RunInstalledPackageTestsResponse]) error {
+	return s.runInstalledPackageTests(ctx, request.Header(), request.Msg, stream.Send)
+}
+
+// runInstalledPackageTests runs the test hooks of a release one at a time,
+// sending a result when each test starts and another once it completes.
+// A failed test does not prevent the following ones from being run.
+func (s *Server) runInstalledPackageTests(ctx context.Context, headers http.Header, request *helmv1.RunInstalledPackageTestsRequest, send func(*helmv1.RunInstalledPackageTestsResponse) error) error {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	log.InfoS("+helm RunInstalledPackageTests", "cluster", installedRef.GetContext().GetCluster(), "namespace", namespace, "id", releaseName)
+
+	actionConfig, err := s.actionConfigGetter(headers, installedRef.GetContext())
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
+	}
+	rel, err := agent.GetRelease(actionConfig, releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find Helm release %q in namespace %q: %w", releaseName, namespace, err))
+		}
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to run Helm get action: %w", err))
+	}
+
+	testHooks, err := selectTestHooks(agent.GetReleaseTestHooks(rel), request.GetTestNames())
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cluster := installedRef.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	// Recording the test results updates the stored release, which removes
+	// the annotations of an adopted release and its values references.
+	adoption, err := s.getReleaseAdoption(ctx, headers, cluster, rel.Namespace, rel.Name)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the adopted release: %w", err))
+	}
+	if adoption != nil {
+		defer s.keepReleaseAdoption(ctx, headers, cluster, rel.Namespace, rel.Name, rel.Version, adoption)
+	}
+	valuesFrom, hadValuesFrom, err := s.getReleaseValuesFrom(ctx, headers, cluster, rel.Namespace, rel.Name)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the values references of the release: %w", err))
+	}
+	defer s.keepReleaseValuesFrom(ctx, headers, cluster, rel.Namespace, rel.Name, rel.Version, valuesFrom, hadValuesFrom)
+
+	for _, hook := range testHooks {
+		if err := send(&helmv1.RunInstalledPackageTestsResponse{
+			TestResult: &helmv1.InstalledPackageTestResult{
+				Name:      hook.Name,
+				Phase:     helmv1.InstalledPackageTestResult_PHASE_RUNNING,
+				StartedAt: timestamppb.New(time.Now()),
+			},
+		}); err != nil {
+			return err
+		}
+
+		testedRel, testErr := agent.RunReleaseTest(actionConfig, releaseName, hook.Name, s.pluginConfig.TimeoutSeconds)
+		result := &helmv1.InstalledPackageTestResult{
+			Name:  hook.Name,
+			Phase: helmv1.InstalledPackageTestResult_PHASE_FAILED,
+		}
+		if testedRel != nil {
+			for _, h := range testedRel.Hooks {
+				if h.Name == hook.Name {
+					result = testResultFromHook(h)
+					break
+				}
+			}
+		}
+		if testErr != nil {
+			log.Errorf("+helm the test %q of the release %q failed: %v", hook.Name, releaseName, testErr)
+			result.Phase = helmv1.InstalledPackageTestResult_PHASE_FAILED
+			result.ErrorMessage = testErr.Error()
+		}
+		if hook.Kind == "Pod" {
+			result.Logs = s.getTestPodLogs(ctx, headers, cluster, rel.Namespace, hook.Name)
+		}
+
+		if err := send(&helmv1.RunInstalledPackageTestsResponse{
+			TestResult: result,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectTestHooks returns the test hooks with the requested names, or all of
+// them if no name is requested.
+func selectTestHooks(testHooks []*release.Hook, testNames []string) ([]*release.Hook, error) {
+	if len(testNames) == 0 {
+		return testHooks, nil
+	}
+	requested := map[string]bool{}
+	for _, name := range testNames {
+		requested[name] = true
+	}
+	selected := []*release.Hook{}
+	for _, hook := range testHooks {
+		if requested[hook.Name] {
+			selected = append(selected, hook)
+			delete(requested, hook.Name)
+		}
+	}
+	for _, name := range testNames {
+		if requested[name] {
+			return nil, fmt.Errorf("Unable to find the test %q in the release", name)
+		}
+	}
+	return selected, nil
+}
+
+// testResultFromHook returns the result of the last run of a test hook.
+func testResultFromHook(hook *release.Hook) *helmv1.InstalledPackageTestResult {
+	result := &helmv1.InstalledPackageTestResult{
+		Name: hook.Name,
+	}
+	switch hook.LastRun.Phase {
+	case release.HookPhaseRunning:
+		result.Phase = helmv1.InstalledPackageTestResult_PHASE_RUNNING
+	case release.HookPhaseSucceeded:
+		result.Phase = helmv1.InstalledPackageTestResult_PHASE_SUCCEEDED
+	case release.HookPhaseFailed:
+		result.Phase = helmv1.InstalledPackageTestResult_PHASE_FAILED
+	}
+	if !hook.LastRun.StartedAt.IsZero() {
+		result.StartedAt = timestamppb.New(hook.LastRun.StartedAt.Time)
+	}
+	if !hook.LastRun.CompletedAt.IsZero() {
+		result.CompletedAt = timestamppb.New(hook.LastRun.CompletedAt.Time)
+	}
+	return result
+}
+
+// lastTestResults returns the results of the last run of the tests of a
+// release, for those which have been run.
+func lastTestResults(rel *release.Release) []*helmv1.InstalledPackageTestResult {
+	var results []*helmv1.InstalledPackageTestResult
+	for _, hook := range agent.GetReleaseTestHooks(rel) {
+		if hook.LastRun.StartedAt.IsZero() {
+			continue
+		}
+		results = append(results, testResultFromHook(hook))
+	}
+	return results
+}
+
+// getTestPodLogs returns the logs of the pod of a test. As the pod may already
+// have been deleted according to the hook deletion policy, a failure is only
+// logged.
+func (s *Server) getTestPodLogs(ctx context.Context, headers http.Header, cluster, namespace, podName string) string {
+	typedClient, err := s.clientGetter.Typed(headers, cluster)
+	if err != nil {
+		log.Warningf("+helm unable to get the logs of the test pod %q: %v", podName, err)
+		return ""
+	}
+	logs, err := typedClient.CoreV1().Pods(namespace).GetLogs(podName, &corek8sv1.PodLogOptions{}).DoRaw(ctx)
+	if err != nil {
+		log.Warningf("+helm unable to get the logs of the test pod %q in the namespace %q: %v", podName, namespace, err)
+		return ""
+	}
+	return string(logs)
+}
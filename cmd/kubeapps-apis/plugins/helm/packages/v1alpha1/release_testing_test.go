@@ -0,0 +1,178 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// testResultSummary is the part of a test result which does not depend on
+// when the test was run.
+type testResultSummary struct {
+	Name     string
+	Phase    helmv1.InstalledPackageTestResult_Phase
+	Logs     string
+	HasError bool
+}
+
+func summarizeTestResult(result *helmv1.InstalledPackageTestResult) testResultSummary {
+	return testResultSummary{
+		Name:     result.GetName(),
+		Phase:    result.GetPhase(),
+		Logs:     result.GetLogs(),
+		HasError: result.GetErrorMessage() != "",
+	}
+}
+
+func TestRunInstalledPackageTests(t *testing.T) {
+	// The hooks are recorded in place, so each test case uses its own release.
+	existingReleases := func() []releaseStub {
+		return []releaseStub{{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      1,
+			hooks: []*release.Hook{
+				{Name: "my-apache-test-b", Kind: "Pod", Events: []release.HookEvent{release.HookTest}},
+				{Name: "my-apache-job", Kind: "Job", Events: []release.HookEvent{release.HookPostInstall}},
+				{Name: "my-apache-test-a", Kind: "Pod", Events: []release.HookEvent{release.HookTest}},
+			},
+		}}
+	}
+
+	const running = helmv1.InstalledPackageTestResult_PHASE_RUNNING
+	const succeeded = helmv1.InstalledPackageTestResult_PHASE_SUCCEEDED
+	const failed = helmv1.InstalledPackageTestResult_PHASE_FAILED
+
+	testCases := []struct {
+		name                string
+		releaseName         string
+		testNames           []string
+		testError           error
+		expectedResults     []testResultSummary
+		expectedLastResults []testResultSummary
+		expectedStatusCode  connect.Code
+	}{
+		{
+			name:        "it runs all the tests in order, streaming their results",
+			releaseName: "my-apache",
+			expectedResults: []testResultSummary{
+				{Name: "my-apache-test-a", Phase: running},
+				{Name: "my-apache-test-a", Phase: succeeded, Logs: "fake logs"},
+				{Name: "my-apache-test-b", Phase: running},
+				{Name: "my-apache-test-b", Phase: succeeded, Logs: "fake logs"},
+			},
+			expectedLastResults: []testResultSummary{
+				{Name: "my-apache-test-a", Phase: succeeded},
+				{Name: "my-apache-test-b", Phase: succeeded},
+			},
+		},
+		{
+			name:        "it runs the following tests when one fails",
+			releaseName: "my-apache",
+			testError:   errors.New("pod failed"),
+			expectedResults: []testResultSummary{
+				{Name: "my-apache-test-a", Phase: running},
+				{Name: "my-apache-test-a", Phase: failed, Logs: "fake logs", HasError: true},
+				{Name: "my-apache-test-b", Phase: running},
+				{Name: "my-apache-test-b", Phase: failed, Logs: "fake logs", HasError: true},
+			},
+			expectedLastResults: []testResultSummary{
+				{Name: "my-apache-test-a", Phase: failed},
+				{Name: "my-apache-test-b", Phase: failed},
+			},
+		},
+		{
+			name:        "it runs only the requested tests",
+			releaseName: "my-apache",
+			testNames:   []string{"my-apache-test-b"},
+			expectedResults: []testResultSummary{
+				{Name: "my-apache-test-b", Phase: running},
+				{Name: "my-apache-test-b", Phase: succeeded, Logs: "fake logs"},
+			},
+			expectedLastResults: []testResultSummary{
+				{Name: "my-apache-test-b", Phase: succeeded},
+			},
+		},
+		{
+			name:               "it returns invalid argument for an unknown test",
+			releaseName:        "my-apache",
+			testNames:          []string{"my-apache-job"},
+			expectedStatusCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:               "it returns not found for an unknown release",
+			releaseName:        "other-release",
+			expectedStatusCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", existingReleases(), &kubefake.FailingKubeClient{
+				PrintingKubeClient:   kubefake.PrintingKubeClient{Out: io.Discard},
+				WatchUntilReadyError: tc.testError,
+			})
+			server, _, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+
+			results := []testResultSummary{}
+			err := server.runInstalledPackageTests(context.Background(), http.Header{}, &helmv1.RunInstalledPackageTestsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   globalPackagingCluster,
+						Namespace: "default",
+					},
+					Identifier: tc.releaseName,
+				},
+				TestNames: tc.testNames,
+			}, func(response *helmv1.RunInstalledPackageTestsResponse) error {
+				results = append(results, summarizeTestResult(response.GetTestResult()))
+				return nil
+			})
+
+			if got, want := connect.CodeOf(err), tc.expectedStatusCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedStatusCode != 0 {
+				if err == nil {
+					t.Fatalf("got: nil, want: error with code %+v", tc.expectedStatusCode)
+				}
+				return
+			}
+
+			if got, want := results, tc.expectedResults; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			// The results are recorded in the release.
+			rel, err := actionConfig.Releases.Last(tc.releaseName)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			lastResults := []testResultSummary{}
+			for _, result := range lastTestResults(rel) {
+				if result.GetStartedAt() == nil || result.GetCompletedAt() == nil {
+					t.Errorf("got: %+v, want: the times of the last test run", result)
+				}
+				lastResults = append(lastResults, summarizeTestResult(result))
+			}
+			if got, want := lastResults, tc.expectedLastResults; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
@@ -24,7 +24,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
 	log "k8s.io/klog/v2"
 	"k8s.io/utils/strings/slices"
 )
@@ -84,14 +83,14 @@ func (s *Server) newRepo(ctx context.Context, headers http.Header, repo *HelmRep
 		}
 	}
 
-	// Handle imagesPullSecret if any
-	imagePullSecret, imagePullSecretIsKubeappsManaged, err := handleImagesPullSecretForCreate(ctx, typedClient, repo.name, repo.customDetail)
+	// Handle imagesPullSecrets if any
+	imagePullSecrets, err := handleImagesPullSecretsForCreate(ctx, typedClient, repo.name, repo.customDetail)
 	if err != nil {
 		return nil, err
 	}
 
 	// Map data to a repository CRD
-	helmRepoCrd, err := newHelmRepoCrd(repo, secret, imagePullSecret)
+	helmRepoCrd, err := newHelmRepoCrd(repo, secret, imagePullSecrets)
 	if err != nil {
 		return nil, err
 	}
@@ -112,9 +111,11 @@ func (s *Server) newRepo(ctx context.Context, headers http.Header, repo *HelmRep
 			return nil, err
 		}
 	}
-	if imagePullSecretIsKubeappsManaged {
-		if imagePullSecret, err = createKubeappsManagedRepoSecret(ctx, typedClient, repo.name.Namespace, imagePullSecret); err != nil {
-			return nil, err
+	for _, imagePullSecret := range imagePullSecrets {
+		if imagePullSecret.isNew {
+			if imagePullSecret.secret, err = createKubeappsManagedRepoSecret(ctx, typedClient, repo.name.Namespace, imagePullSecret.secret); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -129,9 +130,11 @@ func (s *Server) newRepo(ctx context.Context, headers http.Header, repo *HelmRep
 				return nil, err
 			}
 		}
-		if imagePullSecretIsKubeappsManaged {
-			if err = s.setOwnerReferencesForRepoSecret(ctx, headers, imagePullSecret, repo.cluster, helmRepoCrd); err != nil {
-				return nil, err
+		for _, imagePullSecret := range imagePullSecrets {
+			if imagePullSecret.isNew {
+				if err = s.setOwnerReferencesForRepoSecret(ctx, headers, imagePullSecret.secret, repo.cluster, helmRepoCrd); err != nil {
+					return nil, err
+				}
 			}
 		}
 		return &corev1.PackageRepositoryReference{
@@ -145,7 +148,7 @@ func (s *Server) newRepo(ctx context.Context, headers http.Header, repo *HelmRep
 	}
 }
 
-func newHelmRepoCrd(repo *HelmRepository, secret *k8scorev1.Secret, imagePullSecret *k8scorev1.Secret) (*apprepov1alpha1.AppRepository, error) {
+func newHelmRepoCrd(repo *HelmRepository, secret *k8scorev1.Secret, imagePullSecrets []*repoImagesPullSecret) (*apprepov1alpha1.AppRepository, error) {
 	appRepoCrd := &apprepov1alpha1.AppRepository{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      repo.name.Name,
@@ -172,11 +175,7 @@ func newHelmRepoCrd(repo *HelmRepository, secret *k8scorev1.Secret, imagePullSec
 			appRepoCrd.Spec.Auth = *repoAuth
 		}
 	}
-	if imagePullSecret != nil {
-		appRepoCrd.Spec.DockerRegistrySecrets = []string{imagePullSecret.Name}
-	} else {
-		appRepoCrd.Spec.DockerRegistrySecrets = nil
-	}
+	appRepoCrd.Spec.DockerRegistrySecrets = imagesPullSecretNames(imagePullSecrets)
 	if repo.customDetail != nil {
 		if repo.customDetail.FilterRule != nil {
 			appRepoCrd.Spec.FilterRule = apprepov1alpha1.FilterRuleSpec{
@@ -187,6 +186,11 @@ func newHelmRepoCrd(repo *HelmRepository, secret *k8scorev1.Secret, imagePullSec
 		if repo.customDetail.OciRepositories != nil {
 			appRepoCrd.Spec.OCIRepositories = repo.customDetail.OciRepositories
 		}
+		verification, err := newAppRepositoryVerification(repo.customDetail.ChartVerification)
+		if err != nil {
+			return nil, err
+		}
+		appRepoCrd.Spec.Verification = verification
 		if repo.customDetail.NodeSelector != nil {
 			appRepoCrd.Spec.SyncJobPodTemplate.Spec.NodeSelector = repo.customDetail.NodeSelector
 		}
@@ -240,10 +244,56 @@ func newHelmRepoCrd(repo *HelmRepository, secret *k8scorev1.Secret, imagePullSec
 	return appRepoCrd, nil
 }
 
+// newAppRepositoryVerification returns the verification of the charts of an
+// AppRepository requested in the custom detail, if any.
+func newAppRepositoryVerification(verification *v1alpha1.ChartVerification) (*apprepov1alpha1.AppRepositoryVerification, error) {
+	if verification == nil {
+		return nil, nil
+	}
+	if verification.GetSecretRef() == "" || verification.GetSecretKey() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The secret reference and key are required for the chart verification"))
+	}
+	var policy string
+	switch verification.GetPolicy() {
+	case v1alpha1.ChartVerification_POLICY_UNSPECIFIED, v1alpha1.ChartVerification_POLICY_ENFORCE:
+		policy = apprepov1alpha1.VerificationPolicyEnforce
+	case v1alpha1.ChartVerification_POLICY_WARN:
+		policy = apprepov1alpha1.VerificationPolicyWarn
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unsupported chart verification policy: [%s]", verification.GetPolicy()))
+	}
+	return &apprepov1alpha1.AppRepositoryVerification{
+		Policy: policy,
+		SecretKeyRef: k8scorev1.SecretKeySelector{
+			LocalObjectReference: k8scorev1.LocalObjectReference{
+				Name: verification.GetSecretRef(),
+			},
+			Key: verification.GetSecretKey(),
+		},
+	}, nil
+}
+
+// newChartVerification returns the custom detail of the verification of the
+// charts of an AppRepository, if any.
+func newChartVerification(verification *apprepov1alpha1.AppRepositoryVerification) *v1alpha1.ChartVerification {
+	if verification == nil {
+		return nil
+	}
+	policy := v1alpha1.ChartVerification_POLICY_ENFORCE
+	if verification.Policy == apprepov1alpha1.VerificationPolicyWarn {
+		policy = v1alpha1.ChartVerification_POLICY_WARN
+	}
+	return &v1alpha1.ChartVerification{
+		Policy:    policy,
+		SecretRef: verification.SecretKeyRef.Name,
+		SecretKey: verification.SecretKeyRef.Key,
+	}
+}
+
 func (s *Server) mapToPackageRepositoryDetail(source *apprepov1alpha1.AppRepository,
 	cluster, namespace string,
 	caSecret *k8scorev1.Secret, authSecret *k8scorev1.Secret,
-	imagesPullSecret *k8scorev1.Secret) (*corev1.PackageRepositoryDetail, error) {
+	imagesPullSecrets []*k8scorev1.Secret) (*corev1.PackageRepositoryDetail, error) {
 
 	// Auth
 	tlsConfig, auth, err := getRepoTlsConfigAndAuth(source, caSecret, authSecret)
@@ -276,10 +326,13 @@ func (s *Server) mapToPackageRepositoryDetail(source *apprepov1alpha1.AppReposit
 	}
 
 	// Custom details
-	if source.Spec.DockerRegistrySecrets != nil || source.Spec.FilterRule.JQ != "" || source.Spec.OCIRepositories != nil {
+	if source.Spec.DockerRegistrySecrets != nil || source.Spec.FilterRule.JQ != "" || source.Spec.OCIRepositories != nil || source.Spec.Verification != nil {
 		var customDetail = &v1alpha1.HelmPackageRepositoryCustomDetail{}
 
-		customDetail.ImagesPullSecret = getRepoImagesPullSecret(source, imagesPullSecret)
+		customDetail.ImagesPullSecrets = getRepoImagesPullSecrets(source, imagesPullSecrets)
+		if len(customDetail.ImagesPullSecrets) > 0 {
+			customDetail.ImagesPullSecret = customDetail.ImagesPullSecrets[0]
+		}
 		if source.Spec.FilterRule.JQ != "" {
 			customDetail.FilterRule = &v1alpha1.RepositoryFilterRule{
 				Jq:        source.Spec.FilterRule.JQ,
@@ -287,6 +340,7 @@ func (s *Server) mapToPackageRepositoryDetail(source *apprepov1alpha1.AppReposit
 			}
 		}
 		customDetail.OciRepositories = source.Spec.OCIRepositories
+		customDetail.ChartVerification = newChartVerification(source.Spec.Verification)
 		target.CustomDetail, err = anypb.New(customDetail)
 		if err != nil {
 			return nil, err
@@ -338,7 +392,7 @@ func (s *Server) updateRepo(ctx context.Context,
 	appRepo *apprepov1alpha1.AppRepository,
 	caSecret *k8scorev1.Secret,
 	authSecret *k8scorev1.Secret,
-	imagePullSecret *k8scorev1.Secret,
+	imagePullSecrets []*k8scorev1.Secret,
 	repo *HelmRepository) (*corev1.PackageRepositoryReference, error) {
 	if repo.url == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Repository url may not be empty"))
@@ -379,8 +433,8 @@ func (s *Server) updateRepo(ctx context.Context,
 		}
 	}
 
-	// Handle imagesPullSecret if any
-	imagePullSecret, imagePullSecretIsKubeappsManaged, imagePullSecretIsUpdated, err := handleImagesPullSecretForUpdate(ctx, typedClient, appRepo, repo.customDetail, imagePullSecret)
+	// Handle imagesPullSecrets if any
+	updatedImagePullSecrets, err := handleImagesPullSecretsForUpdate(ctx, typedClient, appRepo, repo.customDetail, imagePullSecrets)
 	if err != nil {
 		return nil, err
 	}
@@ -403,13 +457,7 @@ func (s *Server) updateRepo(ctx context.Context,
 
 	appRepo.Spec.Interval = repo.interval
 
-	if imagePullSecretIsUpdated {
-		if imagePullSecret != nil {
-			appRepo.Spec.DockerRegistrySecrets = []string{imagePullSecret.Name}
-		} else {
-			appRepo.Spec.DockerRegistrySecrets = nil
-		}
-	}
+	appRepo.Spec.DockerRegistrySecrets = imagesPullSecretNames(updatedImagePullSecrets)
 
 	// Custom details
 	if repo.customDetail != nil {
@@ -422,6 +470,10 @@ func (s *Server) updateRepo(ctx context.Context,
 			appRepo.Spec.FilterRule = apprepov1alpha1.FilterRuleSpec{}
 		}
 		appRepo.Spec.OCIRepositories = repo.customDetail.OciRepositories
+		appRepo.Spec.Verification, err = newAppRepositoryVerification(repo.customDetail.ChartVerification)
+		if err != nil {
+			return nil, err
+		}
 		appRepo.Spec.SyncJobPodTemplate.Spec.NodeSelector = repo.customDetail.NodeSelector
 
 		if repo.customDetail.Tolerations != nil {
@@ -474,6 +526,7 @@ func (s *Server) updateRepo(ctx context.Context,
 		appRepo.Spec.DockerRegistrySecrets = nil
 		appRepo.Spec.OCIRepositories = nil
 		appRepo.Spec.FilterRule = apprepov1alpha1.FilterRuleSpec{}
+		appRepo.Spec.Verification = nil
 	}
 
 	// store secrets if kubeapps managed (see newRepo, this is required in order to handle owner references)
@@ -482,9 +535,11 @@ func (s *Server) updateRepo(ctx context.Context,
 			return nil, err
 		}
 	}
-	if imagePullSecretIsKubeappsManaged {
-		if imagePullSecret, err = createKubeappsManagedRepoSecret(ctx, typedClient, repo.name.Namespace, imagePullSecret); err != nil {
-			return nil, err
+	for _, imagePullSecret := range updatedImagePullSecrets {
+		if imagePullSecret.isNew {
+			if imagePullSecret.secret, err = createKubeappsManagedRepoSecret(ctx, typedClient, repo.name.Namespace, imagePullSecret.secret); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -500,8 +555,11 @@ func (s *Server) updateRepo(ctx context.Context,
 			return nil, err
 		}
 	}
-	if imagePullSecretIsKubeappsManaged {
-		if err = s.setOwnerReferencesForRepoSecret(ctx, headers, imagePullSecret, repo.cluster, appRepo); err != nil {
+	for _, imagePullSecret := range updatedImagePullSecrets {
+		if !imagePullSecret.isNew {
+			continue
+		}
+		if err = s.setOwnerReferencesForRepoSecret(ctx, headers, imagePullSecret.secret, repo.cluster, appRepo); err != nil {
 			return nil, err
 		}
 	}
@@ -587,18 +645,10 @@ func (s *Server) GetPkgRepositories(ctx context.Context, headers http.Header, cl
 
 // getAccessiblePackageRepositories gather list of repositories to which the user has access per namespace
 func (s *Server) getAccessiblePackageRepositories(ctx context.Context, headers http.Header, cluster string) ([]*apprepov1alpha1.AppRepository, error) {
-	clusterTypedClientFunc := func() (kubernetes.Interface, error) {
-		return s.clientGetter.Typed(headers, cluster)
-	}
-	inClusterTypedClientFunc := func() (kubernetes.Interface, error) {
-		return s.localServiceAccountClientGetter.Typed(context.Background())
-	}
-
-	namespaceList, err := resources.FindAccessibleNamespaces(clusterTypedClientFunc, inClusterTypedClientFunc, s.MaxWorkers())
+	namespaceList, err := s.getAccessibleNamespaces(headers, cluster)
 	if err != nil {
 		return nil, err
 	}
-	namespaceList = resources.FilterActiveNamespaces(namespaceList)
 	var accessibleRepos []*apprepov1alpha1.AppRepository
 	for _, ns := range namespaceList {
 		nsRepos, err := s.GetPkgRepositories(ctx, headers, cluster, ns.Name)
@@ -76,25 +76,73 @@ func handleAuthSecretForCreate(
 	}
 }
 
-func handleImagesPullSecretForCreate(
+// repoImagesPullSecret is one of the images pull secrets of a repository.
+type repoImagesPullSecret struct {
+	secret *k8scorev1.Secret
+	// kubeappsManaged is set for a secret created by Kubeapps from user
+	// provided credentials, rather than referenced by the user
+	kubeappsManaged bool
+	// isNew is set for a kubeapps-managed secret which has yet to be created
+	isNew bool
+}
+
+// imagesPullSecretNames returns the names of the images pull secrets, as
+// stored in the docker registry secrets of an AppRepository.
+func imagesPullSecretNames(secrets []*repoImagesPullSecret) []string {
+	var names []string
+	for _, secret := range secrets {
+		names = append(names, secret.secret.GetName())
+	}
+	return names
+}
+
+// requestedImagesPullSecrets returns the images pull secrets requested for a
+// repository, with the deprecated single images pull secret as a fallback.
+func requestedImagesPullSecrets(customDetail *v1alpha1.HelmPackageRepositoryCustomDetail) []*v1alpha1.ImagesPullSecret {
+	if customDetail == nil {
+		return nil
+	}
+	if len(customDetail.ImagesPullSecrets) > 0 {
+		return customDetail.ImagesPullSecrets
+	}
+	if customDetail.ImagesPullSecret != nil {
+		return []*v1alpha1.ImagesPullSecret{customDetail.ImagesPullSecret}
+	}
+	return nil
+}
+
+func handleImagesPullSecretsForCreate(
 	ctx context.Context,
 	typedClient kubernetes.Interface,
 	repoName types.NamespacedName,
-	customDetail *v1alpha1.HelmPackageRepositoryCustomDetail) (*k8scorev1.Secret, bool, error) {
-
-	hasRef := customDetail != nil && customDetail.ImagesPullSecret != nil && customDetail.ImagesPullSecret.GetSecretRef() != ""
-	hasData := customDetail != nil && customDetail.ImagesPullSecret != nil && customDetail.ImagesPullSecret.GetCredentials() != nil
-
-	// create/get secret
-	if hasRef {
-		secret, err := validateDockerImagePullSecret(ctx, typedClient, repoName.Namespace, customDetail.ImagesPullSecret.GetSecretRef())
-		return secret, false, err
-	} else if hasData {
-		secret, _, err := newDockerImagePullSecret(repoName.Name, nil, customDetail.ImagesPullSecret.GetCredentials())
-		return secret, true, err
-	} else {
-		return nil, false, nil
+	customDetail *v1alpha1.HelmPackageRepositoryCustomDetail) ([]*repoImagesPullSecret, error) {
+
+	secrets := []*repoImagesPullSecret{}
+	for i, imagesPullSecret := range requestedImagesPullSecrets(customDetail) {
+		hasRef := imagesPullSecret.GetSecretRef() != ""
+		hasData := imagesPullSecret.GetCredentials() != nil
+
+		// create/get secret
+		if hasRef {
+			secret, err := validateDockerImagePullSecret(ctx, typedClient, repoName.Namespace, imagesPullSecret.GetSecretRef())
+			if err != nil {
+				return nil, err
+			}
+			secrets = append(secrets, &repoImagesPullSecret{secret: secret})
+		} else if hasData {
+			secret, _, err := newDockerImagePullSecret(imagesPullSecretNameForIndex(repoName.Name, i), nil, imagesPullSecret.GetCredentials())
+			if err != nil {
+				return nil, err
+			}
+			secrets = append(secrets, &repoImagesPullSecret{secret: secret, kubeappsManaged: true, isNew: true})
+		} else {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Images pull secret %d has neither a secret reference nor credentials", i))
+		}
+	}
+	if err := validateImagesPullSecretRegistries(secrets); err != nil {
+		return nil, err
 	}
+	return secrets, nil
 }
 
 func handleAuthSecretForUpdate(
@@ -159,65 +207,187 @@ func handleAuthSecretForUpdate(
 	}
 }
 
-func handleImagesPullSecretForUpdate(
+// handleImagesPullSecretsForUpdate returns the images pull secrets of an
+// updated repository, given its existing ones. The requested credentials are
+// matched to the existing kubeapps-managed secrets by identity rather than by
+// position, so that removing or reordering them keeps the right secrets. The
+// kubeapps-managed secrets which are replaced or no longer used are deleted.
+func handleImagesPullSecretsForUpdate(
 	ctx context.Context,
 	typedClient kubernetes.Interface,
 	repo *apprepov1alpha1.AppRepository,
 	customDetail *v1alpha1.HelmPackageRepositoryCustomDetail,
-	secret *k8scorev1.Secret) (updatedSecret *k8scorev1.Secret, secretIsKubeappsManaged bool, secretIsUpdated bool, err error) {
+	existingSecrets []*k8scorev1.Secret) ([]*repoImagesPullSecret, error) {
 
-	var imagesPullSecrets *v1alpha1.ImagesPullSecret
-	if customDetail != nil && customDetail.ImagesPullSecret != nil {
-		imagesPullSecrets = customDetail.ImagesPullSecret
-	} else {
-		imagesPullSecrets = &v1alpha1.ImagesPullSecret{}
+	existingByName := map[string]*k8scorev1.Secret{}
+	managedSecrets := []*k8scorev1.Secret{}
+	for _, existingSecret := range existingSecrets {
+		if existingSecret == nil {
+			continue
+		}
+		existingByName[existingSecret.GetName()] = existingSecret
+		if isImagesPullSecretKubeappsManaged(repo, existingSecret) {
+			managedSecrets = append(managedSecrets, existingSecret)
+		}
 	}
 
-	hasRef := imagesPullSecrets != nil && imagesPullSecrets.GetSecretRef() != ""
-	hasData := imagesPullSecrets != nil && imagesPullSecrets.GetCredentials() != nil
+	requested := requestedImagesPullSecrets(customDetail)
+	// the names of the existing secrets still in use, or replaced
+	claimed := map[string]bool{}
+	usedNames := map[string]bool{}
+	secrets := []*repoImagesPullSecret{}
+	for i, imagesPullSecret := range requested {
+		hasRef := imagesPullSecret.GetSecretRef() != ""
+		hasData := imagesPullSecret.GetCredentials() != nil
+
+		if hasRef {
+			// handle user managed secret
+			if existingSecret, ok := existingByName[imagesPullSecret.GetSecretRef()]; ok && isImagesPullSecretKubeappsManaged(repo, existingSecret) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Auth management mode cannot be changed"))
+			}
+			secret, err := validateDockerImagePullSecret(ctx, typedClient, repo.GetNamespace(), imagesPullSecret.GetSecretRef())
+			if err != nil {
+				return nil, err
+			}
+			claimed[secret.GetName()] = true
+			secrets = append(secrets, &repoImagesPullSecret{secret: secret})
+
+		} else if hasData {
+			// handle kubeapps managed secret
+			existingSecret, err := matchManagedImagesPullSecret(repo, imagesPullSecret, existingByName, managedSecrets)
+			if err != nil {
+				return nil, err
+			}
+			if existingSecret != nil {
+				if claimed[existingSecret.GetName()] {
+					return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Images pull secret %q is referenced more than once", existingSecret.GetName()))
+				}
+				claimed[existingSecret.GetName()] = true
+			}
 
-	// check we are not changing mode
-	if secret != nil && (hasRef || hasData) {
-		if isImagesPullSecretKubeappsManaged(repo, secret) != hasData {
-			return nil, false, false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Auth management mode cannot be changed"))
+			secretName := ""
+			if existingSecret != nil {
+				secretName = existingSecret.GetName()
+			} else {
+				secretName = newImagesPullSecretName(repo.GetName(), i, existingByName, usedNames)
+			}
+			usedNames[secretName] = true
+
+			secret, isSameSecret, err := newDockerImagePullSecret(secretName, existingSecret, imagesPullSecret.GetCredentials())
+			if err != nil {
+				return nil, err
+			} else if isSameSecret {
+				// Keep the existing secret if repo auth data came fully redacted
+				secrets = append(secrets, &repoImagesPullSecret{secret: existingSecret, kubeappsManaged: true})
+			} else {
+				// secret has changed, we try to delete any existing secret
+				if existingSecret != nil {
+					secretsInterface := typedClient.CoreV1().Secrets(existingSecret.GetNamespace())
+					if err := deleteSecret(ctx, secretsInterface, existingSecret.GetName()); err != nil {
+						log.Errorf("Error deleting existing secret: [%s] due to %v", existingSecret.GetName(), err)
+					}
+				}
+				secrets = append(secrets, &repoImagesPullSecret{secret: secret, kubeappsManaged: true, isNew: true})
+			}
+
+		} else {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Images pull secret %d has neither a secret reference nor credentials", i))
 		}
 	}
+	if err := validateImagesPullSecretRegistries(secrets); err != nil {
+		return nil, err
+	}
 
-	// create/get secret
-	if hasRef {
-		// handle user managed secret
-		updatedSecret, err := validateDockerImagePullSecret(ctx, typedClient, repo.GetNamespace(), imagesPullSecrets.GetSecretRef())
-		return updatedSecret, false, true, err
+	// delete the kubeapps managed secrets no longer used
+	for _, existingSecret := range managedSecrets {
+		if claimed[existingSecret.GetName()] {
+			continue
+		}
+		secretsInterface := typedClient.CoreV1().Secrets(existingSecret.GetNamespace())
+		if err := deleteSecret(ctx, secretsInterface, existingSecret.GetName()); err != nil {
+			log.Errorf("Error deleting existing secret: [%s] due to %v", existingSecret.GetName(), err)
+		}
+	}
+	return secrets, nil
+}
 
-	} else if hasData {
-		// handle kubeapps managed secret
-		updatedSecret, isSameSecret, err := newDockerImagePullSecret(repo.GetName(), secret, imagesPullSecrets.GetCredentials())
-		if err != nil {
-			return nil, true, false, err
-		} else if isSameSecret {
-			// Do nothing if repo auth data came fully redacted
-			return nil, true, false, nil
-		} else {
-			// secret has changed, we try to delete any existing secret
-			if secret != nil {
-				secretsInterface := typedClient.CoreV1().Secrets(secret.GetNamespace())
-				if err := deleteSecret(ctx, secretsInterface, secret.GetName()); err != nil {
-					log.Errorf("Error deleting existing secret: [%s] due to %v", secret.GetName(), err)
-				}
+// matchManagedImagesPullSecret returns the existing kubeapps-managed secret of
+// the requested credentials, if any: the one named by the request, else the
+// one for the same registry server or, for credentials with a redacted server,
+// the only kubeapps-managed secret of the repository. Redacted credentials
+// which match no existing secret are rejected.
+func matchManagedImagesPullSecret(
+	repo *apprepov1alpha1.AppRepository,
+	imagesPullSecret *v1alpha1.ImagesPullSecret,
+	existingByName map[string]*k8scorev1.Secret,
+	managedSecrets []*k8scorev1.Secret) (*k8scorev1.Secret, error) {
+
+	creds := imagesPullSecret.GetCredentials()
+	if name := imagesPullSecret.GetSecretName(); name != "" {
+		existingSecret, ok := existingByName[name]
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Images pull secret %q is not a secret of the repository", name))
+		} else if !isImagesPullSecretKubeappsManaged(repo, existingSecret) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Auth management mode cannot be changed"))
+		}
+		return existingSecret, nil
+	}
+
+	if creds.GetServer() != RedactedString {
+		for _, existingSecret := range managedSecrets {
+			if existingCreds, err := decodeDockerAuth(existingSecret.Data[DockerConfigJsonKey]); err == nil && existingCreds.Server == creds.GetServer() {
+				return existingSecret, nil
 			}
-			return updatedSecret, true, true, nil
 		}
+	} else if len(managedSecrets) == 1 {
+		return managedSecrets[0], nil
+	}
 
-	} else {
-		// no image pull secret, delete existing secret if necessary
-		if secret != nil {
-			secretsInterface := typedClient.CoreV1().Secrets(secret.GetNamespace())
-			if err := deleteSecret(ctx, secretsInterface, secret.GetName()); err != nil {
-				log.Errorf("Error deleting existing secret: [%s] due to %v", secret.GetName(), err)
+	if hasRedactedDockerCredentials(creds) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Invalid configuration, unexpected REDACTED content in credentials matching no existing images pull secret"))
+	}
+	return nil, nil
+}
+
+func hasRedactedDockerCredentials(creds *corev1.DockerCredentials) bool {
+	return creds.GetServer() == RedactedString || creds.GetUsername() == RedactedString || creds.GetPassword() == RedactedString || creds.GetEmail() == RedactedString
+}
+
+// newImagesPullSecretName returns a name for a new kubeapps-managed images pull
+// secret which is not used by an existing or another new secret.
+func newImagesPullSecretName(repoName string, index int, existingByName map[string]*k8scorev1.Secret, usedNames map[string]bool) string {
+	for ; ; index++ {
+		name := imagesPullSecretNameForIndex(repoName, index)
+		if _, ok := existingByName[name]; !ok && !usedNames[name] {
+			return name
+		}
+	}
+}
+
+// validateImagesPullSecretRegistries checks that the images pull secrets of a
+// repository are distinct and that each registry has credentials in one of them
+// only, as the secret used for the images of a registry would be ambiguous otherwise.
+func validateImagesPullSecretRegistries(secrets []*repoImagesPullSecret) error {
+	secretNames := map[string]bool{}
+	secretsPerRegistry := map[string]string{}
+	for _, imagesPullSecret := range secrets {
+		if secretNames[imagesPullSecret.secret.GetName()] {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Images pull secret %q is referenced more than once", imagesPullSecret.secret.GetName()))
+		}
+		secretNames[imagesPullSecret.secret.GetName()] = true
+
+		dockerConfigJSON := kube.DockerConfigJSON{}
+		if err := json.Unmarshal(imagesPullSecret.secret.Data[k8scorev1.DockerConfigJsonKey], &dockerConfigJSON); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Images pull secret %q does not have valid data", imagesPullSecret.secret.GetName()))
+		}
+		for registry := range dockerConfigJSON.Auths {
+			if other, ok := secretsPerRegistry[registry]; ok {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Registry %q has credentials in both images pull secrets %q and %q", registry, other, imagesPullSecret.secret.GetName()))
 			}
+			secretsPerRegistry[registry] = imagesPullSecret.secret.GetName()
 		}
-		return nil, false, true, nil
 	}
+	return nil
 }
 
 // this func is only used with kubeapps-managed secrets
@@ -476,7 +646,17 @@ func imagesPullSecretName(repoName string) string {
 	return fmt.Sprintf("pullsecret-%s", repoName)
 }
 
-func newDockerImagePullSecret(repoName string, existingSecret *k8scorev1.Secret, creds *corev1.DockerCredentials) (secret *k8scorev1.Secret, isSameSecret bool, err error) {
+// imagesPullSecretNameForIndex returns the name of the kubeapps-managed secret
+// of the images pull secret at the given index of the repository. The first
+// one keeps the name used when repositories had a single images pull secret.
+func imagesPullSecretNameForIndex(repoName string, index int) string {
+	if index == 0 {
+		return imagesPullSecretName(repoName)
+	}
+	return fmt.Sprintf("%s-%d", imagesPullSecretName(repoName), index)
+}
+
+func newDockerImagePullSecret(secretName string, existingSecret *k8scorev1.Secret, creds *corev1.DockerCredentials) (secret *k8scorev1.Secret, isSameSecret bool, err error) {
 	if creds.Server == "" || creds.Username == "" || creds.Password == "" {
 		return nil, false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Docker credentials are missing"))
 	}
@@ -486,7 +666,7 @@ func newDockerImagePullSecret(repoName string, existingSecret *k8scorev1.Secret,
 
 	secret = &k8scorev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        imagesPullSecretName(repoName),
+			Name:        secretName,
 			Annotations: map[string]string{Annotation_ManagedBy_Key: Annotation_ManagedBy_Value},
 		},
 		Type: k8scorev1.SecretTypeDockerConfigJson,
@@ -643,6 +823,14 @@ func validateUserManagedRepoSecret(
 	return secret, nil
 }
 
+func getRepoImagesPullSecrets(source *apprepov1alpha1.AppRepository, imagesPullSecrets []*k8scorev1.Secret) []*v1alpha1.ImagesPullSecret {
+	var result []*v1alpha1.ImagesPullSecret
+	for _, imagesPullSecret := range imagesPullSecrets {
+		result = append(result, getRepoImagesPullSecret(source, imagesPullSecret))
+	}
+	return result
+}
+
 func getRepoImagesPullSecret(source *apprepov1alpha1.AppRepository, imagesPullSecret *k8scorev1.Secret) *v1alpha1.ImagesPullSecret {
 	if imagesPullSecret == nil {
 		return nil
@@ -656,6 +844,7 @@ func getRepoImagesPullSecret(source *apprepov1alpha1.AppRepository, imagesPullSe
 					Email:    RedactedString,
 				},
 			},
+			SecretName: imagesPullSecret.Name,
 		}
 	} else {
 		return &v1alpha1.ImagesPullSecret{
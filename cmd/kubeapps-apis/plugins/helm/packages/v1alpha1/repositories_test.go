@@ -579,6 +579,48 @@ func TestAddPackageRepository(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "create repository with chart verification",
+			request: newPackageRepoRequestWithDetails(&v1alpha1.HelmPackageRepositoryCustomDetail{
+				ChartVerification: &v1alpha1.ChartVerification{
+					Policy:    v1alpha1.ChartVerification_POLICY_WARN,
+					SecretRef: "keyring",
+					SecretKey: "pubring.gpg",
+				},
+			}),
+			expectedResponse: addRepoExpectedResp,
+			expectedRepo: &appRepov1alpha1.AppRepository{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       AppRepositoryKind,
+					APIVersion: AppRepositoryApi,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "bar",
+					Namespace:       "foo",
+					ResourceVersion: "1",
+				},
+				Spec: appRepov1alpha1.AppRepositorySpec{
+					URL:  "https://example.com",
+					Type: "helm",
+					Verification: &appRepov1alpha1.AppRepositoryVerification{
+						Policy: appRepov1alpha1.VerificationPolicyWarn,
+						SecretKeyRef: apiv1.SecretKeySelector{
+							LocalObjectReference: apiv1.LocalObjectReference{Name: "keyring"},
+							Key:                  "pubring.gpg",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "create repository fails with chart verification missing the secret key",
+			request: newPackageRepoRequestWithDetails(&v1alpha1.HelmPackageRepositoryCustomDetail{
+				ChartVerification: &v1alpha1.ChartVerification{
+					SecretRef: "keyring",
+				},
+			}),
+			errorCode: connect.CodeInvalidArgument,
+		},
 		{
 			name: "[user managed secrets] create repository fails with non existing images pull secret",
 			request: newPackageRepoRequestWithDetails(&v1alpha1.HelmPackageRepositoryCustomDetail{
@@ -870,6 +912,328 @@ func TestAddPackageRepository(t *testing.T) {
 	}
 }
 
+func TestPackageRepositoryWithMultipleImagesPullSecrets(t *testing.T) {
+	userDockerSecret := newAuthDockerSecret("user-docker", "foo",
+		dockerAuthJson("https://user.example.com", "username", "password", "foo@bar.com", "dXNlcm5hbWU6cGFzc3dvcmQ="))
+	repoRef := &corev1.PackageRepositoryReference{
+		Plugin:     &pluginDetail,
+		Context:    &corev1.Context{Namespace: "foo", Cluster: KubeappsCluster},
+		Identifier: "bar",
+	}
+	credentials := func(server string) *v1alpha1.ImagesPullSecret {
+		return &v1alpha1.ImagesPullSecret{
+			DockerRegistryCredentialOneOf: &v1alpha1.ImagesPullSecret_Credentials{
+				Credentials: &corev1.DockerCredentials{
+					Server:   server,
+					Username: "username",
+					Password: "password",
+					Email:    "foo@bar.com",
+				},
+			},
+		}
+	}
+	secretRef := &v1alpha1.ImagesPullSecret{
+		DockerRegistryCredentialOneOf: &v1alpha1.ImagesPullSecret_SecretRef{
+			SecretRef: "user-docker",
+		},
+	}
+	addRequest := func(imagesPullSecrets ...*v1alpha1.ImagesPullSecret) *corev1.AddPackageRepositoryRequest {
+		return &corev1.AddPackageRepositoryRequest{
+			Name:            "bar",
+			Context:         &corev1.Context{Namespace: "foo", Cluster: KubeappsCluster},
+			Type:            "helm",
+			Url:             "https://example.com",
+			NamespaceScoped: true,
+			CustomDetail: toProtoBufAny(&v1alpha1.HelmPackageRepositoryCustomDetail{
+				ImagesPullSecrets: imagesPullSecrets,
+			}),
+		}
+	}
+
+	t.Run("create fails", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			request   *corev1.AddPackageRepositoryRequest
+			errorCode connect.Code
+		}{
+			{
+				name:      "with two secrets for the same registry",
+				request:   addRequest(secretRef, credentials("https://user.example.com")),
+				errorCode: connect.CodeInvalidArgument,
+			},
+			{
+				name:      "with an empty images pull secret",
+				request:   addRequest(secretRef, &v1alpha1.ImagesPullSecret{}),
+				errorCode: connect.CodeInvalidArgument,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				s := newServerWithSecretsAndRepos(t, []k8sruntime.Object{userDockerSecret.DeepCopy()}, nil)
+				_, err := s.AddPackageRepository(context.Background(), connect.NewRequest(tc.request))
+				if got, want := connect.CodeOf(err), tc.errorCode; err == nil || got != want {
+					t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				}
+			})
+		}
+	})
+
+	t.Run("create", func(t *testing.T) {
+		s := newServerWithSecretsAndRepos(t, []k8sruntime.Object{userDockerSecret.DeepCopy()}, nil)
+		ctx := context.Background()
+
+		_, err := s.AddPackageRepository(ctx, connect.NewRequest(addRequest(secretRef, credentials("https://myfooserver.com"))))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+
+		ctrlClient, err := s.clientGetter.ControllerRuntime(http.Header{}, s.kubeappsCluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var appRepo appRepov1alpha1.AppRepository
+		if err = ctrlClient.Get(ctx, types.NamespacedName{Namespace: "foo", Name: "bar"}, &appRepo); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := appRepo.Spec.DockerRegistrySecrets, []string{"user-docker", "pullsecret-bar-1"}; !cmp.Equal(want, got) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+		}
+		typedClient, err := s.clientGetter.Typed(http.Header{}, s.kubeappsCluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkSecrets(t, ctx, typedClient, "foo", "pullsecret-bar-1", setSecretAnnotations(setSecretOwnerRef("bar",
+			newAuthDockerSecret("pullsecret-bar-1", "foo",
+				dockerAuthJson("https://myfooserver.com", "username", "password", "foo@bar.com", "dXNlcm5hbWU6cGFzc3dvcmQ=")))))
+	})
+
+	t.Run("get and update", func(t *testing.T) {
+		existingRepo := &appRepov1alpha1.AppRepository{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       AppRepositoryKind,
+				APIVersion: AppRepositoryApi,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "bar",
+				Namespace:       "foo",
+				ResourceVersion: "1",
+			},
+			Spec: appRepov1alpha1.AppRepositorySpec{
+				URL:                   "https://example.com",
+				Type:                  "helm",
+				DockerRegistrySecrets: []string{"user-docker", "pullsecret-bar-1"},
+			},
+		}
+		managedDockerSecret := setSecretAnnotations(setSecretOwnerRef("bar", newAuthDockerSecret("pullsecret-bar-1", "foo",
+			dockerAuthJson("https://myfooserver.com", "username", "password", "foo@bar.com", "dXNlcm5hbWU6cGFzc3dvcmQ="))))
+		s := newServerWithSecretsAndRepos(t, []k8sruntime.Object{userDockerSecret.DeepCopy(), managedDockerSecret}, []*appRepov1alpha1.AppRepository{existingRepo})
+		ctx := context.Background()
+
+		detail, err := s.GetPackageRepositoryDetail(ctx, connect.NewRequest(&corev1.GetPackageRepositoryDetailRequest{PackageRepoRef: repoRef}))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		customDetail := &v1alpha1.HelmPackageRepositoryCustomDetail{}
+		if err := detail.Msg.GetDetail().GetCustomDetail().UnmarshalTo(customDetail); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := len(customDetail.GetImagesPullSecrets()), 2; got != want {
+			t.Fatalf("got: %d, want: %d", got, want)
+		}
+		if got, want := customDetail.GetImagesPullSecrets()[0].GetSecretRef(), "user-docker"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if got, want := customDetail.GetImagesPullSecrets()[1].GetCredentials().GetServer(), RedactedString; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if got, want := customDetail.GetImagesPullSecret().GetSecretRef(), "user-docker"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+
+		_, err = s.UpdatePackageRepository(ctx, connect.NewRequest(&corev1.UpdatePackageRepositoryRequest{
+			PackageRepoRef: repoRef,
+			Url:            "https://example.com",
+			CustomDetail: toProtoBufAny(&v1alpha1.HelmPackageRepositoryCustomDetail{
+				ImagesPullSecrets: []*v1alpha1.ImagesPullSecret{secretRef},
+			}),
+		}))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+
+		appRepo, _, _, err := s.getPkgRepository(ctx, http.Header{}, KubeappsCluster, "foo", "bar")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := appRepo.Spec.DockerRegistrySecrets, []string{"user-docker"}; !cmp.Equal(want, got) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+		}
+		// The secret managed by Kubeapps is deleted, the user one is kept.
+		typedClient, err := s.clientGetter.Typed(http.Header{}, s.kubeappsCluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := typedClient.CoreV1().Secrets("foo").Get(ctx, "pullsecret-bar-1", metav1.GetOptions{}); !k8sErrors.IsNotFound(err) {
+			t.Errorf("got: %+v, want: not found", err)
+		}
+		if _, err := typedClient.CoreV1().Secrets("foo").Get(ctx, "user-docker", metav1.GetOptions{}); err != nil {
+			t.Errorf("%+v", err)
+		}
+	})
+
+	redacted := func(secretName string) *v1alpha1.ImagesPullSecret {
+		return &v1alpha1.ImagesPullSecret{
+			DockerRegistryCredentialOneOf: &v1alpha1.ImagesPullSecret_Credentials{
+				Credentials: &corev1.DockerCredentials{
+					Server:   RedactedString,
+					Username: RedactedString,
+					Password: RedactedString,
+					Email:    RedactedString,
+				},
+			},
+			SecretName: secretName,
+		}
+	}
+	managedRepoAndSecrets := func() (*appRepov1alpha1.AppRepository, []k8sruntime.Object) {
+		repo := &appRepov1alpha1.AppRepository{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       AppRepositoryKind,
+				APIVersion: AppRepositoryApi,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "bar",
+				Namespace:       "foo",
+				ResourceVersion: "1",
+			},
+			Spec: appRepov1alpha1.AppRepositorySpec{
+				URL:                   "https://example.com",
+				Type:                  "helm",
+				DockerRegistrySecrets: []string{"pullsecret-bar", "pullsecret-bar-1"},
+			},
+		}
+		secrets := []k8sruntime.Object{
+			setSecretAnnotations(setSecretOwnerRef("bar", newAuthDockerSecret("pullsecret-bar", "foo",
+				dockerAuthJson("https://first.example.com", "username", "password", "foo@bar.com", "dXNlcm5hbWU6cGFzc3dvcmQ=")))),
+			setSecretAnnotations(setSecretOwnerRef("bar", newAuthDockerSecret("pullsecret-bar-1", "foo",
+				dockerAuthJson("https://second.example.com", "username", "password", "foo@bar.com", "dXNlcm5hbWU6cGFzc3dvcmQ=")))),
+		}
+		return repo, secrets
+	}
+
+	t.Run("update removes and reorders kubeapps managed secrets", func(t *testing.T) {
+		changedCredentials := credentials("https://first.example.com")
+		changedCredentials.GetCredentials().Password = "changed"
+		testCases := []struct {
+			name              string
+			imagesPullSecrets []*v1alpha1.ImagesPullSecret
+			expectedNames     []string
+			expectedServers   map[string]string
+			expectedDeleted   []string
+		}{
+			{
+				name:              "removes the first secret and adds a new one",
+				imagesPullSecrets: []*v1alpha1.ImagesPullSecret{redacted("pullsecret-bar-1"), credentials("https://third.example.com")},
+				expectedNames:     []string{"pullsecret-bar-1", "pullsecret-bar-2"},
+				expectedServers: map[string]string{
+					"pullsecret-bar-1": "https://second.example.com",
+					"pullsecret-bar-2": "https://third.example.com",
+				},
+				expectedDeleted: []string{"pullsecret-bar"},
+			},
+			{
+				name:              "reorders the secrets and changes one matched by its server",
+				imagesPullSecrets: []*v1alpha1.ImagesPullSecret{redacted("pullsecret-bar-1"), changedCredentials},
+				expectedNames:     []string{"pullsecret-bar-1", "pullsecret-bar"},
+				expectedServers: map[string]string{
+					"pullsecret-bar-1": "https://second.example.com",
+					"pullsecret-bar":   "https://first.example.com",
+				},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				repo, secrets := managedRepoAndSecrets()
+				s := newServerWithSecretsAndRepos(t, secrets, []*appRepov1alpha1.AppRepository{repo})
+				ctx := context.Background()
+
+				_, err := s.UpdatePackageRepository(ctx, connect.NewRequest(&corev1.UpdatePackageRepositoryRequest{
+					PackageRepoRef: repoRef,
+					Url:            "https://example.com",
+					CustomDetail: toProtoBufAny(&v1alpha1.HelmPackageRepositoryCustomDetail{
+						ImagesPullSecrets: tc.imagesPullSecrets,
+					}),
+				}))
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+
+				appRepo, _, _, err := s.getPkgRepository(ctx, http.Header{}, KubeappsCluster, "foo", "bar")
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if got, want := appRepo.Spec.DockerRegistrySecrets, tc.expectedNames; !cmp.Equal(want, got) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+				}
+				typedClient, err := s.clientGetter.Typed(http.Header{}, s.kubeappsCluster)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for name, server := range tc.expectedServers {
+					secret, err := typedClient.CoreV1().Secrets("foo").Get(ctx, name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatalf("%+v", err)
+					}
+					creds, err := decodeDockerAuth(secret.Data[DockerConfigJsonKey])
+					if err != nil {
+						t.Fatalf("%+v", err)
+					}
+					if got, want := creds.Server, server; got != want {
+						t.Errorf("secret %q: got: %q, want: %q", name, got, want)
+					}
+				}
+				for _, name := range tc.expectedDeleted {
+					if _, err := typedClient.CoreV1().Secrets("foo").Get(ctx, name, metav1.GetOptions{}); !k8sErrors.IsNotFound(err) {
+						t.Errorf("got: %+v, want: not found", err)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("update fails", func(t *testing.T) {
+		testCases := []struct {
+			name              string
+			imagesPullSecrets []*v1alpha1.ImagesPullSecret
+		}{
+			{
+				name:              "with redacted credentials of an unknown secret",
+				imagesPullSecrets: []*v1alpha1.ImagesPullSecret{redacted("pullsecret-other")},
+			},
+			{
+				name:              "with redacted credentials matching no secret",
+				imagesPullSecrets: []*v1alpha1.ImagesPullSecret{redacted(""), redacted("")},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				repo, secrets := managedRepoAndSecrets()
+				s := newServerWithSecretsAndRepos(t, secrets, []*appRepov1alpha1.AppRepository{repo})
+
+				_, err := s.UpdatePackageRepository(context.Background(), connect.NewRequest(&corev1.UpdatePackageRepositoryRequest{
+					PackageRepoRef: repoRef,
+					Url:            "https://example.com",
+					CustomDetail: toProtoBufAny(&v1alpha1.HelmPackageRepositoryCustomDetail{
+						ImagesPullSecrets: tc.imagesPullSecrets,
+					}),
+				}))
+				if got, want := connect.CodeOf(err), connect.CodeInvalidArgument; err == nil || got != want {
+					t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+				}
+			})
+		}
+	})
+}
+
 func TestGetPackageRepositorySummaries(t *testing.T) {
 	// some prep
 	indexYAMLBytes, err := os.ReadFile(testYaml("valid-index.yaml"))
@@ -1186,6 +1550,18 @@ func TestGetPackageRepositoryDetail(t *testing.T) {
 							},
 						},
 					},
+					ImagesPullSecrets: []*v1alpha1.ImagesPullSecret{
+						{
+							DockerRegistryCredentialOneOf: &v1alpha1.ImagesPullSecret_Credentials{
+								Credentials: &corev1.DockerCredentials{
+									Server:   "REDACTED",
+									Username: "REDACTED",
+									Password: "REDACTED",
+									Email:    "REDACTED",
+								},
+							},
+						},
+					},
 				}),
 			existingSecret: newAuthDockerSecret(imagesPullSecretName("repo-5"), "ns-5",
 				dockerAuthJson("https://myfooserver.com", "username", "password", "foo@bar.com", "dXNlcm5hbWU6cGFzc3dvcmQ=")),
@@ -0,0 +1,239 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// diffValues returns the differences between two sets of values, such as the
+// user-supplied values of two release revisions.
+func diffValues(from, to map[string]interface{}) ([]*helmv1.RevisionDiffEntry, error) {
+	// Round-trip both sides through JSON so that equal values compare equally
+	// regardless of how they were decoded (eg. int vs float64).
+	normalizedFrom, err := normalizeForDiff(from)
+	if err != nil {
+		return nil, err
+	}
+	normalizedTo, err := normalizeForDiff(to)
+	if err != nil {
+		return nil, err
+	}
+	entries := []*helmv1.RevisionDiffEntry{}
+	return diffPath("", normalizedFrom, normalizedTo, entries)
+}
+
+// diffManifests returns the resources which differ between two rendered
+// manifests, in the order in which they appear in the manifests.
+func diffManifests(from, to, namespace string) ([]*helmv1.ResourceDiff, error) {
+	fromResources, err := manifestResources(from, namespace)
+	if err != nil {
+		return nil, err
+	}
+	toResources, err := manifestResources(to, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	fromByKey := map[string]manifestResource{}
+	for _, r := range fromResources {
+		fromByKey[r.key()] = r
+	}
+	toByKey := map[string]manifestResource{}
+	for _, r := range toResources {
+		toByKey[r.key()] = r
+	}
+
+	diffs := []*helmv1.ResourceDiff{}
+	for _, r := range toResources {
+		previous, ok := fromByKey[r.key()]
+		if !ok {
+			diffs = append(diffs, &helmv1.ResourceDiff{
+				ResourceRef: r.ref,
+				Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED,
+			})
+			continue
+		}
+		entries, err := diffPath("", previous.object, r.object, []*helmv1.RevisionDiffEntry{})
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) > 0 {
+			diffs = append(diffs, &helmv1.ResourceDiff{
+				ResourceRef: r.ref,
+				Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+				Entries:     entries,
+			})
+		}
+	}
+	for _, r := range fromResources {
+		if _, ok := toByKey[r.key()]; !ok {
+			diffs = append(diffs, &helmv1.ResourceDiff{
+				ResourceRef: r.ref,
+				Change:      helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED,
+			})
+		}
+	}
+	return diffs, nil
+}
+
+// manifestResource is a single decoded resource of a rendered manifest.
+type manifestResource struct {
+	ref    *corev1.ResourceRef
+	object interface{}
+}
+
+func (r manifestResource) key() string {
+	return strings.Join([]string{r.ref.ApiVersion, r.ref.Kind, r.ref.Namespace, r.ref.Name}, "/")
+}
+
+// manifestResources decodes the resources of a rendered manifest, defaulting
+// their namespace to the release namespace as done for the resource refs.
+func manifestResources(manifest, namespace string) ([]manifestResource, error) {
+	decoder := yaml.NewYAMLToJSONDecoder(strings.NewReader(manifest))
+	resources := []manifestResource{}
+	for {
+		var object map[string]interface{}
+		err := decoder.Decode(&object)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("unable to decode yaml manifest: %w", err)
+		}
+		if object == nil {
+			continue
+		}
+		ref := &corev1.ResourceRef{}
+		ref.ApiVersion, _ = object["apiVersion"].(string)
+		ref.Kind, _ = object["kind"].(string)
+		if ref.Kind == "" {
+			continue
+		}
+		if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+			ref.Name, _ = metadata["name"].(string)
+			ref.Namespace, _ = metadata["namespace"].(string)
+		}
+		if ref.Namespace == "" {
+			ref.Namespace = namespace
+		}
+		resources = append(resources, manifestResource{ref: ref, object: object})
+	}
+	return resources, nil
+}
+
+func normalizeForDiff(values map[string]interface{}) (interface{}, error) {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	bytes, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(bytes, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// diffPath appends to entries the differences found between from and to,
+// recursing into maps and lists so that each entry has the most specific path.
+func diffPath(path string, from, to interface{}, entries []*helmv1.RevisionDiffEntry) ([]*helmv1.RevisionDiffEntry, error) {
+	var err error
+	switch fromTyped := from.(type) {
+	case map[string]interface{}:
+		toTyped, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := []string{}
+		for k := range fromTyped {
+			keys = append(keys, k)
+		}
+		for k := range toTyped {
+			if _, ok := fromTyped[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			fromValue, inFrom := fromTyped[k]
+			toValue, inTo := toTyped[k]
+			switch {
+			case !inFrom:
+				entries, err = appendDiffEntry(entries, childPath, helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED, nil, toValue)
+			case !inTo:
+				entries, err = appendDiffEntry(entries, childPath, helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED, fromValue, nil)
+			default:
+				entries, err = diffPath(childPath, fromValue, toValue, entries)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		return entries, nil
+	case []interface{}:
+		toTyped, ok := to.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(fromTyped) || i < len(toTyped); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(fromTyped):
+				entries, err = appendDiffEntry(entries, childPath, helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED, nil, toTyped[i])
+			case i >= len(toTyped):
+				entries, err = appendDiffEntry(entries, childPath, helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED, fromTyped[i], nil)
+			default:
+				entries, err = diffPath(childPath, fromTyped[i], toTyped[i], entries)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		return entries, nil
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return entries, nil
+	}
+	return appendDiffEntry(entries, path, helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED, from, to)
+}
+
+func appendDiffEntry(entries []*helmv1.RevisionDiffEntry, path string, change helmv1.DiffChangeType, from, to interface{}) ([]*helmv1.RevisionDiffEntry, error) {
+	entry := &helmv1.RevisionDiffEntry{
+		Path:   path,
+		Change: change,
+	}
+	if change != helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED {
+		fromJSON, err := json.Marshal(from)
+		if err != nil {
+			return nil, err
+		}
+		entry.FromValue = string(fromJSON)
+	}
+	if change != helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED {
+		toJSON, err := json.Marshal(to)
+		if err != nil {
+			return nil, err
+		}
+		entry.ToValue = string(toJSON)
+	}
+	return append(entries, entry), nil
+}
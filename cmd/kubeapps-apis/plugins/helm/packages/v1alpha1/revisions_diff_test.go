@@ -0,0 +1,223 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+)
+
+func TestDiffValues(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     map[string]interface{}
+		to       map[string]interface{}
+		expected []*helmv1.RevisionDiffEntry
+	}{
+		{
+			name:     "returns no entries for equal values",
+			from:     map[string]interface{}{"replicas": 1, "image": map[string]interface{}{"tag": "1.0"}},
+			to:       map[string]interface{}{"replicas": float64(1), "image": map[string]interface{}{"tag": "1.0"}},
+			expected: []*helmv1.RevisionDiffEntry{},
+		},
+		{
+			name:     "returns no entries for nil values",
+			expected: []*helmv1.RevisionDiffEntry{},
+		},
+		{
+			name: "returns added, removed and changed paths",
+			from: map[string]interface{}{
+				"image":   map[string]interface{}{"tag": "1.0"},
+				"service": map[string]interface{}{"type": "ClusterIP"},
+			},
+			to: map[string]interface{}{
+				"image":     map[string]interface{}{"tag": "1.1"},
+				"ingress":   map[string]interface{}{"enabled": true},
+				"extraArgs": []interface{}{"--debug"},
+			},
+			expected: []*helmv1.RevisionDiffEntry{
+				{
+					Path:    "extraArgs",
+					Change:  helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED,
+					ToValue: "[\"--debug\"]",
+				},
+				{
+					Path:      "image.tag",
+					Change:    helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+					FromValue: "\"1.0\"",
+					ToValue:   "\"1.1\"",
+				},
+				{
+					Path:    "ingress",
+					Change:  helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED,
+					ToValue: "{\"enabled\":true}",
+				},
+				{
+					Path:      "service",
+					Change:    helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED,
+					FromValue: "{\"type\":\"ClusterIP\"}",
+				},
+			},
+		},
+		{
+			name: "returns indexed paths for lists",
+			from: map[string]interface{}{"args": []interface{}{"a", "b"}},
+			to:   map[string]interface{}{"args": []interface{}{"a", "c", "d"}},
+			expected: []*helmv1.RevisionDiffEntry{
+				{
+					Path:      "args[1]",
+					Change:    helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+					FromValue: "\"b\"",
+					ToValue:   "\"c\"",
+				},
+				{
+					Path:    "args[2]",
+					Change:  helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED,
+					ToValue: "\"d\"",
+				},
+			},
+		},
+		{
+			name: "returns a change when the type differs",
+			from: map[string]interface{}{"resources": map[string]interface{}{"limits": "none"}},
+			to:   map[string]interface{}{"resources": "none"},
+			expected: []*helmv1.RevisionDiffEntry{
+				{
+					Path:      "resources",
+					Change:    helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+					FromValue: "{\"limits\":\"none\"}",
+					ToValue:   "\"none\"",
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(helmv1.RevisionDiffEntry{})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := diffValues(tc.from, tc.to)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := entries, tc.expected; !cmp.Equal(got, want, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
+
+func TestDiffManifests(t *testing.T) {
+	const (
+		service = `apiVersion: v1
+kind: Service
+metadata:
+  name: my-apache
+spec:
+  ports:
+  - port: 80
+`
+		serviceChanged = `apiVersion: v1
+kind: Service
+metadata:
+  name: my-apache
+spec:
+  ports:
+  - port: 8080
+`
+		configMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-apache
+  namespace: other
+data:
+  foo: bar
+`
+		deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-apache
+`
+	)
+
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected []*helmv1.ResourceDiff
+	}{
+		{
+			name:     "returns no diffs for equal manifests",
+			from:     service + "---\n" + configMap,
+			to:       service + "---\n" + configMap,
+			expected: []*helmv1.ResourceDiff{},
+		},
+		{
+			name: "returns added, removed and changed resources",
+			from: service + "---\n" + configMap,
+			to:   deployment + "---\n" + serviceChanged,
+			expected: []*helmv1.ResourceDiff{
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-apache",
+						Namespace:  "default",
+					},
+					Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED,
+				},
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "v1",
+						Kind:       "Service",
+						Name:       "my-apache",
+						Namespace:  "default",
+					},
+					Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+					Entries: []*helmv1.RevisionDiffEntry{
+						{
+							Path:      "spec.ports[0].port",
+							Change:    helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+							FromValue: "80",
+							ToValue:   "8080",
+						},
+					},
+				},
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-apache",
+						Namespace:  "other",
+					},
+					Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED,
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		helmv1.ResourceDiff{},
+		helmv1.RevisionDiffEntry{},
+		corev1.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diffs, err := diffManifests(tc.from, tc.to, "default")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := diffs, tc.expected; !cmp.Equal(got, want, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	existingReleases := []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.2",
+			status:       release.StatusSuperseded,
+			version:      1,
+		},
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      2,
+			values:       "{\"foo\": \"bar\"}",
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		existingReleases  []releaseStub
+		request           *helmv1.GetInstalledPackageRevisionsRequest
+		expectedResponse  *helmv1.GetInstalledPackageRevisionsResponse
+		expectedErrorCode connect.Code
+	}{
+		{
+			name:             "returns the revisions of the installed package from the most recent",
+			existingReleases: existingReleases,
+			request: &helmv1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedResponse: &helmv1.GetInstalledPackageRevisionsResponse{
+				Revisions: []*helmv1.InstalledPackageRevision{
+					{
+						ReleaseRevision: 2,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.3",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      true,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+							UserReason: "deployed",
+						},
+						ValuesApplied: "{\"foo\":\"bar\"}",
+					},
+					{
+						ReleaseRevision: 1,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.2",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      false,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+							UserReason: "superseded",
+						},
+						ValuesApplied: "{}",
+					},
+				},
+			},
+		},
+		{
+			name:             "returns at most the requested number of revisions",
+			existingReleases: existingReleases,
+			request: &helmv1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				MaxRevisions: 1,
+			},
+			expectedResponse: &helmv1.GetInstalledPackageRevisionsResponse{
+				Revisions: []*helmv1.InstalledPackageRevision{
+					{
+						ReleaseRevision: 2,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.3",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      true,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+							UserReason: "deployed",
+						},
+						ValuesApplied: "{\"foo\":\"bar\"}",
+					},
+				},
+			},
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &helmv1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedErrorCode: connect.CodeNotFound,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		helmv1.GetInstalledPackageRevisionsResponse{},
+		helmv1.InstalledPackageRevision{},
+		corev1.PackageAppVersion{},
+		corev1.InstalledPackageStatus{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases, nil)
+
+			server, _, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageRevisions(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageRevisionsDiff(t *testing.T) {
+	existingReleases := []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.2",
+			status:       release.StatusSuperseded,
+			version:      1,
+			values:       "{\"replicas\": 1}",
+			manifest:     "apiVersion: v1\nkind: Service\nmetadata:\n  name: my-apache\n",
+		},
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      2,
+			values:       "{\"replicas\": 2}",
+			manifest:     "apiVersion: v1\nkind: Service\nmetadata:\n  name: my-apache\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-apache\n",
+		},
+	}
+
+	installedRef := &corev1.InstalledPackageReference{
+		Context: &corev1.Context{
+			Cluster:   "default",
+			Namespace: "default",
+		},
+		Identifier: "my-apache",
+	}
+
+	testCases := []struct {
+		name              string
+		existingReleases  []releaseStub
+		request           *helmv1.GetInstalledPackageRevisionsDiffRequest
+		expectedResponse  *helmv1.GetInstalledPackageRevisionsDiffResponse
+		expectedErrorCode connect.Code
+	}{
+		{
+			name:             "returns the diff between a revision and the current one",
+			existingReleases: existingReleases,
+			request: &helmv1.GetInstalledPackageRevisionsDiffRequest{
+				InstalledPackageRef: installedRef,
+				FromRevision:        1,
+			},
+			expectedResponse: &helmv1.GetInstalledPackageRevisionsDiffResponse{
+				ValuesDiff: []*helmv1.RevisionDiffEntry{
+					{
+						Path:      "replicas",
+						Change:    helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+						FromValue: "1",
+						ToValue:   "2",
+					},
+				},
+				ManifestDiff: []*helmv1.ResourceDiff{
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       "my-apache",
+							Namespace:  "default",
+						},
+						Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_ADDED,
+					},
+				},
+			},
+		},
+		{
+			name:             "returns the diff between two explicit revisions",
+			existingReleases: existingReleases,
+			request: &helmv1.GetInstalledPackageRevisionsDiffRequest{
+				InstalledPackageRef: installedRef,
+				FromRevision:        2,
+				ToRevision:          1,
+			},
+			expectedResponse: &helmv1.GetInstalledPackageRevisionsDiffResponse{
+				ValuesDiff: []*helmv1.RevisionDiffEntry{
+					{
+						Path:      "replicas",
+						Change:    helmv1.DiffChangeType_DIFF_CHANGE_TYPE_CHANGED,
+						FromValue: "2",
+						ToValue:   "1",
+					},
+				},
+				ManifestDiff: []*helmv1.ResourceDiff{
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       "my-apache",
+							Namespace:  "default",
+						},
+						Change: helmv1.DiffChangeType_DIFF_CHANGE_TYPE_REMOVED,
+					},
+				},
+			},
+		},
+		{
+			name:             "returns invalid argument without a from revision",
+			existingReleases: existingReleases,
+			request: &helmv1.GetInstalledPackageRevisionsDiffRequest{
+				InstalledPackageRef: installedRef,
+			},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:             "returns not found if the revision doesn't exist",
+			existingReleases: existingReleases,
+			request: &helmv1.GetInstalledPackageRevisionsDiffRequest{
+				InstalledPackageRef: installedRef,
+				FromRevision:        3,
+			},
+			expectedErrorCode: connect.CodeNotFound,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		helmv1.GetInstalledPackageRevisionsDiffResponse{},
+		helmv1.RevisionDiffEntry{},
+		helmv1.ResourceDiff{},
+		corev1.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases, nil)
+
+			server, _, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageRevisionsDiff(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
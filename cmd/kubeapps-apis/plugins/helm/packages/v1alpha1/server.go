@@ -29,21 +29,27 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resources"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"github.com/vmware-tanzu/kubeapps/pkg/dbutils"
+	kubeappshelm "github.com/vmware-tanzu/kubeapps/pkg/helm"
 	"github.com/vmware-tanzu/kubeapps/pkg/kube"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corek8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
 	log "k8s.io/klog/v2"
 	"oras.land/oras-go/v2/registry/remote"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -59,12 +65,13 @@ const (
 	UserAgentPrefix = "kubeapps-apis/plugins"
 )
 
-type createRelease func(*action.Configuration, string, string, string, *chart.Chart, map[string]string, int32) (*release.Release, error)
+type createRelease func(*action.Configuration, string, string, string, *chart.Chart, map[string]string, agent.PostRendererChain, int32, bool, agent.WaitOptions) (*release.Release, error)
 type repositoryClientGetter func(appRepo *appRepov1.AppRepository, secret *corek8sv1.Secret) (*http.Client, error)
 
 // Server implements the helm packages v1alpha1 interface.
 type Server struct {
 	helmv1.UnimplementedHelmPackagesServiceServer
+	pkgutils.CorePackagesRPCs
 	// clientGetter is a field so that it can be switched in tests for
 	// a fake client. NewServer() below sets this automatically with the
 	// non-test implementation.
@@ -77,13 +84,17 @@ type Server struct {
 	manager                         utils.AssetManager
 	actionConfigGetter              helmActionConfigGetter
 	chartClientFactory              utils.ChartClientFactoryInterface
-	createReleaseFunc               createRelease
-	kubeappsCluster                 string // Specifies the cluster on which Kubeapps is installed.
-	kubeappsNamespace               string // Namespace in which Kubeapps is installed
-	pluginConfig                    *common.HelmPluginConfig
-	repoClientGetter                repositoryClientGetter
-	clientQPS                       float32
-	OCICatalogAddr                  string
+	chartCache                      *utils.ChartCache // nil when the chart cache is disabled
+	// restMapperGetter returns the mapper of the kinds of the release manifests
+	// to the API resources of a cluster. Replaced in tests by a static mapper.
+	restMapperGetter  func(headers http.Header, cluster string) (meta.RESTMapper, error)
+	createReleaseFunc createRelease
+	kubeappsCluster   string // Specifies the cluster on which Kubeapps is installed.
+	kubeappsNamespace string // Namespace in which Kubeapps is installed
+	pluginConfig      *common.HelmPluginConfig
+	repoClientGetter  repositoryClientGetter
+	clientQPS         float32
+	OCICatalogAddr    string
 }
 
 // NewServer returns a Server automatically configured with a function to obtain
@@ -150,6 +161,19 @@ func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster
 		log.Fatalf("%s", err)
 	}
 
+	storageForDrivers, err := newStorageForDrivers(pluginConfig, dbConfig)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	var chartCache *utils.ChartCache
+	if pluginConfig.ChartCache.MaxSizeBytes > 0 {
+		chartCache, err = utils.NewChartCache(pluginConfig.ChartCache.Directory, pluginConfig.ChartCache.MaxSizeBytes)
+		if err != nil {
+			log.Fatalf("%s", err)
+		}
+	}
+
 	return &Server{
 		clientGetter: clientProvider,
 		// Get the "in-cluster" client getter
@@ -161,7 +185,8 @@ func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster
 			if kube.IsKubeappsClusterRef(cluster) {
 				cluster = globalPackagingCluster
 			}
-			fn := helm.NewHelmActionConfigGetter(configGetter, cluster)
+			storageForDriver := storageForDrivers[pluginConfig.StorageDriverForCluster(cluster)]
+			fn := helm.NewHelmActionConfigGetter(configGetter, cluster, storageForDriver)
 			return fn(headers, pkgContext.GetNamespace())
 		},
 		manager:                  manager,
@@ -169,12 +194,50 @@ func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster
 		globalPackagingNamespace: globalPackagingNamespace,
 		globalPackagingCluster:   globalPackagingCluster,
 		chartClientFactory:       &utils.ChartClientFactory{},
-		pluginConfig:             pluginConfig,
-		createReleaseFunc:        agent.CreateRelease,
-		repoClientGetter:         newRepositoryClient,
-		clientQPS:                clientQPS,
-		OCICatalogAddr:           OCI_CATALOG_URL,
+		chartCache:               chartCache,
+		restMapperGetter: func(headers http.Header, cluster string) (meta.RESTMapper, error) {
+			typedClient, err := clientProvider.Typed(headers, cluster)
+			if err != nil {
+				return nil, err
+			}
+			return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(typedClient.Discovery())), nil
+		},
+		pluginConfig:      pluginConfig,
+		createReleaseFunc: agent.CreateRelease,
+		repoClientGetter:  newRepositoryClient,
+		clientQPS:         clientQPS,
+		OCICatalogAddr:    OCI_CATALOG_URL,
+	}
+}
+
+// newStorageForDrivers returns the storage of each helm storage driver used
+// by the clusters of the plugin config, keyed by driver name. The SQL driver
+// stores the releases in the database of the assets.
+func newStorageForDrivers(pluginConfig *common.HelmPluginConfig, dbConfig dbutils.Config) (map[string]agent.StorageForDriver, error) {
+	driverNames := []string{pluginConfig.StorageDriverForCluster("")}
+	for cluster := range pluginConfig.ClusterStorageDrivers {
+		driverNames = append(driverNames, pluginConfig.StorageDriverForCluster(cluster))
+	}
+	storageForDrivers := map[string]agent.StorageForDriver{}
+	for _, driverName := range driverNames {
+		if _, ok := storageForDrivers[driverName]; ok {
+			continue
+		}
+		connectionString := ""
+		if driverName == agent.StorageDriverSQL {
+			var err error
+			connectionString, err = dbutils.PostgresConnectionString(dbConfig)
+			if err != nil {
+				return nil, err
+			}
+		}
+		storageForDriver, err := agent.NewStorageForDriver(driverName, connectionString)
+		if err != nil {
+			return nil, err
+		}
+		storageForDrivers[driverName] = storageForDriver
 	}
+	return storageForDrivers, nil
 }
 
 func (s *Server) MaxWorkers() int {
@@ -207,26 +270,45 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *conn
 	namespace := request.Msg.GetContext().GetNamespace()
 	cluster := request.Msg.GetContext().GetCluster()
 
-	// Check the requested namespace: if any, return "everything a user can read";
+	// Check the requested namespace: if none, return "everything a user can read";
 	// otherwise, first check if the user can access the requested ns
+	var namespaces []string
 	if namespace == "" {
-		// TODO(agamez): not including a namespace means that it returns everything a user can read
-		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: not including a namespace means that it returns everything a user can read"))
-	}
-	// After requesting a specific namespace, we have to ensure the user can actually access to it
-	if err := s.hasAccessToNamespace(request.Header(), cluster, namespace); err != nil {
-		return nil, err
-	}
+		if cluster != "" && cluster != s.globalPackagingCluster {
+			// If the request is for available packages on another cluster, we only
+			// return the global packages (ie. kubeapps namespace)
+			namespace = s.GetGlobalPackagingNamespace()
+		} else {
+			// App repositories live on the global packaging cluster only, so the
+			// namespaces the user can read are checked there
+			namespaceList, err := s.getAccessibleNamespaces(request.Header(), s.globalPackagingCluster)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to fetch the accessible namespaces: %w", err))
+			}
+			for _, ns := range namespaceList {
+				namespaces = append(namespaces, ns.Name)
+			}
+			if len(namespaces) == 0 {
+				namespace = s.GetGlobalPackagingNamespace()
+			}
+		}
+	} else {
+		// After requesting a specific namespace, we have to ensure the user can actually access to it
+		if err := s.hasAccessToNamespace(request.Header(), cluster, namespace); err != nil {
+			return nil, err
+		}
 
-	// If the request is for available packages on another cluster, we only
-	// return the global packages (ie. kubeapps namespace)
-	if cluster != "" && cluster != s.globalPackagingCluster {
-		namespace = s.GetGlobalPackagingNamespace()
+		// If the request is for available packages on another cluster, we only
+		// return the global packages (ie. kubeapps namespace)
+		if cluster != "" && cluster != s.globalPackagingCluster {
+			namespace = s.GetGlobalPackagingNamespace()
+		}
 	}
 
-	// Create the initial chart query with the namespace
+	// Create the initial chart query with the namespace(s)
 	cq := utils.ChartQuery{
-		Namespace: namespace,
+		Namespace:  namespace,
+		Namespaces: namespaces,
 	}
 
 	// Add any other filter if a FilterOptions is passed
@@ -245,8 +327,8 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *conn
 	}
 
 	// This plugin will include, as part of the GetAvailablePackageSummariesResponse,
-	// a "Categories" field containing only the distinct category names considering just the namespace
-	chartCategories, err := s.manager.GetAllChartCategories(utils.ChartQuery{Namespace: namespace})
+	// a "Categories" field containing only the distinct category names considering just the namespace(s)
+	chartCategories, err := s.manager.GetAllChartCategories(utils.ChartQuery{Namespace: namespace, Namespaces: namespaces})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to fetch chart categories: %w", err))
 	}
@@ -487,6 +569,22 @@ func (s *Server) hasAccessToNamespace(headers http.Header, cluster, namespace st
 	return nil
 }
 
+// getAccessibleNamespaces returns the active namespaces of the cluster to which the user has access
+func (s *Server) getAccessibleNamespaces(headers http.Header, cluster string) ([]corek8sv1.Namespace, error) {
+	clusterTypedClientFunc := func() (kubernetes.Interface, error) {
+		return s.clientGetter.Typed(headers, cluster)
+	}
+	inClusterTypedClientFunc := func() (kubernetes.Interface, error) {
+		return s.localServiceAccountClientGetter.Typed(context.Background())
+	}
+
+	namespaceList, err := resources.FindAccessibleNamespaces(clusterTypedClientFunc, inClusterTypedClientFunc, s.MaxWorkers())
+	if err != nil {
+		return nil, err
+	}
+	return resources.FilterActiveNamespaces(namespaceList), nil
+}
+
 // GetInstalledPackageSummaries returns the installed packages managed by the 'helm' plugin
 func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *connect.Request[corev1.GetInstalledPackageSummariesRequest]) (*connect.Response[corev1.GetInstalledPackageSummariesResponse], error) {
 	log.InfoS("+helm GetInstalledPackageSummaries", "cluster", request.Msg.GetContext().GetCluster(), "namespace", request.Msg.GetContext().GetNamespace())
@@ -524,43 +622,47 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *conn
 		installedPkgSummaries[i].InstalledPackageRef.Plugin = GetPluginDetail()
 	}
 
+	adoptedRefs, err := s.getAdoptedAvailablePackageRefs(ctx, request.Header(), cluster, request.Msg.GetContext().GetNamespace())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the adopted releases: %w", err))
+	}
+
 	// Fill in the latest package version for each.
 	// TODO(mnelson): Update to do this with a single query rather than iterating and
 	// querying per release.
 	for i, rel := range releases {
-		// Helm does not store a back-reference to the chart used to create a
-		// release (https://github.com/helm/helm/issues/6464), so for each
-		// release, we look up a chart with than name and version available in
-		// the release namespace, and if one is found, pull out the latest chart
-		// version.
-		cq := utils.ChartQuery{
-			Namespace:  rel.Namespace,
-			ChartName:  rel.Chart.Metadata.Name,
-			Version:    rel.Chart.Metadata.Version,
-			AppVersion: rel.Chart.Metadata.AppVersion,
-		}
-		charts, err := s.manager.GetPaginatedChartListWithFilters(cq, 0, 0)
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Error while fetching related charts: %w", err))
-		}
-		// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
-		// Example: global + namespaced repo including an overlapping subset of packages.
-		if len(charts) > 0 && len(charts[0].ChartVersions) > 0 {
-			sortedVersions, err := pkgutils.SortByPackageVersion(charts[0].ChartVersions)
-			if err != nil {
-				// If there was an error parsing a version as semver, fall back to ChartVersions[0]
-				log.Errorf("Error parsing versions as semver: %v", err)
-				installedPkgSummaries[i].LatestVersion = &corev1.PackageAppVersion{
-					PkgVersion: charts[0].ChartVersions[0].Version,
-					AppVersion: charts[0].ChartVersions[0].AppVersion,
-				}
-			} else {
-				installedPkgSummaries[i].LatestVersion = &corev1.PackageAppVersion{
-					PkgVersion: sortedVersions[0].Version.String(),
-					AppVersion: sortedVersions[0].AppVersion,
+		// Adopted releases use the versions of their explicitly associated
+		// available package. The versions of a raw OCI reference are not known.
+		if adoptedRef, ok := adoptedRefs[adoptedReleaseKey(rel.Namespace, rel.Name)]; ok {
+			if !isOCIReference(adoptedRef.GetIdentifier()) {
+				chart, err := s.manager.GetChart(adoptedRef.GetContext().GetNamespace(), adoptedRef.GetIdentifier())
+				if err != nil {
+					log.Warningf("Unable to find the available package %q of the adopted release %q: %v", adoptedRef.GetIdentifier(), rel.Name, err)
+				} else {
+					installedPkgSummaries[i].LatestVersion = latestPackageAppVersion(chart.ChartVersions)
 				}
 			}
-
+		} else if !isLocallySourced(rel) {
+			// Helm does not store a back-reference to the chart used to create a
+			// release (https://github.com/helm/helm/issues/6464), so for each
+			// release, we look up a chart with than name and version available in
+			// the release namespace, and if one is found, pull out the latest chart
+			// version.
+			cq := utils.ChartQuery{
+				Namespace:  rel.Namespace,
+				ChartName:  rel.Chart.Metadata.Name,
+				Version:    rel.Chart.Metadata.Version,
+				AppVersion: rel.Chart.Metadata.AppVersion,
+			}
+			charts, err := s.manager.GetPaginatedChartListWithFilters(cq, 0, 0)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Error while fetching related charts: %w", err))
+			}
+			// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
+			// Example: global + namespaced repo including an overlapping subset of packages.
+			if len(charts) > 0 {
+				installedPkgSummaries[i].LatestVersion = latestPackageAppVersion(charts[0].ChartVersions)
+			}
 		}
 		installedPkgSummaries[i].Status = &corev1.InstalledPackageStatus{
 			Ready:      rel.Info.Status == release.StatusDeployed,
@@ -582,6 +684,27 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *conn
 	return connect.NewResponse(response), nil
 }
 
+// latestPackageAppVersion returns the latest of the chart versions, or nil if
+// there are none.
+func latestPackageAppVersion(chartVersions []models.ChartVersion) *corev1.PackageAppVersion {
+	if len(chartVersions) == 0 {
+		return nil
+	}
+	sortedVersions, err := pkgutils.SortByPackageVersion(chartVersions)
+	if err != nil {
+		// If there was an error parsing a version as semver, fall back to chartVersions[0]
+		log.Errorf("Error parsing versions as semver: %v", err)
+		return &corev1.PackageAppVersion{
+			PkgVersion: chartVersions[0].Version,
+			AppVersion: chartVersions[0].AppVersion,
+		}
+	}
+	return &corev1.PackageAppVersion{
+		PkgVersion: sortedVersions[0].Version.String(),
+		AppVersion: sortedVersions[0].AppVersion,
+	}
+}
+
 func statusReasonForHelmStatus(s release.Status) corev1.InstalledPackageStatus_StatusReason {
 	switch s {
 	case release.StatusDeployed:
@@ -655,6 +778,50 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *connect
 	}
 	installedPkgDetail.ValuesApplied = string(valuesMarshalled)
 
+	// An adopted release uses its explicitly associated available package.
+	cluster := request.Msg.GetInstalledPackageRef().GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	// The values read from the references of the release are merged when
+	// updating it, in addition to the ones of the request.
+	valuesFrom, _, err := s.getReleaseValuesFrom(ctx, request.Header(), cluster, release.Namespace, release.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the values references of the release: %w", err))
+	}
+	installedPkgDetail.ServerSideValues = len(valuesFrom) > 0
+
+	adoptedRef, err := s.getAdoptedAvailablePackageRef(ctx, request.Header(), cluster, release.Namespace, release.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the adopted release: %w", err))
+	}
+	if adoptedRef != nil {
+		installedPkgDetail.AvailablePackageRef = adoptedRef
+		// The versions of a raw OCI reference are not known.
+		if isOCIReference(adoptedRef.GetIdentifier()) {
+			return connect.NewResponse(&corev1.GetInstalledPackageDetailResponse{
+				InstalledPackageDetail: installedPkgDetail,
+			}), nil
+		}
+		chart, err := s.manager.GetChart(adoptedRef.GetContext().GetNamespace(), adoptedRef.GetIdentifier())
+		if err != nil {
+			log.Warningf("Unable to find the available package %q of the adopted release %q: %v", adoptedRef.GetIdentifier(), release.Name, err)
+		} else {
+			installedPkgDetail.LatestVersion = latestPackageAppVersion(chart.ChartVersions)
+		}
+		return connect.NewResponse(&corev1.GetInstalledPackageDetailResponse{
+			InstalledPackageDetail: installedPkgDetail,
+		}), nil
+	}
+
+	// A chart uploaded as an archive does not match any available package,
+	// even one with the same name and version.
+	if isLocallySourced(release) {
+		return connect.NewResponse(&corev1.GetInstalledPackageDetailResponse{
+			InstalledPackageDetail: installedPkgDetail,
+		}), nil
+	}
+
 	// Check for a chart matching the installed package.
 	cq := utils.ChartQuery{
 		Namespace: release.Namespace,
@@ -683,23 +850,7 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *connect
 				Cluster:   s.globalPackagingCluster,
 			}
 		}
-		if len(charts[0].ChartVersions) > 0 {
-			sortedVersions, err := pkgutils.SortByPackageVersion(charts[0].ChartVersions)
-			if err != nil {
-				// If there was an error parsing a version as semver, fall back to ChartVersions[0]
-				log.Errorf("Error parsing versions as semver: %v", err)
-				installedPkgDetail.LatestVersion = &corev1.PackageAppVersion{
-					PkgVersion: charts[0].ChartVersions[0].Version,
-					AppVersion: charts[0].ChartVersions[0].AppVersion,
-				}
-			} else {
-				installedPkgDetail.LatestVersion = &corev1.PackageAppVersion{
-					PkgVersion: sortedVersions[0].Version.String(),
-					AppVersion: sortedVersions[0].AppVersion,
-				}
-			}
-
-		}
+		installedPkgDetail.LatestVersion = latestPackageAppVersion(charts[0].ChartVersions)
 	}
 
 	return connect.NewResponse(&corev1.GetInstalledPackageDetailResponse{
@@ -710,6 +861,8 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *connect
 func installedPkgDetailFromRelease(r *release.Release, ref *corev1.InstalledPackageReference) (*corev1.InstalledPackageDetail, error) {
 	customDetailHelm, err := anypb.New(&helmv1.InstalledPackageDetailCustomDataHelm{
 		ReleaseRevision: int32(r.Version),
+		LastTestResults: lastTestResults(r),
+		LocallySourced:  isLocallySourced(r),
 	})
 	if err != nil {
 		return nil, err
@@ -744,19 +897,74 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *connect.Re
 	}
 	chartID := request.Msg.GetAvailablePackageRef().GetIdentifier()
 	repoNamespace := request.Msg.GetAvailablePackageRef().GetContext().GetNamespace()
-	repoName, chartName, err := pkgutils.SplitPackageIdentifier(chartID)
+	var ch *chart.Chart
+	var registrySecrets map[string]string
+	// A chart installed from a raw OCI reference is associated with its
+	// release in the same way as an adopted release, so that it can be updated.
+	var ociAdoption *releaseAdoption
+	if isOCIReference(chartID) {
+		ociRef, version, err := parseOCIReference(chartID, request.Msg.GetPkgVersionReference().GetVersion())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if repoNamespace == "" {
+			repoNamespace = request.Msg.GetTargetContext().GetNamespace()
+		}
+		registrySecret, err := ociRegistrySecretFromCustomDetail(request.Msg.GetCustomDetail())
+		if err != nil {
+			return nil, err
+		}
+		ch, err = s.fetchChartFromOCIReference(ctx, request.Header(), ociRef, version, repoNamespace, registrySecret)
+		if err != nil {
+			return nil, err
+		}
+		ociAdoption = &releaseAdoption{
+			availablePackageRef: &corev1.AvailablePackageReference{
+				Context: &corev1.Context{
+					Cluster:   s.globalPackagingCluster,
+					Namespace: repoNamespace,
+				},
+				Identifier: ociRef,
+				Plugin:     GetPluginDetail(),
+			},
+			registrySecret: registrySecret,
+		}
+	} else {
+		repoName, chartName, err := pkgutils.SplitPackageIdentifier(chartID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		chartDetails := &utils.ChartDetails{
+			AppRepositoryResourceName:      repoName,
+			AppRepositoryResourceNamespace: repoNamespace,
+			ChartName:                      chartName,
+			Version:                        request.Msg.GetPkgVersionReference().GetVersion(),
+		}
+		ch, registrySecrets, err = s.fetchChartWithRegistrySecrets(ctx, request.Header(), chartDetails, typedClient)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+				return nil, err
+			}
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("Missing permissions %w", err))
+		}
+	}
+
+	waitOptions, err := waitOptionsFromCustomDetail(request.Msg.GetCustomDetail())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
+	}
+
+	cluster := request.Msg.GetTargetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
 	}
-	chartDetails := &utils.ChartDetails{
-		AppRepositoryResourceName:      repoName,
-		AppRepositoryResourceNamespace: repoNamespace,
-		ChartName:                      chartName,
-		Version:                        request.Msg.GetPkgVersionReference().GetVersion(),
+	valuesFrom, _, err := valuesFromCustomDetail(request.Msg.GetCustomDetail())
+	if err != nil {
+		return nil, err
 	}
-	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, request.Header(), chartDetails, typedClient)
+	referencedValues, err := s.resolveValuesFrom(ctx, request.Header(), cluster, request.Msg.GetTargetContext().GetNamespace(), valuesFrom)
 	if err != nil {
-		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("Missing permissions %w", err))
+		return nil, err
 	}
 
 	// Create an action config for the target namespace.
@@ -764,15 +972,31 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *connect.Re
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
 	}
+	ch = applyValuesFrom(actionConfig, ch, referencedValues)
 
-	release, err := s.createReleaseFunc(actionConfig, request.Msg.GetName(), request.Msg.GetTargetContext().GetNamespace(), request.Msg.GetValues(), ch, registrySecrets, s.pluginConfig.TimeoutSeconds)
+	postRenderers, err := s.postRenderersFor(ctx, request.Msg.GetTargetContext().GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.createReleaseFunc(actionConfig, request.Msg.GetName(), request.Msg.GetTargetContext().GetNamespace(), request.Msg.GetValues(), ch, registrySecrets, postRenderers, s.pluginConfig.TimeoutSeconds, request.Msg.GetDryRun(), waitOptions)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create helm release %q in the namespace %q: %w", request.Msg.GetName(), request.Msg.GetTargetContext().GetNamespace(), err))
 	}
 
-	cluster := request.Msg.GetTargetContext().GetCluster()
-	if cluster == "" {
-		cluster = s.globalPackagingCluster
+	var renderedPackage *corev1.RenderedPackage
+	if request.Msg.GetDryRun() {
+		renderedPackage, err = renderedPackageFromRelease(release, "")
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to render helm release %q in the namespace %q: %w", request.Msg.GetName(), request.Msg.GetTargetContext().GetNamespace(), err))
+		}
+	}
+
+	if !request.Msg.GetDryRun() {
+		if ociAdoption != nil {
+			s.keepReleaseAdoption(ctx, request.Header(), cluster, release.Namespace, release.Name, release.Version, ociAdoption)
+		}
+		s.keepReleaseValuesFrom(ctx, request.Header(), cluster, release.Namespace, release.Name, release.Version, valuesFrom, false)
 	}
 	return connect.NewResponse(&corev1.CreateInstalledPackageResponse{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
@@ -783,9 +1007,45 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *connect.Re
 			Identifier: release.Name,
 			Plugin:     GetPluginDetail(),
 		},
+		RenderedPackage: renderedPackage,
 	}), nil
 }
 
+// renderedPackageFromRelease returns the rendered package for a release which
+// was created or upgraded as a dry run.
+func renderedPackageFromRelease(r *release.Release, currentManifest string) (*corev1.RenderedPackage, error) {
+	refs, err := resourcerefs.ResourceRefsFromManifest(r.Manifest, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	renderedPackage := &corev1.RenderedPackage{
+		Manifest:        r.Manifest,
+		ResourceRefs:    refs,
+		CurrentManifest: currentManifest,
+	}
+	if r.Info != nil {
+		renderedPackage.PostInstallationNotes = r.Info.Notes
+	}
+	return renderedPackage, nil
+}
+
+// waitOptionsFromCustomDetail returns the wait options requested using the
+// custom detail of a create or update request, if any.
+func waitOptionsFromCustomDetail(customDetail *anypb.Any) (agent.WaitOptions, error) {
+	if customDetail == nil {
+		return agent.WaitOptions{}, nil
+	}
+	customData := &helmv1.InstalledPackageRequestCustomDataHelm{}
+	if err := customDetail.UnmarshalTo(customData); err != nil {
+		return agent.WaitOptions{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to unmarshal the custom detail: %w", err))
+	}
+	return agent.WaitOptions{
+		Wait:        customData.GetWait(),
+		WaitForJobs: customData.GetWaitForJobs(),
+		Atomic:      customData.GetAtomic(),
+	}, nil
+}
+
 // UpdateInstalledPackage updates an installed package.
 func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Request[corev1.UpdateInstalledPackageRequest]) (*connect.Response[corev1.UpdateInstalledPackageResponse], error) {
 	installedRef := request.Msg.GetInstalledPackageRef()
@@ -816,20 +1076,88 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Re
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create kubernetes clientset: %w", err))
 	}
-	chartID := availablePkgRef.GetIdentifier()
-	repoName, chartName, err := pkgutils.SplitPackageIdentifier(chartID)
+	waitOptions, err := waitOptionsFromCustomDetail(request.Msg.GetCustomDetail())
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := installedRef.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	// Helm replaces the secret of the current revision when upgrading, so the
+	// adoption of the release must be retrieved beforehand.
+	adoption, err := s.getReleaseAdoption(ctx, request.Header(), cluster, installedRef.GetContext().GetNamespace(), releaseName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the adopted release: %w", err))
+	}
+
+	// The values references stored with the release are kept unless others
+	// are requested or they are explicitly cleared.
+	valuesFrom, clearValuesFrom, err := valuesFromCustomDetail(request.Msg.GetCustomDetail())
 	if err != nil {
 		return nil, err
 	}
-	chartDetails := &utils.ChartDetails{
-		AppRepositoryResourceName:      repoName,
-		AppRepositoryResourceNamespace: availablePkgRef.GetContext().GetNamespace(),
-		ChartName:                      chartName,
-		Version:                        request.Msg.GetPkgVersionReference().GetVersion(),
+	storedValuesFrom, hadValuesFrom, err := s.getReleaseValuesFrom(ctx, request.Header(), cluster, installedRef.GetContext().GetNamespace(), releaseName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the values references of the release: %w", err))
+	}
+	if len(valuesFrom) == 0 && !clearValuesFrom {
+		valuesFrom = storedValuesFrom
+	}
+
+	chartID := availablePkgRef.GetIdentifier()
+	var ch *chart.Chart
+	var registrySecrets map[string]string
+	if isOCIReference(chartID) {
+		// The installed version is kept when no version is requested, as the
+		// versions of a raw OCI reference are not known.
+		requestedVersion := request.Msg.GetPkgVersionReference().GetVersion()
+		if requestedVersion == "" {
+			requestedVersion = detailResponse.Msg.GetInstalledPackageDetail().GetCurrentVersion().GetPkgVersion()
+		}
+		ociRef, version, err := parseOCIReference(chartID, requestedVersion)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		registrySecret, err := ociRegistrySecretFromCustomDetail(request.Msg.GetCustomDetail())
+		if err != nil {
+			return nil, err
+		}
+		if adoption == nil {
+			adoption = &releaseAdoption{availablePackageRef: availablePkgRef}
+		}
+		if registrySecret != "" {
+			adoption.registrySecret = registrySecret
+		}
+		ch, err = s.fetchChartFromOCIReference(ctx, request.Header(), ociRef, version, availablePkgRef.GetContext().GetNamespace(), adoption.registrySecret)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		repoName, chartName, err := pkgutils.SplitPackageIdentifier(chartID)
+		if err != nil {
+			return nil, err
+		}
+		chartDetails := &utils.ChartDetails{
+			AppRepositoryResourceName:      repoName,
+			AppRepositoryResourceNamespace: availablePkgRef.GetContext().GetNamespace(),
+			ChartName:                      chartName,
+			Version:                        request.Msg.GetPkgVersionReference().GetVersion(),
+		}
+		ch, registrySecrets, err = s.fetchChartWithRegistrySecrets(ctx, request.Header(), chartDetails, typedClient)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+				return nil, err
+			}
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("Missing permissions %w", err))
+		}
 	}
-	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, request.Header(), chartDetails, typedClient)
+
+	referencedValues, err := s.resolveValuesFrom(ctx, request.Header(), cluster, installedRef.GetContext().GetNamespace(), valuesFrom)
 	if err != nil {
-		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("Missing permissions %w", err))
+		return nil, err
 	}
 
 	// Create an action config for the installed pkg context.
@@ -837,15 +1165,38 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Re
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
 	}
+	ch = applyValuesFrom(actionConfig, ch, referencedValues)
+
+	postRenderers, err := s.postRenderersFor(ctx, installedRef.GetContext().GetNamespace())
+	if err != nil {
+		return nil, err
+	}
 
-	release, err := agent.UpgradeRelease(actionConfig, releaseName, request.Msg.GetValues(), ch, registrySecrets, s.pluginConfig.TimeoutSeconds)
+	// A release upgraded with an available package is no longer locally
+	// sourced, but Helm keeps the labels of the previous revision.
+	labels := map[string]string{packageSourceLabel: agent.RemovedLabel}
+	release, err := agent.UpgradeRelease(actionConfig, releaseName, request.Msg.GetValues(), ch, labels, registrySecrets, postRenderers, s.pluginConfig.TimeoutSeconds, request.Msg.GetDryRun(), waitOptions)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to upgrade helm release %q in the namespace %q: %w", releaseName, installedRef.GetContext().GetNamespace(), err))
 	}
 
-	cluster := installedRef.GetContext().GetCluster()
-	if cluster == "" {
-		cluster = s.globalPackagingCluster
+	var renderedPackage *corev1.RenderedPackage
+	if request.Msg.GetDryRun() {
+		// A dry run leaves the deployed release untouched, so it can be
+		// returned alongside the rendered one for comparison.
+		current, err := agent.GetRelease(actionConfig, releaseName)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get helm release %q in the namespace %q: %w", releaseName, installedRef.GetContext().GetNamespace(), err))
+		}
+		renderedPackage, err = renderedPackageFromRelease(release, current.Manifest)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to render helm release %q in the namespace %q: %w", releaseName, installedRef.GetContext().GetNamespace(), err))
+		}
+	} else {
+		if adoption != nil {
+			s.keepReleaseAdoption(ctx, request.Header(), cluster, release.Namespace, release.Name, release.Version, adoption)
+		}
+		s.keepReleaseValuesFrom(ctx, request.Header(), cluster, release.Namespace, release.Name, release.Version, valuesFrom, hadValuesFrom)
 	}
 
 	return connect.NewResponse(&corev1.UpdateInstalledPackageResponse{
@@ -857,11 +1208,12 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Re
 			Identifier: release.Name,
 			Plugin:     GetPluginDetail(),
 		},
+		RenderedPackage: renderedPackage,
 	}), nil
 }
 
 // getAppRepoAndRelatedSecrets retrieves the given repo from its cluster and namespace
-func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, headers http.Header, cluster, appRepoName, appRepoNamespace string) (*appRepov1.AppRepository, *corek8sv1.Secret, *corek8sv1.Secret, *corek8sv1.Secret, error) {
+func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, headers http.Header, cluster, appRepoName, appRepoNamespace string) (*appRepov1.AppRepository, *corek8sv1.Secret, *corek8sv1.Secret, []*corek8sv1.Secret, error) {
 
 	// We currently get app repositories on the kubeapps cluster only.
 	typedClient, err := s.clientGetter.Typed(headers, cluster)
@@ -912,16 +1264,16 @@ func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, headers http.H
 		}
 	}
 
-	var imagesPullSecret *corek8sv1.Secret
-	if len(appRepo.Spec.DockerRegistrySecrets) > 0 {
-		secretName := appRepo.Spec.DockerRegistrySecrets[0]
-		imagesPullSecret, err = typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
+	var imagesPullSecrets []*corek8sv1.Secret
+	for _, secretName := range appRepo.Spec.DockerRegistrySecrets {
+		imagesPullSecret, err := typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
 		if err != nil {
 			return nil, nil, nil, nil, fmt.Errorf("unable to read images pull secret %q: %v", secretName, err)
 		}
+		imagesPullSecrets = append(imagesPullSecrets, imagesPullSecret)
 	}
 
-	return &appRepo, caCertSecret, authSecret, imagesPullSecret, nil
+	return &appRepo, caCertSecret, authSecret, imagesPullSecrets, nil
 }
 
 // fetchChartWithRegistrySecrets returns the chart and related registry secrets.
@@ -944,14 +1296,24 @@ func (s *Server) fetchChartWithRegistrySecrets(ctx context.Context, headers http
 	if err != nil {
 		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to fetch the chart %s (version %s) from the namespace %q: %w", chartID, chartDetails.Version, chartDetails.AppRepositoryResourceNamespace, err))
 	}
-	var tarballURL string
+	var tarballURL, digest string
 	// If the chart is cached, we can use the tarball URL from the cache,
 	// we assume cachedChart.ChartVersions only contains 1 element
 	if len(cachedChart.ChartVersions) == 1 && cachedChart.ChartVersions[0].URLs != nil {
 		tarballURL = chartTarballURL(cachedChart.Repo, cachedChart.ChartVersions[0])
+		digest = cachedChart.ChartVersions[0].Digest
 		log.InfoS("Using chart tarball", "url", tarballURL)
 	}
 
+	var verificationSecret *corek8sv1.Secret
+	if appRepo.Spec.Verification != nil {
+		secretName := appRepo.Spec.Verification.SecretKeyRef.Name
+		verificationSecret, err = client.CoreV1().Secrets(appRepo.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to read the verification secret %q from the namespace %q: %w", secretName, appRepo.Namespace, err))
+		}
+	}
+
 	// Grab the chart itself
 	ch, err := utils.GetChart(
 		&utils.ChartDetails{
@@ -960,11 +1322,16 @@ func (s *Server) fetchChartWithRegistrySecrets(ctx context.Context, headers http
 			ChartName:                      chartDetails.ChartName,
 			Version:                        chartDetails.Version,
 			TarballURL:                     tarballURL,
+			Digest:                         digest,
 		},
 		appRepo,
-		caCertSecret, authSecret,
+		caCertSecret, authSecret, verificationSecret,
 		s.chartClientFactory.New(tarballURL, userAgentString),
+		s.chartCache,
 	)
+	if errors.Is(err, kubeappshelm.ErrChartVerificationFailed) {
+		return nil, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("Unable to verify the chart %s from the namespace %q: %w", chartDetails.ChartName, appRepo.Namespace, err))
+	}
 	if err != nil {
 		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to fetch the chart %s from the namespace %q: %w", chartDetails.ChartName, appRepo.Namespace, err))
 	}
@@ -1031,19 +1398,16 @@ func (s *Server) RollbackInstalledPackage(ctx context.Context, request *connect.
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
 	}
 
-	release, err := agent.RollbackRelease(actionConfig, releaseName, int(request.Msg.GetReleaseRevision()), s.pluginConfig.TimeoutSeconds)
-	if err != nil {
-		if errors.Is(err, driver.ErrReleaseNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find Helm release %q in namespace %q: %+w", releaseName, installedRef.GetContext().GetNamespace(), err))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to rollback helm release %q in the namespace %q: %w", releaseName, installedRef.GetContext().GetNamespace(), err))
-	}
-
 	cluster := installedRef.GetContext().GetCluster()
 	if cluster == "" {
 		cluster = s.globalPackagingCluster
 	}
 
+	release, err := s.rollbackRelease(ctx, request.Header(), actionConfig, cluster, installedRef.GetContext().GetNamespace(), releaseName, int(request.Msg.GetReleaseRevision()))
+	if err != nil {
+		return nil, err
+	}
+
 	return connect.NewResponse(&helmv1.RollbackInstalledPackageResponse{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
@@ -1056,6 +1420,149 @@ func (s *Server) RollbackInstalledPackage(ctx context.Context, request *connect.
 	}), nil
 }
 
+// rollbackRelease rolls back a release to the revision, keeping its adoption
+// and its values references.
+func (s *Server) rollbackRelease(ctx context.Context, headers http.Header, actionConfig *action.Configuration, cluster, namespace, releaseName string, revision int) (*release.Release, error) {
+	// Helm replaces the secret of the current revision when rolling back, so
+	// the adoption of the release must be retrieved beforehand.
+	adoption, err := s.getReleaseAdoption(ctx, headers, cluster, namespace, releaseName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the adopted release: %w", err))
+	}
+	valuesFrom, hadValuesFrom, err := s.getReleaseValuesFrom(ctx, headers, cluster, namespace, releaseName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the values references of the release: %w", err))
+	}
+
+	release, err := agent.RollbackRelease(actionConfig, releaseName, revision, s.pluginConfig.TimeoutSeconds)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find Helm release %q in namespace %q: %+w", releaseName, namespace, err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to rollback helm release %q in the namespace %q: %w", releaseName, namespace, err))
+	}
+	if adoption != nil {
+		s.keepReleaseAdoption(ctx, headers, cluster, release.Namespace, release.Name, release.Version, adoption)
+	}
+	s.keepReleaseValuesFrom(ctx, headers, cluster, release.Namespace, release.Name, release.Version, valuesFrom, hadValuesFrom)
+	return release, nil
+}
+
+// GetInstalledPackageRevisions returns the revisions of an installed package,
+// as recorded in the Helm release history.
+func (s *Server) GetInstalledPackageRevisions(ctx context.Context, request *connect.Request[helmv1.GetInstalledPackageRevisionsRequest]) (*connect.Response[helmv1.GetInstalledPackageRevisionsResponse], error) {
+	installedRef := request.Msg.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	log.InfoS("+helm GetInstalledPackageRevisions", "cluster", installedRef.GetContext().GetCluster(), "namespace", installedRef.GetContext().GetNamespace(), "id", releaseName)
+
+	// Create an action config for the installed pkg context.
+	actionConfig, err := s.actionConfigGetter(request.Header(), installedRef.GetContext())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
+	}
+
+	history, err := agent.GetReleaseHistory(actionConfig, releaseName, int(request.Msg.GetMaxRevisions()))
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find Helm release %q in namespace %q: %+w", releaseName, installedRef.GetContext().GetNamespace(), err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the history of helm release %q in the namespace %q: %w", releaseName, installedRef.GetContext().GetNamespace(), err))
+	}
+
+	revisions := make([]*helmv1.InstalledPackageRevision, 0, len(history))
+	for _, r := range history {
+		revision, err := installedPkgRevisionFromRelease(r)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create installed package revision from release: %w", err))
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return connect.NewResponse(&helmv1.GetInstalledPackageRevisionsResponse{
+		Revisions: revisions,
+	}), nil
+}
+
+// GetInstalledPackageRevisionsDiff returns the differences between the
+// user-supplied values and the rendered manifests of two revisions of an
+// installed package.
+func (s *Server) GetInstalledPackageRevisionsDiff(ctx context.Context, request *connect.Request[helmv1.GetInstalledPackageRevisionsDiffRequest]) (*connect.Response[helmv1.GetInstalledPackageRevisionsDiffResponse], error) {
+	installedRef := request.Msg.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	log.InfoS("+helm GetInstalledPackageRevisionsDiff", "cluster", installedRef.GetContext().GetCluster(), "namespace", namespace, "id", releaseName)
+
+	if request.Msg.GetFromRevision() <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Invalid FromRevision: [%d]", request.Msg.GetFromRevision()))
+	}
+	if request.Msg.GetToRevision() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Invalid ToRevision: [%d]", request.Msg.GetToRevision()))
+	}
+
+	// Create an action config for the installed pkg context.
+	actionConfig, err := s.actionConfigGetter(request.Header(), installedRef.GetContext())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
+	}
+
+	revisions := []*release.Release{}
+	for _, revision := range []int32{request.Msg.GetFromRevision(), request.Msg.GetToRevision()} {
+		// A zero revision gets the current release.
+		r, err := agent.GetReleaseRevision(actionConfig, releaseName, int(revision))
+		if err != nil {
+			if errors.Is(err, driver.ErrReleaseNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to find revision %d of Helm release %q in namespace %q: %+w", revision, releaseName, namespace, err))
+			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get revision %d of Helm release %q in namespace %q: %w", revision, releaseName, namespace, err))
+		}
+		revisions = append(revisions, r)
+	}
+	from, to := revisions[0], revisions[1]
+
+	valuesDiff, err := diffValues(from.Config, to.Config)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to compare the values of Helm release %q: %w", releaseName, err))
+	}
+	manifestDiff, err := diffManifests(from.Manifest, to.Manifest, from.Namespace)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to compare the manifests of Helm release %q: %w", releaseName, err))
+	}
+
+	return connect.NewResponse(&helmv1.GetInstalledPackageRevisionsDiffResponse{
+		ValuesDiff:   valuesDiff,
+		ManifestDiff: manifestDiff,
+	}), nil
+}
+
+func installedPkgRevisionFromRelease(r *release.Release) (*helmv1.InstalledPackageRevision, error) {
+	valuesMarshalled, err := json.Marshal(r.Config)
+	if err != nil {
+		return nil, err
+	}
+	revision := &helmv1.InstalledPackageRevision{
+		ReleaseRevision: int32(r.Version),
+		ValuesApplied:   string(valuesMarshalled),
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		revision.Version = &corev1.PackageAppVersion{
+			PkgVersion: r.Chart.Metadata.Version,
+			AppVersion: r.Chart.Metadata.AppVersion,
+		}
+	}
+	if r.Info != nil {
+		revision.Status = &corev1.InstalledPackageStatus{
+			Ready:      r.Info.Status == release.StatusDeployed,
+			Reason:     statusReasonForHelmStatus(r.Info.Status),
+			UserReason: r.Info.Status.String(),
+		}
+		revision.Description = r.Info.Description
+		if !r.Info.LastDeployed.IsZero() {
+			revision.LastDeployed = timestamppb.New(r.Info.LastDeployed.Time)
+		}
+	}
+	return revision, nil
+}
+
 // GetInstalledPackageResourceRefs returns the references for the Kubernetes
 // resources created by an installed package.
 func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *connect.Request[corev1.GetInstalledPackageResourceRefsRequest]) (*connect.Response[corev1.GetInstalledPackageResourceRefsResponse], error) {
@@ -1165,13 +1672,13 @@ func (s *Server) GetPackageRepositoryDetail(ctx context.Context, request *connec
 	log.InfoS("+helm GetPackageRepositoryDetail", "cluster", cluster, "namespace", namespace, "name", name)
 
 	// Retrieve repository
-	appRepo, caCertSecret, authSecret, imagesPullSecret, err := s.getAppRepoAndRelatedSecrets(ctx, request.Header(), cluster, name, namespace)
+	appRepo, caCertSecret, authSecret, imagesPullSecrets, err := s.getAppRepoAndRelatedSecrets(ctx, request.Header(), cluster, name, namespace)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to retrieve AppRepository '%s/%s' due to [%w]", namespace, name, err))
 	}
 
 	// Map to target struct
-	repositoryDetail, err := s.mapToPackageRepositoryDetail(appRepo, cluster, namespace, caCertSecret, authSecret, imagesPullSecret)
+	repositoryDetail, err := s.mapToPackageRepositoryDetail(appRepo, cluster, namespace, caCertSecret, authSecret, imagesPullSecrets)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to convert the AppRepository: %w", err))
 	}
@@ -1213,7 +1720,7 @@ func (s *Server) UpdatePackageRepository(ctx context.Context, request *connect.R
 	log.InfoS("+helm UpdatePackageRepository", "cluster", cluster, "namespace", namespace, "name", name)
 
 	// Retrieve repository
-	appRepo, caCertSecret, authSecret, imagesPullSecret, err := s.getAppRepoAndRelatedSecrets(ctx, request.Header(), cluster, name, namespace)
+	appRepo, caCertSecret, authSecret, imagesPullSecrets, err := s.getAppRepoAndRelatedSecrets(ctx, request.Header(), cluster, name, namespace)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Unable to retrieve AppRepository '%s/%s' due to [%w]", namespace, name, err))
 	}
@@ -1241,7 +1748,7 @@ func (s *Server) UpdatePackageRepository(ctx context.Context, request *connect.R
 		auth:         request.Msg.GetAuth(),
 		customDetail: customDetail,
 	}
-	if responseRef, err := s.updateRepo(ctx, request.Header(), appRepo, caCertSecret, authSecret, imagesPullSecret, helmRepo); err != nil {
+	if responseRef, err := s.updateRepo(ctx, request.Header(), appRepo, caCertSecret, authSecret, imagesPullSecrets, helmRepo); err != nil {
 		return nil, err
 	} else {
 		return connect.NewResponse(&corev1.UpdatePackageRepositoryResponse{
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	sqldriver "database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -50,6 +51,7 @@ import (
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
 	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -400,14 +402,16 @@ func newServerWithAppRepoReactors(unstructuredObjs []k8sruntime.Object, repos []
 
 func TestGetAvailablePackageSummaries(t *testing.T) {
 	testCases := []struct {
-		name                   string
-		charts                 []*models.Chart
-		expectDBQueryNamespace string
-		errorCode              connect.Code
-		request                *corev1.GetAvailablePackageSummariesRequest
-		expectedResponse       *corev1.GetAvailablePackageSummariesResponse
-		authorized             bool
-		expectedCategories     []*models.ChartCategory
+		name                    string
+		charts                  []*models.Chart
+		existingNamespaces      []string
+		expectDBQueryNamespace  string
+		expectDBQueryNamespaces []string
+		errorCode               connect.Code
+		request                 *corev1.GetAvailablePackageSummariesRequest
+		expectedResponse        *corev1.GetAvailablePackageSummariesResponse
+		authorized              bool
+		expectedCategories      []*models.ChartCategory
 	}{
 		{
 			name:       "it returns a set of availablePackageSummary from the database (global ns)",
@@ -582,15 +586,90 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			},
 		},
 		{
-			name:       "it returns a unimplemented status if no namespaces is provided",
+			name:               "it returns the packages of every accessible namespace if no namespace is provided",
+			authorized:         true,
+			existingNamespaces: []string{"my-ns", "other-ns"},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Namespace: "",
+				},
+			},
+			expectDBQueryNamespaces: []string{"my-ns", "other-ns"},
+			charts: []*models.Chart{
+				makeChart("chart-1", "repo-1", "http://chart-1", "my-ns", []string{"2.0.0", "3.0.0"}, DefaultChartCategory),
+				makeChart("chart-2", "repo-2", "http://chart-2", "other-ns", []string{"1.0.0"}, DefaultChartCategory),
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					{
+						Name:        "chart-1",
+						DisplayName: "chart-1",
+						LatestVersion: &corev1.PackageAppVersion{
+							PkgVersion: "3.0.0",
+							AppVersion: DefaultAppVersion,
+						},
+						IconUrl:          DefaultChartIconURL,
+						Categories:       []string{DefaultChartCategory},
+						ShortDescription: DefaultChartDescription,
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: "my-ns"},
+							Identifier: "repo-1/chart-1",
+							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+						},
+					},
+					{
+						Name:        "chart-2",
+						DisplayName: "chart-2",
+						LatestVersion: &corev1.PackageAppVersion{
+							PkgVersion: "1.0.0",
+							AppVersion: DefaultAppVersion,
+						},
+						IconUrl:          DefaultChartIconURL,
+						Categories:       []string{DefaultChartCategory},
+						ShortDescription: DefaultChartDescription,
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: "other-ns"},
+							Identifier: "repo-2/chart-2",
+							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+						},
+					},
+				},
+				Categories: []string{"cat1"},
+			},
+		},
+		{
+			name:       "it returns only the global packages if no namespace is provided and none is accessible",
 			authorized: true,
 			request: &corev1.GetAvailablePackageSummariesRequest{
 				Context: &corev1.Context{
 					Namespace: "",
 				},
 			},
-			charts:    []*models.Chart{},
-			errorCode: connect.CodeUnimplemented,
+			expectDBQueryNamespace: globalPackagingNamespace,
+			charts: []*models.Chart{
+				makeChart("chart-1", "repo-1", "http://chart-1", globalPackagingNamespace, []string{"1.0.0"}, DefaultChartCategory),
+			},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					{
+						Name:        "chart-1",
+						DisplayName: "chart-1",
+						LatestVersion: &corev1.PackageAppVersion{
+							PkgVersion: "1.0.0",
+							AppVersion: DefaultAppVersion,
+						},
+						IconUrl:          DefaultChartIconURL,
+						Categories:       []string{DefaultChartCategory},
+						ShortDescription: DefaultChartDescription,
+						AvailablePackageRef: &corev1.AvailablePackageReference{
+							Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: globalPackagingNamespace},
+							Identifier: "repo-1/chart-1",
+							Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+						},
+					},
+				},
+				Categories: []string{"cat1"},
+			},
 		},
 		{
 			name:       "it returns an internal error status if response does not contain version",
@@ -793,6 +872,17 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			server, mock, cleanup := makeServer(t, tc.authorized, nil)
 			defer cleanup()
 
+			if len(tc.existingNamespaces) > 0 {
+				namespaces := []k8sruntime.Object{}
+				for _, ns := range tc.existingNamespaces {
+					namespaces = append(namespaces, &apiv1.Namespace{
+						ObjectMeta: metav1.ObjectMeta{Name: ns},
+						Status:     apiv1.NamespaceStatus{Phase: apiv1.NamespaceActive},
+					})
+				}
+				server.clientGetter = clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset(namespaces...)).Build()
+			}
+
 			// Simulate the pagination by reducing the rows of JSON based on the offset and limit.
 			// TODO(mnelson): We should check the LIMIT and OFFSET in the actual query as well.
 			rowsJSON := makeChartRowsJSON(t, tc.charts, tc.request.GetPaginationOptions().GetPageToken(), int(tc.request.GetPaginationOptions().GetPageSize()))
@@ -802,8 +892,18 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 				rows.AddRow(row)
 			}
 
-			if tc.expectDBQueryNamespace != "" {
-				// Checking if the WHERE condition is properly applied
+			// Checking if the WHERE condition is properly applied
+			queryArgs := []sqldriver.Value{}
+			if len(tc.expectDBQueryNamespaces) > 0 {
+				for _, ns := range tc.expectDBQueryNamespaces {
+					queryArgs = append(queryArgs, ns)
+				}
+				queryArgs = append(queryArgs, server.globalPackagingNamespace)
+			} else if tc.expectDBQueryNamespace != "" {
+				queryArgs = append(queryArgs, tc.expectDBQueryNamespace, server.globalPackagingNamespace)
+			}
+
+			if len(queryArgs) > 0 {
 
 				// Check returned categories
 				catrows := sqlmock.NewRows([]string{"name", "count"})
@@ -824,11 +924,11 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 				}
 
 				mock.ExpectQuery("SELECT (info ->> 'category')*").
-					WithArgs(tc.expectDBQueryNamespace, server.globalPackagingNamespace).
+					WithArgs(queryArgs...).
 					WillReturnRows(catrows)
 
 				mock.ExpectQuery("SELECT info FROM").
-					WithArgs(tc.expectDBQueryNamespace, server.globalPackagingNamespace).
+					WithArgs(queryArgs...).
 					WillReturnRows(rows)
 			}
 
@@ -1435,6 +1535,122 @@ core:
 		})
 	}
 }
+func TestParsePluginConfigHelmPackages(t *testing.T) {
+	testCases := []struct {
+		name              string
+		pluginYAMLConf    []byte
+		exp_postRenderers []common.PostRendererConfig
+		exp_chartCache    common.ChartCacheConfig
+		exp_error_str     string
+	}{
+		{
+			name: "post-renderers specified in plugin config",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      postRenderers:
+        - type: labels
+          labels:
+            team: payments
+        - type: patches
+          namespaces:
+            - production
+          configMaps:
+            - production-patches
+        - type: imagePullSecrets
+          imagePullSecrets:
+            - default-secret
+      `),
+			exp_postRenderers: []common.PostRendererConfig{
+				{Type: common.PostRendererTypeLabels, Labels: map[string]string{"team": "payments"}},
+				{Type: common.PostRendererTypePatches, Namespaces: []string{"production"}, ConfigMaps: []string{"production-patches"}},
+				{Type: common.PostRendererTypeImagePullSecrets, ImagePullSecrets: []string{"default-secret"}},
+			},
+		},
+		{
+			name: "chart cache specified in plugin config",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      chartCache:
+        directory: /tmp/chart-cache
+        maxSizeBytes: 1073741824
+      `),
+			exp_chartCache: common.ChartCacheConfig{Directory: "/tmp/chart-cache", MaxSizeBytes: 1073741824},
+		},
+		{
+			name: "negative chart cache size in plugin config",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      chartCache:
+        maxSizeBytes: -1
+      `),
+			exp_error_str: "invalid chart cache size -1",
+		},
+		{
+			name: "unsupported storage driver in plugin config",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      clusterStorageDrivers:
+        other-cluster: memory
+      `),
+			exp_error_str: "unsupported helm storage driver \"memory\"",
+		},
+		{
+			name: "unsupported post-renderer type in plugin config",
+			pluginYAMLConf: []byte(`
+helm:
+  packages:
+    v1alpha1:
+      postRenderers:
+        - type: kustomize
+      `),
+			exp_error_str: "unsupported type \"kustomize\"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginJSONConf, err := yaml.YAMLToJSON(tc.pluginYAMLConf)
+			if err != nil {
+				log.Fatalf("%s", err)
+			}
+			f, err := os.CreateTemp(".", "plugin_json_conf")
+			if err != nil {
+				log.Fatalf("%s", err)
+			}
+			defer os.Remove(f.Name()) // clean up
+			if _, err := f.Write(pluginJSONConf); err != nil {
+				log.Fatalf("%s", err)
+			}
+			if err := f.Close(); err != nil {
+				log.Fatalf("%s", err)
+			}
+			pluginConfig, err := common.ParsePluginConfig(f.Name())
+			if tc.exp_error_str != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.exp_error_str) {
+					t.Errorf("err got %+v, want to find %q", err, tc.exp_error_str)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := pluginConfig.PostRenderers, tc.exp_postRenderers; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := pluginConfig.ChartCache, tc.exp_chartCache; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageSummaries(t *testing.T) {
 	testCases := []struct {
 		name               string
@@ -2144,6 +2360,7 @@ func releaseForStub(t *testing.T, r releaseStub) *release.Release {
 			},
 		},
 		Config: config,
+		Hooks:  r.hooks,
 	}
 }
 
@@ -2249,6 +2466,7 @@ type releaseStub struct {
 	notes          string
 	status         release.Status
 	manifest       string
+	hooks          []*release.Hook
 }
 
 func newFakeOCIServer(t *testing.T, responses map[string]*http.Response) *httptest.Server {
@@ -0,0 +1,99 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/release"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// packageSourceLabel is the Helm release label identifying a release
+	// installed from an uploaded chart archive. Unlike the adoption annotations,
+	// Helm stores the labels of a release with each revision.
+	packageSourceLabel      = "kubeapps.dev/package-source"
+	packageSourceLabelLocal = "local"
+)
+
+// InstallPackageFromTarball installs or upgrades a release from an uploaded chart archive.
+func (s *Server) InstallPackageFromTarball(ctx context.Context, request *connect.Request[helmv1.InstallPackageFromTarballRequest]) (*connect.Response[helmv1.InstallPackageFromTarballResponse], error) {
+	targetContext := request.Msg.GetTargetContext()
+	log.InfoS("+helm InstallPackageFromTarball", "cluster", targetContext.GetCluster(), "namespace", targetContext.GetNamespace(), "name", request.Msg.GetName())
+
+	if request.Msg.GetName() == "" || targetContext.GetNamespace() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Required name or target namespace not provided"))
+	}
+	ch, err := loadChartTarball(request.Msg.GetChartTarball())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	actionConfig, err := s.actionConfigGetter(request.Header(), targetContext)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create Helm action config: %w", err))
+	}
+	postRenderers, err := s.postRenderersFor(ctx, targetContext.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string]string{packageSourceLabel: packageSourceLabelLocal}
+	release, upgraded, err := agent.InstallOrUpgradeRelease(actionConfig, request.Msg.GetName(), targetContext.GetNamespace(), request.Msg.GetValues(), ch, labels, postRenderers, s.pluginConfig.TimeoutSeconds, agent.WaitOptions{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to install helm release %q in the namespace %q from the chart archive: %w", request.Msg.GetName(), targetContext.GetNamespace(), err))
+	}
+
+	cluster := targetContext.GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	return connect.NewResponse(&helmv1.InstallPackageFromTarballResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: release.Namespace,
+			},
+			Identifier: release.Name,
+			Plugin:     GetPluginDetail(),
+		},
+		Upgraded: upgraded,
+	}), nil
+}
+
+// loadChartTarball validates and loads a gzipped chart archive.
+func loadChartTarball(chartTarball []byte) (*chart.Chart, error) {
+	if len(chartTarball) == 0 {
+		return nil, fmt.Errorf("Required chart archive not provided")
+	}
+	details, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(chartTarball))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the chart archive: %w", err)
+	}
+	if details[models.ChartYamlKey] == "" {
+		return nil, fmt.Errorf("The chart archive does not contain a Chart.yaml file")
+	}
+	ch, err := loader.LoadArchive(bytes.NewReader(chartTarball))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load the chart archive: %w", err)
+	}
+	return ch, nil
+}
+
+// isLocallySourced returns whether a release was installed or last upgraded
+// from an uploaded chart archive. The label is removed by the upgrades using an
+// available package.
+func isLocallySourced(r *release.Release) bool {
+	return r.Labels[packageSourceLabel] == packageSourceLabelLocal
+}
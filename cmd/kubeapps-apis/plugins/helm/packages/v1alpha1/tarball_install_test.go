@@ -0,0 +1,237 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"helm.sh/helm/v3/pkg/release"
+	typfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestInstallPackageFromTarball(t *testing.T) {
+	chartTarball, err := os.ReadFile("./utils/testdata/nginx-5.1.1-apiVersionV2.tgz")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name              string
+		existingReleases  []releaseStub
+		request           *helmv1.InstallPackageFromTarballRequest
+		expectedResponse  *helmv1.InstallPackageFromTarballResponse
+		expectedRevision  int
+		expectedErrorCode connect.Code
+	}{
+		{
+			name: "installs the chart archive",
+			request: &helmv1.InstallPackageFromTarballRequest{
+				TargetContext: &corev1.Context{Namespace: "default"},
+				Name:          "my-nginx",
+				ChartTarball:  chartTarball,
+				Values:        "{\"replicaCount\": 2}",
+			},
+			expectedResponse: &helmv1.InstallPackageFromTarballResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-nginx",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedRevision: 1,
+		},
+		{
+			name: "upgrades an existing release with the chart archive",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-nginx",
+					namespace:    "default",
+					version:      1,
+					chartVersion: "5.1.0",
+					status:       release.StatusDeployed,
+				},
+			},
+			request: &helmv1.InstallPackageFromTarballRequest{
+				TargetContext: &corev1.Context{Namespace: "default"},
+				Name:          "my-nginx",
+				ChartTarball:  chartTarball,
+			},
+			expectedResponse: &helmv1.InstallPackageFromTarballResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-nginx",
+					Plugin:     GetPluginDetail(),
+				},
+				Upgraded: true,
+			},
+			expectedRevision: 2,
+		},
+		{
+			name: "returns invalid argument for an invalid chart archive",
+			request: &helmv1.InstallPackageFromTarballRequest{
+				TargetContext: &corev1.Context{Namespace: "default"},
+				Name:          "my-nginx",
+				ChartTarball:  []byte("not a chart"),
+			},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "returns invalid argument without name",
+			request: &helmv1.InstallPackageFromTarballRequest{
+				TargetContext: &corev1.Context{Namespace: "default"},
+				ChartTarball:  chartTarball,
+			},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", tc.existingReleases, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+
+			response, err := server.InstallPackageFromTarball(context.Background(), connect.NewRequest(tc.request))
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(helmv1.InstallPackageFromTarballResponse{}, corev1.InstalledPackageReference{}, corev1.Context{}, plugins.Plugin{})
+			if got, want := response.Msg, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			rel, err := actionConfig.Releases.Get("my-nginx", tc.expectedRevision)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := rel.Chart.Metadata.Version, "5.1.1"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if !isLocallySourced(rel) {
+				t.Errorf("the release %q is not labeled as locally sourced: %+v", rel.Name, rel.Labels)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageDetailLocallySourced(t *testing.T) {
+	actionConfig := newActionConfigFixture(t, "default", []releaseStub{
+		{
+			name:         "my-nginx",
+			namespace:    "default",
+			version:      1,
+			chartVersion: "5.1.1",
+			status:       release.StatusDeployed,
+		},
+	}, nil)
+	rel, err := actionConfig.Releases.Get("my-nginx", 1)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	rel.Labels = map[string]string{packageSourceLabel: packageSourceLabelLocal}
+	if err := actionConfig.Releases.Update(rel); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	server, mock, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+	server.clientGetter = clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset()).Build()
+
+	// No chart matching the release is looked up.
+	response, err := server.GetInstalledPackageDetail(context.Background(), connect.NewRequest(&corev1.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Namespace: "default"},
+			Identifier: "my-nginx",
+		},
+	}))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	detail := response.Msg.GetInstalledPackageDetail()
+	if got := detail.GetAvailablePackageRef(); got != nil {
+		t.Errorf("got: %+v, want: nil", got)
+	}
+	customDetail := &helmv1.InstalledPackageDetailCustomDataHelm{}
+	if err := detail.GetCustomDetail().UnmarshalTo(customDetail); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !customDetail.GetLocallySourced() {
+		t.Errorf("the installed package is not reported as locally sourced")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestUpdateInstalledPackageLocallySourced(t *testing.T) {
+	chartTarball, err := os.ReadFile("./utils/testdata/nginx-5.1.1-apiVersionV2.tgz")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	actionConfig := newActionConfigFixture(t, "default", nil, nil)
+	server, _, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+	installedRef := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Namespace: "default"},
+		Identifier: "my-nginx",
+	}
+
+	_, err = server.InstallPackageFromTarball(context.Background(), connect.NewRequest(&helmv1.InstallPackageFromTarballRequest{
+		TargetContext: installedRef.Context,
+		Name:          installedRef.Identifier,
+		ChartTarball:  chartTarball,
+	}))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// The release is then adopted and upgraded with an available package.
+	server.clientGetter = clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset(
+		newReleaseSecret("my-nginx", "default", 1, "oci://registry.example.com/charts/nginx", globalPackagingNamespace),
+		newReleaseSecret("my-nginx", "default", 2, "", ""),
+	)).Build()
+	_, err = server.UpdateInstalledPackage(context.Background(), connect.NewRequest(&corev1.UpdateInstalledPackageRequest{
+		InstalledPackageRef: installedRef,
+		PkgVersionReference: &corev1.VersionReference{Version: "5.1.2"},
+	}))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	rel, err := actionConfig.Releases.Get("my-nginx", 2)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if isLocallySourced(rel) {
+		t.Errorf("the upgraded release %q is still labeled as locally sourced: %+v", rel.Name, rel.Labels)
+	}
+	if got, want := rel.Labels, map[string]string{}; !cmp.Equal(want, got, cmpopts.EquateEmpty()) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, cmpopts.EquateEmpty()))
+	}
+}
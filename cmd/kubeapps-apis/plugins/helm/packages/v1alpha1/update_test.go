@@ -135,6 +135,48 @@ func TestUpdateInstalledPackage(t *testing.T) {
 				Labels:    map[string]string{},
 			},
 		},
+		{
+			name: "renders the updated package without upgrading it for a dry run",
+			existingReleases: []releaseStub{
+				{
+					name:           "my-apache",
+					namespace:      "default",
+					chartID:        "bitnami/apache",
+					chartVersion:   "1.18.3",
+					chartNamespace: globalPackagingNamespace,
+					status:         release.StatusDeployed,
+					manifest:       "apiVersion: v1\nkind: Service\nmetadata:\n  name: my-apache\n",
+				},
+			},
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+				Values: "{\"foo\": \"baz\"}",
+				DryRun: true,
+			},
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+				RenderedPackage: &corev1.RenderedPackage{
+					ResourceRefs:    []*corev1.ResourceRef{},
+					CurrentManifest: "apiVersion: v1\nkind: Service\nmetadata:\n  name: my-apache\n",
+				},
+			},
+		},
 		{
 			name: "returns invalid if installed package doesn't exist",
 			request: &corev1.UpdateInstalledPackageRequest{
@@ -152,6 +194,7 @@ func TestUpdateInstalledPackage(t *testing.T) {
 	ignoredUnexported := cmpopts.IgnoreUnexported(
 		corev1.UpdateInstalledPackageResponse{},
 		corev1.InstalledPackageReference{},
+		corev1.RenderedPackage{},
 		corev1.Context{},
 		plugins.Plugin{},
 		chart.Chart{},
@@ -171,6 +214,8 @@ func TestUpdateInstalledPackage(t *testing.T) {
 			populateAssetDB(t, mockDB, tc.existingReleases)
 			if tc.expectedRelease != nil {
 				populateAssetForTarball(t, mockDB, fmt.Sprintf("bitnami%%%s", tc.expectedRelease.Chart.Metadata.Name), globalPackagingNamespace, tc.expectedRelease.Chart.Metadata.Version)
+			} else if tc.request.GetDryRun() {
+				populateAssetForTarball(t, mockDB, "bitnami%apache", globalPackagingNamespace, tc.request.GetPkgVersionReference().GetVersion())
 			}
 			response, err := server.UpdateInstalledPackage(context.Background(), connect.NewRequest(tc.request))
 
@@ -204,6 +249,20 @@ func TestUpdateInstalledPackage(t *testing.T) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported, ignoredFields))
 				}
 			}
+
+			if tc.request.GetDryRun() {
+				// Verify that the deployed release was left untouched.
+				releases, err := actionConfig.Releases.Driver.List(func(*release.Release) bool { return true })
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if got, want := len(releases), len(tc.existingReleases); got != want {
+					t.Fatalf("got: %d, want: %d", got, want)
+				}
+				if got, want := releases[0].Chart.Metadata.Version, tc.existingReleases[0].chartVersion; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,211 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	log "k8s.io/klog/v2"
+)
+
+const chartCacheFileExtension = ".tgz"
+
+var sha256DigestRegex = regexp.MustCompile("^[a-f0-9]{64}$")
+
+// ChartCache is a content-addressed cache of chart tarballs, keyed by their
+// sha256 digest as published in the repository index. When the total size of
+// the tarballs exceeds the maximum size, the least recently used ones are
+// evicted. The tarballs are kept in memory, or in a directory if one is given,
+// in which case they are also available after a restart.
+type ChartCache struct {
+	mutex   sync.Mutex
+	dir     string
+	maxSize int64
+	size    int64
+	// lru holds the *chartCacheEntry from the most to the least recently used.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type chartCacheEntry struct {
+	digest string
+	size   int64
+	// data is only set for the in-memory caches.
+	data []byte
+}
+
+// NewChartCache returns a cache of at most maxSize bytes of chart tarballs.
+// The tarballs are kept in memory if dir is empty.
+func NewChartCache(dir string, maxSize int64) (*ChartCache, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("the maximum size of the chart cache must be positive, got %d", maxSize)
+	}
+	c := &ChartCache{
+		dir:     dir,
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+	if dir != "" {
+		if err := c.loadDir(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// loadDir indexes the tarballs already stored in the directory of the cache,
+// the most recently modified being the most recently used.
+func (c *ChartCache) loadDir() error {
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return fmt.Errorf("unable to create the chart cache directory %q: %w", c.dir, err)
+	}
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("unable to read the chart cache directory %q: %w", c.dir, err)
+	}
+	infos := []os.FileInfo{}
+	for _, file := range files {
+		digest := strings.TrimSuffix(file.Name(), chartCacheFileExtension)
+		if file.IsDir() || !sha256DigestRegex.MatchString(digest) || digest+chartCacheFileExtension != file.Name() {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			return err
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().Before(infos[j].ModTime())
+	})
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, info := range infos {
+		digest := strings.TrimSuffix(info.Name(), chartCacheFileExtension)
+		c.entries[digest] = c.lru.PushFront(&chartCacheEntry{digest: digest, size: info.Size()})
+		c.size += info.Size()
+	}
+	c.evict()
+	return nil
+}
+
+// Get returns the tarball with the digest, if cached.
+func (c *ChartCache) Get(digest string) ([]byte, bool) {
+	digest = normalizeDigest(digest)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[digest]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*chartCacheEntry)
+	data := entry.data
+	if c.dir != "" {
+		var err error
+		data, err = os.ReadFile(c.path(digest))
+		if err != nil {
+			log.Warningf("Unable to read the cached chart %s, removing it from the cache: %v", digest, err)
+			c.remove(element)
+			return nil, false
+		}
+	}
+	c.lru.MoveToFront(element)
+	return data, true
+}
+
+// Add caches the tarball with the digest, evicting the least recently used
+// tarballs if needed. It returns an error if the digest is not the sha256
+// digest of the tarball.
+func (c *ChartCache) Add(digest string, data []byte) error {
+	digest = normalizeDigest(digest)
+	if !sha256DigestRegex.MatchString(digest) {
+		return fmt.Errorf("unsupported chart digest %q, expected a sha256 digest", digest)
+	}
+	sum := sha256.Sum256(data)
+	if actual := hex.EncodeToString(sum[:]); actual != digest {
+		return fmt.Errorf("the chart tarball digest %q does not match the expected digest %q", actual, digest)
+	}
+	size := int64(len(data))
+	if size > c.maxSize {
+		return fmt.Errorf("the chart tarball of %d bytes exceeds the size of the cache", size)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[digest]; ok {
+		c.lru.MoveToFront(element)
+		return nil
+	}
+	entry := &chartCacheEntry{digest: digest, size: size}
+	if c.dir == "" {
+		entry.data = data
+	} else if err := writeFileAtomically(c.path(digest), data); err != nil {
+		return err
+	}
+	c.entries[digest] = c.lru.PushFront(entry)
+	c.size += size
+	c.evict()
+	return nil
+}
+
+// evict removes the least recently used tarballs until the cache fits its
+// maximum size. The mutex must be held.
+func (c *ChartCache) evict() {
+	for c.size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove removes a tarball from the cache. The mutex must be held.
+func (c *ChartCache) remove(element *list.Element) {
+	entry := element.Value.(*chartCacheEntry)
+	c.lru.Remove(element)
+	delete(c.entries, entry.digest)
+	c.size -= entry.size
+	if c.dir != "" {
+		if err := os.Remove(c.path(entry.digest)); err != nil && !os.IsNotExist(err) {
+			log.Warningf("Unable to remove the cached chart %s: %v", entry.digest, err)
+		}
+	}
+}
+
+func (c *ChartCache) path(digest string) string {
+	return filepath.Join(c.dir, digest+chartCacheFileExtension)
+}
+
+// normalizeDigest returns the hex encoded digest, without the algorithm
+// prefix used by OCI registries.
+func normalizeDigest(digest string) string {
+	return strings.ToLower(strings.TrimPrefix(digest, "sha256:"))
+}
+
+// writeFileAtomically writes the file through a temporary file, so that a
+// partially written tarball is never read from the cache.
+func writeFileAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appRepov1 "github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestChartCache(t *testing.T) {
+	one, two, three := []byte("chart one"), []byte("chart two"), []byte("chart three")
+
+	testCases := []struct {
+		name string
+		dir  bool
+	}{
+		{name: "in memory"},
+		{name: "on disk", dir: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := ""
+			if tc.dir {
+				dir = t.TempDir()
+			}
+			// The cache fits two of the tarballs.
+			cache, err := NewChartCache(dir, int64(len(one)+len(three)))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if err := cache.Add(sha256Digest(one), one); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if err := cache.Add("sha256:"+sha256Digest(two), two); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			// Using the first tarball makes the second one the least recently used.
+			if got, ok := cache.Get(sha256Digest(one)); !ok || string(got) != string(one) {
+				t.Fatalf("got: %q, %t, want: %q", got, ok, one)
+			}
+			if err := cache.Add(sha256Digest(three), three); err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if _, ok := cache.Get(sha256Digest(two)); ok {
+				t.Errorf("got: cached, want: the least recently used tarball evicted")
+			}
+			for _, data := range [][]byte{one, three} {
+				if got, ok := cache.Get(sha256Digest(data)); !ok || string(got) != string(data) {
+					t.Errorf("got: %q, %t, want: %q", got, ok, data)
+				}
+			}
+
+			if !tc.dir {
+				return
+			}
+			if _, err := os.Stat(filepath.Join(dir, sha256Digest(two)+chartCacheFileExtension)); !os.IsNotExist(err) {
+				t.Errorf("got: %+v, want: the evicted tarball removed from disk", err)
+			}
+			// The tarballs are still cached after a restart.
+			cache, err = NewChartCache(dir, int64(len(one)+len(three)))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, ok := cache.Get(sha256Digest(three)); !ok || string(got) != string(three) {
+				t.Errorf("got: %q, %t, want: %q", got, ok, three)
+			}
+		})
+	}
+}
+
+func TestChartCacheAddErrors(t *testing.T) {
+	cache, err := NewChartCache("", 10)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name   string
+		digest string
+		data   []byte
+	}{
+		{
+			name:   "it returns an error if the digest is not a sha256 digest",
+			digest: "md5:1234",
+			data:   []byte("chart"),
+		},
+		{
+			name:   "it returns an error if the digest does not match the tarball",
+			digest: sha256Digest([]byte("other chart")),
+			data:   []byte("chart"),
+		},
+		{
+			name:   "it returns an error if the tarball does not fit in the cache",
+			digest: sha256Digest([]byte("a chart bigger than the cache")),
+			data:   []byte("a chart bigger than the cache"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := cache.Add(tc.digest, tc.data); err == nil {
+				t.Fatalf("got: nil, want: error")
+			}
+			if _, ok := cache.Get(tc.digest); ok {
+				t.Errorf("got: cached, want: not cached")
+			}
+		})
+	}
+}
+
+// countingChartClient returns the tarball of a chart, counting the downloads.
+type countingChartClient struct {
+	chartData []byte
+	downloads int
+}
+
+func (c *countingChartClient) Init(appRepo *appRepov1.AppRepository, caCertSecret *corev1.Secret, authSecret *corev1.Secret) error {
+	return nil
+}
+
+func (c *countingChartClient) InitVerification(verification *appRepov1.AppRepositoryVerification, verificationSecret *corev1.Secret) error {
+	return nil
+}
+
+func (c *countingChartClient) GetChart(details *ChartDetails, repoURL string) (*chart.Chart, error) {
+	c.downloads++
+	return loader.LoadArchive(bytes.NewReader(c.chartData))
+}
+
+func (c *countingChartClient) GetChartArchive(details *ChartDetails, repoURL string) ([]byte, error) {
+	c.downloads++
+	return c.chartData, nil
+}
+
+func TestGetChartWithCache(t *testing.T) {
+	chartData, err := os.ReadFile("testdata/nginx-5.1.1-apiVersionV2.tgz")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name              string
+		digest            string
+		verification      *appRepov1.AppRepositoryVerification
+		expectedDownloads int
+	}{
+		{
+			name:              "it downloads the chart once",
+			digest:            sha256Digest(chartData),
+			expectedDownloads: 1,
+		},
+		{
+			name:              "it downloads the chart each time without digest",
+			expectedDownloads: 2,
+		},
+		{
+			name:              "it downloads the chart each time if the repository requires verification",
+			digest:            sha256Digest(chartData),
+			verification:      &appRepov1.AppRepositoryVerification{Policy: appRepov1.VerificationPolicyEnforce},
+			expectedDownloads: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cache, err := NewChartCache("", 1024*1024)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			client := &countingChartClient{chartData: chartData}
+			appRepo := &appRepov1.AppRepository{Spec: appRepov1.AppRepositorySpec{Verification: tc.verification}}
+			details := &ChartDetails{ChartName: "nginx", Version: "5.1.1", Digest: tc.digest}
+
+			for i := 0; i < 2; i++ {
+				ch, err := GetChart(details, appRepo, nil, nil, nil, client, cache)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if got, want := ch.Name(), "nginx"; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+			}
+
+			if got, want := client.downloads, tc.expectedDownloads; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
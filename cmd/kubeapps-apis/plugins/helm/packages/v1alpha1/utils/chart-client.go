@@ -4,6 +4,7 @@
 package utils
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"net/http"
@@ -39,6 +40,9 @@ type ChartDetails struct {
 	Values string `json:"values,omitempty"`
 	// TarballURL is the URL to the tarball file
 	TarballURL string `json:"tarballURL,omitempty"`
+	// Digest is the sha256 digest of the tarball file, as published in the
+	// repository index.
+	Digest string `json:"digest,omitempty"`
 }
 
 // LoadHelmChart returns a helm3 Chart struct from an IOReader
@@ -47,13 +51,61 @@ type LoadHelmChart func(in io.Reader) (*chart.Chart, error)
 // ChartClient for exposed funcs
 type ChartClient interface {
 	Init(appRepo *appRepov1.AppRepository, caCertSecret *corev1.Secret, authSecret *corev1.Secret) error
+	InitVerification(verification *appRepov1.AppRepositoryVerification, verificationSecret *corev1.Secret) error
 	GetChart(details *ChartDetails, repoURL string) (*chart.Chart, error)
 }
 
+// ChartArchiveClient is implemented by the chart clients able to return the
+// tarball of a chart, which can then be cached.
+type ChartArchiveClient interface {
+	GetChartArchive(details *ChartDetails, repoURL string) ([]byte, error)
+}
+
+// chartVerifier verifies the charts retrieved by a client, according to the
+// verification policy of the repository.
+type chartVerifier struct {
+	policy     string
+	publicKeys []byte
+}
+
+// newChartVerifier returns a verifier using the public keys of the secret.
+func newChartVerifier(verification *appRepov1.AppRepositoryVerification, verificationSecret *corev1.Secret) (*chartVerifier, error) {
+	if verificationSecret == nil {
+		return nil, fmt.Errorf("the verification secret %q is required", verification.SecretKeyRef.Name)
+	}
+	publicKeys, err := kube.GetDataFromSecret(verification.SecretKeyRef.Key, verificationSecret)
+	if err != nil {
+		return nil, err
+	}
+	switch verification.Policy {
+	case "", appRepov1.VerificationPolicyEnforce, appRepov1.VerificationPolicyWarn:
+	default:
+		return nil, fmt.Errorf("unsupported verification policy %q", verification.Policy)
+	}
+	return &chartVerifier{
+		policy:     verification.Policy,
+		publicKeys: []byte(publicKeys),
+	}, nil
+}
+
+// check returns the verification error, unless the policy is only to warn
+// about the charts failing the verification.
+func (v *chartVerifier) check(chartRef string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if v.policy == appRepov1.VerificationPolicyWarn {
+		log.Warningf("Installing the chart %s despite its failed verification: %v", chartRef, err)
+		return nil
+	}
+	return err
+}
+
 // HelmRepoClient struct contains the clients required to retrieve charts info
 type HelmRepoClient struct {
 	userAgent string
 	netClient *http.Client
+	verifier  *chartVerifier
 }
 
 // NewChartClient returns a new ChartClient
@@ -67,6 +119,7 @@ func NewChartClient(userAgent string) ChartClient {
 type OCIRepoClient struct {
 	userAgent string
 	puller    helm.ChartPuller
+	verifier  *chartVerifier
 }
 
 // NewOCIClient returns a new OCIClient
@@ -84,9 +137,26 @@ func (c *HelmRepoClient) Init(appRepo *appRepov1.AppRepository, caCertSecret *co
 	return err
 }
 
+// InitVerification configures the verification of the charts against their
+// provenance files, using the GPG keyring of the secret
+func (c *HelmRepoClient) InitVerification(verification *appRepov1.AppRepositoryVerification, verificationSecret *corev1.Secret) error {
+	var err error
+	c.verifier, err = newChartVerifier(verification, verificationSecret)
+	return err
+}
+
 // GetChart loads a Chart from a given tarball, if the tarball URL is not passed,
 // it will try to retrieve the chart by parsing the whole repo index
 func (c *HelmRepoClient) GetChart(details *ChartDetails, repoURL string) (*chart.Chart, error) {
+	chartData, err := c.GetChartArchive(details, repoURL)
+	if err != nil {
+		return nil, err
+	}
+	return loader.LoadArchive(bytes.NewReader(chartData))
+}
+
+// GetChartArchive downloads and verifies the tarball of a chart
+func (c *HelmRepoClient) GetChartArchive(details *ChartDetails, repoURL string) ([]byte, error) {
 	if c.netClient == nil {
 		return nil, fmt.Errorf("unable to retrieve chart, Init should be called first")
 	}
@@ -101,12 +171,29 @@ func (c *HelmRepoClient) GetChart(details *ChartDetails, repoURL string) (*chart
 	}
 
 	log.Infof("Downloading %s ...", chartURL)
-	chart, err := fetchChart(c.netClient, chartURL.String())
+	chartData, err := fetchData(c.netClient, chartURL.String())
 	if err != nil {
 		return nil, err
 	}
 
-	return chart, nil
+	if c.verifier != nil {
+		if err := c.verifier.check(chartURL.String(), c.verifyProvenance(chartData, chartURL)); err != nil {
+			return nil, err
+		}
+	}
+
+	return chartData, nil
+}
+
+// verifyProvenance verifies the chart against the provenance file published
+// alongside the chart tarball, as expected by the Helm CLI.
+func (c *HelmRepoClient) verifyProvenance(chartData []byte, chartURL *url.URL) error {
+	provenanceURL := chartURL.String() + ".prov"
+	provenanceData, err := fetchData(c.netClient, provenanceURL)
+	if err != nil {
+		return fmt.Errorf("%w: unable to download the provenance file %s: %v", helm.ErrChartVerificationFailed, provenanceURL, err)
+	}
+	return helm.VerifyProvenance(chartData, path.Base(chartURL.Path), provenanceData, c.verifier.publicKeys)
 }
 
 func resolveChartURL(indexURL, chartURL string) (*url.URL, error) {
@@ -121,9 +208,9 @@ func resolveChartURL(indexURL, chartURL string) (*url.URL, error) {
 	return parsedChartURL, nil
 }
 
-// fetchChart returns the Chart content given an URL
-func fetchChart(netClient *http.Client, chartURL string) (*chart.Chart, error) {
-	req, err := getReq(chartURL)
+// fetchData returns the content given an URL, such as a chart tarball
+func fetchData(netClient *http.Client, rawURL string) ([]byte, error) {
+	req, err := getReq(rawURL)
 	if err != nil {
 		return nil, err
 	}
@@ -132,12 +219,13 @@ func fetchChart(netClient *http.Client, chartURL string) (*chart.Chart, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("chart download request failed")
 	}
 
-	return loader.LoadArchive(res.Body)
+	return io.ReadAll(res.Body)
 }
 
 func getReq(rawURL string) (*http.Request, error) {
@@ -179,8 +267,25 @@ func (c *OCIRepoClient) Init(appRepo *appRepov1.AppRepository, caCertSecret *cor
 	return err
 }
 
+// InitVerification configures the verification of the charts against their
+// cosign signatures, using the PEM public key of the secret
+func (c *OCIRepoClient) InitVerification(verification *appRepov1.AppRepositoryVerification, verificationSecret *corev1.Secret) error {
+	var err error
+	c.verifier, err = newChartVerifier(verification, verificationSecret)
+	return err
+}
+
 // GetChart retrieves and loads a Chart from a OCI registry
 func (c *OCIRepoClient) GetChart(details *ChartDetails, repoURL string) (*chart.Chart, error) {
+	chartData, err := c.GetChartArchive(details, repoURL)
+	if err != nil {
+		return nil, err
+	}
+	return loader.LoadArchive(bytes.NewReader(chartData))
+}
+
+// GetChartArchive pulls and verifies the tarball of a chart from a OCI registry
+func (c *OCIRepoClient) GetChartArchive(details *ChartDetails, repoURL string) ([]byte, error) {
 	if c.puller == nil {
 		return nil, fmt.Errorf("unable to retrieve chart, Init should be called first")
 	}
@@ -193,12 +298,27 @@ func (c *OCIRepoClient) GetChart(details *ChartDetails, repoURL string) (*chart.
 	}
 
 	ref := path.Join(chartURL.Host, chartURL.Path)
-	chartBuffer, _, err := c.puller.PullOCIChart(ref)
+	chartBuffer, manifestDigest, err := c.puller.PullOCIChart(ref)
 	if err != nil {
 		return nil, err
 	}
 
-	return loader.LoadArchive(chartBuffer)
+	if c.verifier != nil {
+		if err := c.verifier.check(ref, c.verifySignatures(ref, manifestDigest)); err != nil {
+			return nil, err
+		}
+	}
+
+	return chartBuffer.Bytes(), nil
+}
+
+// verifySignatures verifies the cosign signatures of the chart manifest.
+func (c *OCIRepoClient) verifySignatures(ref, manifestDigest string) error {
+	signatures, err := c.puller.PullOCISignatures(ref, manifestDigest)
+	if err != nil {
+		return fmt.Errorf("%w: unable to pull the signatures of %s: %v", helm.ErrChartVerificationFailed, ref, err)
+	}
+	return helm.VerifyCosignSignatures(signatures, manifestDigest, c.verifier.publicKeys)
 }
 
 // ChartClientFactoryInterface defines how a ChartClientFactory implementation
@@ -224,15 +344,37 @@ func (c *ChartClientFactory) New(tarballUrl string, userAgent string) ChartClien
 	return client
 }
 
-// GetChart retrieves a chart
-func GetChart(chartDetails *ChartDetails, appRepo *appRepov1.AppRepository, caCertSecret *k8scorev1.Secret, authSecret *k8scorev1.Secret, chartClient ChartClient) (*chart.Chart, error) {
+// GetChart retrieves a chart, verifying it when configured in the AppRepository.
+// When a cache is given, the tarball of a chart with a known digest is only
+// downloaded once. The charts of repositories requiring verification are not
+// cached, as they are verified against the keys of their repository.
+func GetChart(chartDetails *ChartDetails, appRepo *appRepov1.AppRepository, caCertSecret *k8scorev1.Secret, authSecret *k8scorev1.Secret, verificationSecret *k8scorev1.Secret, chartClient ChartClient, chartCache *ChartCache) (*chart.Chart, error) {
+	cacheable := chartCache != nil && chartDetails.Digest != "" && appRepo.Spec.Verification == nil
+	if cacheable {
+		if chartData, ok := chartCache.Get(chartDetails.Digest); ok {
+			log.Infof("Using the cached chart %s-%s", chartDetails.ChartName, chartDetails.Version)
+			return loader.LoadArchive(bytes.NewReader(chartData))
+		}
+	}
 	err := chartClient.Init(appRepo, caCertSecret, authSecret)
 	if err != nil {
 		return nil, err
 	}
-	ch, err := chartClient.GetChart(chartDetails, appRepo.Spec.URL)
+	if appRepo.Spec.Verification != nil {
+		if err := chartClient.InitVerification(appRepo.Spec.Verification, verificationSecret); err != nil {
+			return nil, err
+		}
+	}
+	archiveClient, ok := chartClient.(ChartArchiveClient)
+	if !cacheable || !ok {
+		return chartClient.GetChart(chartDetails, appRepo.Spec.URL)
+	}
+	chartData, err := archiveClient.GetChartArchive(chartDetails, appRepo.Spec.URL)
 	if err != nil {
 		return nil, err
 	}
-	return ch, nil
+	if err := chartCache.Add(chartDetails.Digest, chartData); err != nil {
+		log.Warningf("Unable to cache the chart %s-%s: %v", chartDetails.ChartName, chartDetails.Version, err)
+	}
+	return loader.LoadArchive(bytes.NewReader(chartData))
 }
@@ -5,6 +5,7 @@ package utils
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -14,9 +15,12 @@ import (
 	"testing"
 
 	appRepov1 "github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/pkg/helm"
 	helmfake "github.com/vmware-tanzu/kubeapps/pkg/helm/fake"
 	helmtest "github.com/vmware-tanzu/kubeapps/pkg/helm/test"
+	"helm.sh/helm/v3/pkg/chart"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -145,6 +149,92 @@ func TestGetChartHttp(t *testing.T) {
 	})
 }
 
+// newVerificationSecret returns a secret with the public keys for the chart
+// verification.
+func newVerificationSecret(keys []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "verification"},
+		Data:       map[string][]byte{"keyring": keys},
+	}
+}
+
+func TestGetChartHttpVerification(t *testing.T) {
+	testCases := []struct {
+		name              string
+		policy            string
+		provenancePath    string
+		expectedErr       bool
+		expectedFailedErr bool
+	}{
+		{
+			name:           "gets a chart matching its provenance file",
+			provenancePath: "/hashtest-1.2.3.tgz.prov",
+		},
+		{
+			name:              "fails for a chart without provenance file",
+			expectedErr:       true,
+			expectedFailedErr: true,
+		},
+		{
+			name:   "gets a chart without provenance file when only warning",
+			policy: appRepov1.VerificationPolicyWarn,
+		},
+		{
+			name:        "fails for an unsupported policy",
+			policy:      "ignore",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				files := map[string][]byte{"/hashtest-1.2.3.tgz": helmtest.ProvenanceChart}
+				if tc.provenancePath != "" {
+					files[tc.provenancePath] = helmtest.ProvenanceFile
+				}
+				if data, ok := files[r.URL.Path]; ok {
+					_, err := w.Write(data)
+					if err != nil {
+						t.Fatalf("%+v", err)
+					}
+					return
+				}
+				w.WriteHeader(404)
+			}))
+			defer server.Close()
+
+			chUtils := HelmRepoClient{}
+			chUtils.netClient = server.Client()
+			err := chUtils.InitVerification(&appRepov1.AppRepositoryVerification{
+				Policy: tc.policy,
+				SecretKeyRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "verification"},
+					Key:                  "keyring",
+				},
+			}, newVerificationSecret(helmtest.ProvenanceKeyring))
+			var ch *chart.Chart
+			if err == nil {
+				ch, err = chUtils.GetChart(&ChartDetails{
+					ChartName:  "hashtest",
+					Version:    "1.2.3",
+					TarballURL: server.URL + "/hashtest-1.2.3.tgz",
+				}, server.URL)
+			}
+
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := errors.Is(err, helm.ErrChartVerificationFailed), tc.expectedFailedErr; got != want {
+				t.Errorf("got: %t, want: %t, err: %+v", got, want, err)
+			}
+			if err == nil && ch.Name() != "hashtest" {
+				t.Errorf("got: %q, want: %q", ch.Name(), "hashtest")
+			}
+		})
+	}
+}
+
 func TestOCIClient(t *testing.T) {
 	t.Run("InitClient - Creates puller with User-Agent header", func(t *testing.T) {
 		cli := NewOCIClient("foo")
@@ -240,6 +330,41 @@ func TestOCIClient(t *testing.T) {
 		}
 	})
 
+	t.Run("GetChart - Fails for a chart without valid signature", func(t *testing.T) {
+		cli := NewOCIClient("foo")
+		data, err := os.ReadFile("./testdata/nginx-5.1.1-apiVersionV2.tgz")
+		assert.NoError(t, err)
+		cli.(*OCIRepoClient).puller = &helmfake.OCIPuller{
+			ExpectedName: "foo/bar/nginx:5.1.1",
+			Content:      map[string]*bytes.Buffer{"5.1.1": bytes.NewBuffer(data)},
+			Checksum:     "sha256:0123456789abcdef",
+			Signatures:   []helm.Signature{{Payload: []byte("{}"), Signature: []byte("invalid")}},
+		}
+		verification := &appRepov1.AppRepositoryVerification{
+			SecretKeyRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "verification"},
+				Key:                  "keyring",
+			},
+		}
+		err = cli.InitVerification(verification, &corev1.Secret{Data: map[string][]byte{"keyring": []byte(ociPublicKey)}})
+		assert.NoError(t, err)
+		_, err = cli.GetChart(&ChartDetails{ChartName: "nginx", Version: "5.1.1", TarballURL: "oci://foo/bar/nginx:5.1.1"}, "http://foo/bar")
+		if !errors.Is(err, helm.ErrChartVerificationFailed) {
+			t.Errorf("got: %+v, want: %+v", err, helm.ErrChartVerificationFailed)
+		}
+
+		// Only a warning is logged for the same chart with the warn policy.
+		verification.Policy = appRepov1.VerificationPolicyWarn
+		err = cli.InitVerification(verification, &corev1.Secret{Data: map[string][]byte{"keyring": []byte(ociPublicKey)}})
+		assert.NoError(t, err)
+		cli.(*OCIRepoClient).puller.(*helmfake.OCIPuller).Content["5.1.1"] = bytes.NewBuffer(data)
+		ch, err := cli.GetChart(&ChartDetails{ChartName: "nginx", Version: "5.1.1", TarballURL: "oci://foo/bar/nginx:5.1.1"}, "http://foo/bar")
+		assert.NoError(t, err)
+		if ch == nil || ch.Name() != "nginx" {
+			t.Errorf("Unexpected chart %+v", ch)
+		}
+	})
+
 	t.Run("GetChart - Returns a chart with multiple slashes", func(t *testing.T) {
 		cli := NewOCIClient("foo")
 		data, err := os.ReadFile("./testdata/nginx-5.1.1-apiVersionV2.tgz")
@@ -257,3 +382,10 @@ func TestOCIClient(t *testing.T) {
 		}
 	})
 }
+
+// ociPublicKey is a PEM public key, as generated by cosign.
+const ociPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEGnqS/QSvNXcWUD7T9Y8vUq9YidKH
+rUSdYAszjrTI2pzc8gfOp8uX1CrUwbwXENQ6oc8UKvb7vdBpU6e//VflFg==
+-----END PUBLIC KEY-----
+`
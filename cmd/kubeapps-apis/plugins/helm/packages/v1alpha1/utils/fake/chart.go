@@ -43,6 +43,11 @@ func (f *ChartClient) Init(appRepo *appRepov1.AppRepository, caCertSecret *corev
 	return nil
 }
 
+// InitVerification fake
+func (f *ChartClient) InitVerification(verification *appRepov1.AppRepositoryVerification, verificationSecret *corev1.Secret) error {
+	return nil
+}
+
 // ChartClientFactory is a fake implementation of the ChartClientFactory interface.
 type ChartClientFactory struct{}
 
@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	_ "github.com/lib/pq"
@@ -197,7 +198,18 @@ func (m *PostgresAssetManager) GenerateWhereClause(cq ChartQuery) (string, []int
 	whereQueryParams := []interface{}{}
 	whereQuery := ""
 
-	if cq.Namespace != AllNamespaces {
+	if len(cq.Namespaces) > 0 {
+		namespaces := append([]string{}, cq.Namespaces...)
+		if !slices.Contains(namespaces, m.GetGlobalPackagingNamespace()) {
+			namespaces = append(namespaces, m.GetGlobalPackagingNamespace())
+		}
+		namespaceClauses := []string{}
+		for _, namespace := range namespaces {
+			whereQueryParams = append(whereQueryParams, namespace)
+			namespaceClauses = append(namespaceClauses, fmt.Sprintf("repo_namespace = $%d", len(whereQueryParams)))
+		}
+		whereClauses = append(whereClauses, "("+strings.Join(namespaceClauses, " OR ")+")")
+	} else if cq.Namespace != AllNamespaces {
 		whereQueryParams = append(whereQueryParams, cq.Namespace, m.GetGlobalPackagingNamespace())
 		whereClauses = append(whereClauses, fmt.Sprintf(
 			"(repo_namespace = $%d OR repo_namespace = $%d)", len(whereQueryParams)-1, len(whereQueryParams),
@@ -419,6 +419,7 @@ func Test_GenerateWhereClause(t *testing.T) {
 	tests := []struct {
 		name           string
 		namespace      string
+		namespaces     []string
 		chartName      string
 		version        string
 		appVersion     string
@@ -452,6 +453,24 @@ func Test_GenerateWhereClause(t *testing.T) {
 			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2)",
 			expectedParams: []interface{}{string("my-ns"), string("kubeapps")},
 		},
+		{
+			name:           "returns where clause - multiple namespaces",
+			namespaces:     []string{"my-ns", "other-ns"},
+			repos:          []string{""},
+			categories:     []string{""},
+			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2 OR repo_namespace = $3)",
+			expectedParams: []interface{}{string("my-ns"), string("other-ns"), string("kubeapps")},
+		},
+		{
+			name:           "returns where clause - multiple namespaces including the global one",
+			namespace:      "ignored-ns",
+			namespaces:     []string{"kubeapps", "my-ns"},
+			chartName:      "my-chart",
+			repos:          []string{""},
+			categories:     []string{""},
+			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2) AND (info->>'name' = $3)",
+			expectedParams: []interface{}{string("kubeapps"), string("my-ns"), string("my-chart")},
+		},
 		{
 			name:           "returns where clause - single param - name",
 			namespace:      "",
@@ -640,6 +659,7 @@ func Test_GenerateWhereClause(t *testing.T) {
 
 			cq := ChartQuery{
 				Namespace:   tt.namespace,
+				Namespaces:  tt.namespaces,
 				ChartName:   tt.chartName,
 				Version:     tt.version,
 				AppVersion:  tt.appVersion,
@@ -13,6 +13,7 @@ import (
 	"github.com/vmware-tanzu/kubeapps/pkg/kube"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	log "k8s.io/klog/v2"
 )
 
 const (
@@ -32,7 +33,10 @@ type AssetManager interface {
 
 // ChartQuery is a container for passing the supported query parameters for generating the WHERE query
 type ChartQuery struct {
-	Namespace   string
+	Namespace string
+	// Namespaces, when not empty, takes precedence over Namespace so that a
+	// single query spans several repository namespaces.
+	Namespaces  []string
 	ChartName   string
 	Version     string
 	AppVersion  string
@@ -71,6 +75,11 @@ func RegistrySecretsPerDomain(ctx context.Context, appRepoSecrets []string, name
 		}
 
 		for key := range dockerConfigJSON.Auths {
+			// the API rejects such repositories, but those created otherwise keep
+			// using the last secret for the registry
+			if existing, ok := secretsPerDomain[key]; ok && existing != secretName {
+				log.Warningf("The AppRepository secrets %q and %q both provide credentials for the registry %q, using %q", existing, secretName, key, secretName)
+			}
 			secretsPerDomain[key] = secretName
 		}
 
@@ -142,6 +142,35 @@ func TestGetRegistrySecretsPerDomain(t *testing.T) {
 				"other.example.com":           "bitnami-repo2",
 			},
 		},
+		{
+			name:        "it uses the last secret if two secrets are for the same server",
+			secretNames: []string{"bitnami-repo1", "bitnami-repo2"},
+			existingSecrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bitnami-repo1",
+						Namespace: namespace,
+					},
+					Type: dockerConfigJSONType,
+					Data: map[string][]byte{
+						dockerConfigJSONKey: []byte(indexDockerIOCred),
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bitnami-repo2",
+						Namespace: namespace,
+					},
+					Type: dockerConfigJSONType,
+					Data: map[string][]byte{
+						dockerConfigJSONKey: []byte(indexDockerIOCred),
+					},
+				},
+			},
+			secretsPerDomain: map[string]string{
+				"https://index.docker.io/v1/": "bitnami-repo2",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
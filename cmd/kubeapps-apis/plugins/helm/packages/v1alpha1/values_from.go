@@ -0,0 +1,288 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/helm/agent"
+	"google.golang.org/protobuf/types/known/anypb"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	valuesFromKindSecret    = "Secret"
+	valuesFromKindConfigMap = "ConfigMap"
+	defaultValuesFromKey    = "values.yaml"
+
+	// The values references of a release, but not their values, are stored as
+	// an annotation of the secret or configmap of each revision, so that the
+	// following updates can keep using them.
+	valuesFromAnnotation = "kubeapps.dev/values-from"
+)
+
+// storedValuesReference is the representation of a values reference in the
+// annotation of a release revision.
+type storedValuesReference struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	ValuesKey  string `json:"valuesKey,omitempty"`
+	TargetPath string `json:"targetPath,omitempty"`
+	Optional   bool   `json:"optional,omitempty"`
+}
+
+// valuesFromCustomDetail returns the values references requested using the
+// custom detail of a create or update request, and whether the stored ones
+// should be cleared.
+func valuesFromCustomDetail(customDetail *anypb.Any) ([]*helmv1.ValuesReference, bool, error) {
+	if customDetail == nil {
+		return nil, false, nil
+	}
+	customData := &helmv1.InstalledPackageRequestCustomDataHelm{}
+	if err := customDetail.UnmarshalTo(customData); err != nil {
+		return nil, false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to unmarshal the custom detail: %w", err))
+	}
+	for _, ref := range customData.GetValuesFrom() {
+		if ref.GetKind() != valuesFromKindSecret && ref.GetKind() != valuesFromKindConfigMap {
+			return nil, false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Invalid kind %q of values reference %q, expected %q or %q", ref.GetKind(), ref.GetName(), valuesFromKindSecret, valuesFromKindConfigMap))
+		}
+		if ref.GetName() == "" {
+			return nil, false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The name of a %s values reference is required", ref.GetKind()))
+		}
+		if path := ref.GetTargetPath(); path != "" {
+			for _, segment := range strings.Split(path, ".") {
+				if segment == "" {
+					return nil, false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Invalid target path %q of values reference %q", path, ref.GetName()))
+				}
+			}
+		}
+	}
+	return customData.GetValuesFrom(), customData.GetClearValuesFrom(), nil
+}
+
+// MergesServerSideValues reports to the core server whether the values of a
+// create or update request are merged with the values referenced by its custom
+// detail, in which case helm validates the merged values against the chart.
+func (s *Server) MergesServerSideValues(customDetail *anypb.Any) bool {
+	refs, _, err := valuesFromCustomDetail(customDetail)
+	return err == nil && len(refs) > 0
+}
+
+// resolveValuesFrom reads the values referenced in the namespace with the
+// credentials of the user, merging them in order.
+func (s *Server) resolveValuesFrom(ctx context.Context, headers http.Header, cluster, namespace string, refs []*helmv1.ValuesReference) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if len(refs) == 0 {
+		return values, nil
+	}
+	typedClient, err := s.clientGetter.Typed(headers, cluster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to create kubernetes clientset: %w", err))
+	}
+
+	for _, ref := range refs {
+		key := ref.GetValuesKey()
+		if key == "" {
+			key = defaultValuesFromKey
+		}
+		var content []byte
+		found := false
+		switch ref.GetKind() {
+		case valuesFromKindSecret:
+			secret, err := typedClient.CoreV1().Secrets(namespace).Get(ctx, ref.GetName(), metav1.GetOptions{})
+			if err != nil {
+				if k8serrors.IsNotFound(err) && ref.GetOptional() {
+					continue
+				}
+				return nil, connecterror.FromK8sError("get", "Secret", ref.GetName(), err)
+			}
+			content, found = secret.Data[key]
+		case valuesFromKindConfigMap:
+			configMap, err := typedClient.CoreV1().ConfigMaps(namespace).Get(ctx, ref.GetName(), metav1.GetOptions{})
+			if err != nil {
+				if k8serrors.IsNotFound(err) && ref.GetOptional() {
+					continue
+				}
+				return nil, connecterror.FromK8sError("get", "ConfigMap", ref.GetName(), err)
+			}
+			var data string
+			if data, found = configMap.Data[key]; found {
+				content = []byte(data)
+			} else {
+				content, found = configMap.BinaryData[key]
+			}
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Invalid kind %q of values reference %q", ref.GetKind(), ref.GetName()))
+		}
+		if !found {
+			if ref.GetOptional() {
+				continue
+			}
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The %s %q in the namespace %q has no key %q", ref.GetKind(), ref.GetName(), namespace, key))
+		}
+
+		if ref.GetTargetPath() != "" {
+			setValueAtPath(values, strings.Split(ref.GetTargetPath(), "."), string(content))
+			continue
+		}
+		refValues := map[string]interface{}{}
+		if err := yaml.Unmarshal(content, &refValues); err != nil {
+			// The content is not included in the error as it may be sensitive.
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unable to parse the values of the key %q of the %s %q", key, ref.GetKind(), ref.GetName()))
+		}
+		values = mergeValues(values, refValues)
+	}
+	return values, nil
+}
+
+// applyValuesFrom returns the chart to install or upgrade with the values from
+// the references merged over its default values, so that the inline values of
+// the request still take precedence. The referenced values are only used to
+// render the release, which is stored with the original values of the chart.
+func applyValuesFrom(actionConfig *action.Configuration, ch *chart.Chart, values map[string]interface{}) *chart.Chart {
+	if len(values) == 0 {
+		return ch
+	}
+	return agent.WithUnstoredValues(actionConfig, ch, mergeValues(ch.Values, values))
+}
+
+// mergeValues returns the values of base deeply merged with the ones of
+// override, which take precedence. Neither map is modified.
+func mergeValues(base, override map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		if overrideMap, ok := v.(map[string]interface{}); ok {
+			if baseMap, ok := merged[k].(map[string]interface{}); ok {
+				merged[k] = mergeValues(baseMap, overrideMap)
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// setValueAtPath sets the value at the path of nested maps, replacing any
+// non-map value on the way.
+func setValueAtPath(values map[string]interface{}, path []string, value interface{}) {
+	for _, segment := range path[:len(path)-1] {
+		next, ok := values[segment].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			values[segment] = next
+		}
+		values = next
+	}
+	values[path[len(path)-1]] = value
+}
+
+// annotateReleaseWithValuesFrom stores the values references of a release in
+// the secret or configmap of the given release revision.
+func (s *Server) annotateReleaseWithValuesFrom(ctx context.Context, headers http.Header, cluster, namespace, releaseName string, revision int, refs []*helmv1.ValuesReference) error {
+	stored := make([]storedValuesReference, 0, len(refs))
+	for _, ref := range refs {
+		stored = append(stored, storedValuesReference{
+			Kind:       ref.GetKind(),
+			Name:       ref.GetName(),
+			ValuesKey:  ref.GetValuesKey(),
+			TargetPath: ref.GetTargetPath(),
+			Optional:   ref.GetOptional(),
+		})
+	}
+	annotation, err := json.Marshal(stored)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to marshal the values references: %w", err))
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				valuesFromAnnotation: string(annotation),
+			},
+		},
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to marshal the release annotations: %w", err))
+	}
+
+	storageName := releaseStorageName(releaseName, revision)
+	if err := s.patchReleaseStorageObject(ctx, headers, cluster, namespace, storageName, patch); err != nil {
+		return connect.NewError(connect.CodeOf(err), fmt.Errorf("Unable to annotate the release storage %q in the namespace %q: %w", storageName, namespace, err))
+	}
+	return nil
+}
+
+// keepReleaseValuesFrom annotates a new revision of a release with its values
+// references, if any. As the new revision is already deployed, a failure is
+// only logged.
+func (s *Server) keepReleaseValuesFrom(ctx context.Context, headers http.Header, cluster, namespace, releaseName string, revision int, refs []*helmv1.ValuesReference, hadValuesFrom bool) {
+	if len(refs) == 0 && !hadValuesFrom {
+		return
+	}
+	if err := s.annotateReleaseWithValuesFrom(ctx, headers, cluster, namespace, releaseName, revision, refs); err != nil {
+		log.Errorf("+helm unable to keep the values references of the release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+}
+
+// getReleaseValuesFrom returns the values references stored with the latest
+// annotated revision of a release, and whether any was found. As for the
+// adopted releases, the releases stored by the SQL driver have none.
+func (s *Server) getReleaseValuesFrom(ctx context.Context, headers http.Header, cluster, namespace, releaseName string) ([]*helmv1.ValuesReference, bool, error) {
+	if s.pluginConfig.StorageDriverForCluster(cluster) == agent.StorageDriverSQL {
+		return nil, false, nil
+	}
+	selector := fmt.Sprintf("%s,name=%s", helmReleaseStorageSelector, releaseName)
+	objects, err := s.listReleaseStorageObjects(ctx, headers, cluster, namespace, selector)
+	if err != nil {
+		return nil, false, err
+	}
+
+	latestRevision := 0
+	annotation := ""
+	for _, object := range objects {
+		value, ok := object.Annotations[valuesFromAnnotation]
+		if !ok {
+			continue
+		}
+		revision, err := strconv.Atoi(object.Labels["version"])
+		if err != nil || revision < latestRevision {
+			continue
+		}
+		latestRevision, annotation = revision, value
+	}
+	if latestRevision == 0 {
+		return nil, false, nil
+	}
+
+	stored := []storedValuesReference{}
+	if err := json.Unmarshal([]byte(annotation), &stored); err != nil {
+		return nil, false, fmt.Errorf("invalid values references of the release %q: %w", releaseName, err)
+	}
+	refs := make([]*helmv1.ValuesReference, 0, len(stored))
+	for _, ref := range stored {
+		refs = append(refs, &helmv1.ValuesReference{
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			ValuesKey:  ref.ValuesKey,
+			TargetPath: ref.TargetPath,
+			Optional:   ref.Optional,
+		})
+	}
+	return refs, true, nil
+}
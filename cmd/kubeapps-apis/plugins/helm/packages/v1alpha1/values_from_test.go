@@ -0,0 +1,505 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"google.golang.org/protobuf/types/known/anypb"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newValuesFromObjects() (*apiv1.Secret, *apiv1.ConfigMap) {
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "default"},
+		Data: map[string][]byte{
+			"values.yaml": []byte("db:\n  user: admin\n  password: s3cr3t\n"),
+			"password":    []byte("0v3rr1dd3n"),
+		},
+	}
+	configMap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data: map[string]string{
+			"values.yaml": "db:\n  host: postgres\n  user: app\nreplicas: 2\n",
+		},
+	}
+	return secret, configMap
+}
+
+func valuesFromCustomDetailAny(t *testing.T, customData *helmv1.InstalledPackageRequestCustomDataHelm) *anypb.Any {
+	customDetail, err := anypb.New(customData)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return customDetail
+}
+
+func TestValuesFromCustomDetail(t *testing.T) {
+	testCases := []struct {
+		name              string
+		valuesFrom        []*helmv1.ValuesReference
+		expectedErrorCode connect.Code
+	}{
+		{
+			name: "it accepts secret and configmap references",
+			valuesFrom: []*helmv1.ValuesReference{
+				{Kind: "Secret", Name: "db-credentials", TargetPath: "db.password"},
+				{Kind: "ConfigMap", Name: "app-config"},
+			},
+		},
+		{
+			name:              "it returns invalid argument for an unknown kind",
+			valuesFrom:        []*helmv1.ValuesReference{{Kind: "Pod", Name: "app"}},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:              "it returns invalid argument without name",
+			valuesFrom:        []*helmv1.ValuesReference{{Kind: "Secret"}},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:              "it returns invalid argument for an invalid target path",
+			valuesFrom:        []*helmv1.ValuesReference{{Kind: "Secret", Name: "db-credentials", TargetPath: "db..password"}},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			customDetail := valuesFromCustomDetailAny(t, &helmv1.InstalledPackageRequestCustomDataHelm{ValuesFrom: tc.valuesFrom})
+
+			refs, _, err := valuesFromCustomDetail(customDetail)
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				if err == nil {
+					t.Fatalf("got: nil, want: error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(refs), len(tc.valuesFrom); got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestMergesServerSideValues(t *testing.T) {
+	testCases := []struct {
+		name         string
+		customDetail *anypb.Any
+		expected     bool
+	}{
+		{
+			name: "it merges the values of a request with values references",
+			customDetail: valuesFromCustomDetailAny(t, &helmv1.InstalledPackageRequestCustomDataHelm{
+				ValuesFrom: []*helmv1.ValuesReference{{Kind: "Secret", Name: "db-credentials"}},
+			}),
+			expected: true,
+		},
+		{
+			name:         "it does not merge the values of a request only waiting for the release",
+			customDetail: valuesFromCustomDetailAny(t, &helmv1.InstalledPackageRequestCustomDataHelm{Wait: true, Atomic: true}),
+		},
+		{
+			name: "it does not merge the values of a request without custom detail",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Server{}
+			if got, want := s.MergesServerSideValues(tc.customDetail), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestResolveValuesFrom(t *testing.T) {
+	testCases := []struct {
+		name              string
+		valuesFrom        []*helmv1.ValuesReference
+		expectedValues    map[string]interface{}
+		expectedErrorCode connect.Code
+	}{
+		{
+			name: "it merges the referenced values in order",
+			valuesFrom: []*helmv1.ValuesReference{
+				{Kind: "ConfigMap", Name: "app-config"},
+				{Kind: "Secret", Name: "db-credentials"},
+			},
+			expectedValues: map[string]interface{}{
+				"db": map[string]interface{}{
+					"host":     "postgres",
+					"user":     "admin",
+					"password": "s3cr3t",
+				},
+				"replicas": float64(2),
+			},
+		},
+		{
+			name: "it sets the value of a key at the target path",
+			valuesFrom: []*helmv1.ValuesReference{
+				{Kind: "Secret", Name: "db-credentials"},
+				{Kind: "Secret", Name: "db-credentials", ValuesKey: "password", TargetPath: "db.password"},
+			},
+			expectedValues: map[string]interface{}{
+				"db": map[string]interface{}{
+					"user":     "admin",
+					"password": "0v3rr1dd3n",
+				},
+			},
+		},
+		{
+			name: "it ignores the missing optional references",
+			valuesFrom: []*helmv1.ValuesReference{
+				{Kind: "Secret", Name: "not-found", Optional: true},
+				{Kind: "ConfigMap", Name: "app-config", ValuesKey: "not-found", Optional: true},
+			},
+			expectedValues: map[string]interface{}{},
+		},
+		{
+			name:              "it returns not found for a missing object",
+			valuesFrom:        []*helmv1.ValuesReference{{Kind: "Secret", Name: "not-found"}},
+			expectedErrorCode: connect.CodeNotFound,
+		},
+		{
+			name:              "it returns invalid argument for a missing key",
+			valuesFrom:        []*helmv1.ValuesReference{{Kind: "ConfigMap", Name: "app-config", ValuesKey: "not-found"}},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:              "it returns invalid argument for invalid values",
+			valuesFrom:        []*helmv1.ValuesReference{{Kind: "Secret", Name: "db-credentials", ValuesKey: "password"}},
+			expectedErrorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret, configMap := newValuesFromObjects()
+			server := &Server{
+				clientGetter: clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset(secret, configMap)).Build(),
+			}
+
+			values, err := server.resolveValuesFrom(context.Background(), http.Header{}, "default", "default", tc.valuesFrom)
+
+			if got, want := connect.CodeOf(err), tc.expectedErrorCode; err != nil && got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedErrorCode != 0 {
+				if err == nil {
+					t.Fatalf("got: nil, want: error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := values, tc.expectedValues; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestCreateInstalledPackageWithValuesFrom(t *testing.T) {
+	actionConfig := newActionConfigFixture(t, "default", nil, nil)
+	server, mock, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+	secret, configMap := newValuesFromObjects()
+	// Helm stores the release in memory, so the secret to be annotated is
+	// created beforehand.
+	clientSet := typfake.NewSimpleClientset(newReleaseSecret("my-apache", "default", 1, "", ""), secret, configMap)
+	server.clientGetter = clientgetter.NewBuilder().WithTyped(clientSet).Build()
+
+	request := &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: &corev1.AvailablePackageReference{
+			Context:    &corev1.Context{Namespace: globalPackagingNamespace},
+			Identifier: "oci://registry.example.com/charts/apache:1.18.3",
+		},
+		TargetContext: &corev1.Context{Namespace: "default"},
+		Name:          "my-apache",
+		Values:        "replicas: 3\n",
+		CustomDetail: valuesFromCustomDetailAny(t, &helmv1.InstalledPackageRequestCustomDataHelm{
+			ValuesFrom: []*helmv1.ValuesReference{
+				{Kind: "ConfigMap", Name: "app-config"},
+				{Kind: "Secret", Name: "db-credentials", ValuesKey: "password", TargetPath: "db.password"},
+			},
+		}),
+	}
+
+	_, err := server.CreateInstalledPackage(context.Background(), connect.NewRequest(request))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	rel, err := actionConfig.Releases.Get("my-apache", 1)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// The referenced values are neither stored as the values supplied by the
+	// user nor as the default values of the chart.
+	if got, want := rel.Config, map[string]interface{}{"replicas": float64(3)}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if got, want := rel.Chart.Values, map[string]interface{}{}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	checkStoredReleaseWithout(t, rel, "0v3rr1dd3n", "postgres")
+
+	storage, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), releaseStorageName("my-apache", 1), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expectedAnnotation := `[{"kind":"ConfigMap","name":"app-config"},{"kind":"Secret","name":"db-credentials","valuesKey":"password","targetPath":"db.password"}]`
+	if got, want := storage.Annotations[valuesFromAnnotation], expectedAnnotation; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestUpdateInstalledPackageWithValuesFrom(t *testing.T) {
+	storedAnnotation := `[{"kind":"Secret","name":"db-credentials"}]`
+
+	testCases := []struct {
+		name               string
+		customData         *helmv1.InstalledPackageRequestCustomDataHelm
+		expectedAnnotation string
+	}{
+		{
+			name:               "keeps the stored values references",
+			expectedAnnotation: storedAnnotation,
+		},
+		{
+			name: "replaces the stored values references",
+			customData: &helmv1.InstalledPackageRequestCustomDataHelm{
+				ValuesFrom: []*helmv1.ValuesReference{{Kind: "ConfigMap", Name: "app-config"}},
+			},
+			expectedAnnotation: `[{"kind":"ConfigMap","name":"app-config"}]`,
+		},
+		{
+			name:               "clears the stored values references",
+			customData:         &helmv1.InstalledPackageRequestCustomDataHelm{ClearValuesFrom: true},
+			expectedAnnotation: "[]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, "default", []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					version:      1,
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+				},
+			}, nil)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			installedSecret := newReleaseSecret("my-apache", "default", 1, "oci://registry.example.com/charts/apache", globalPackagingNamespace)
+			installedSecret.Annotations[valuesFromAnnotation] = storedAnnotation
+			secret, configMap := newValuesFromObjects()
+			clientSet := typfake.NewSimpleClientset(
+				installedSecret,
+				newReleaseSecret("my-apache", "default", 2, "", ""),
+				secret,
+				configMap,
+			)
+			server.clientGetter = clientgetter.NewBuilder().WithTyped(clientSet).Build()
+
+			request := &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Namespace: "default"},
+					Identifier: "my-apache",
+				},
+				Values: "{\"foo\": \"bar\"}",
+			}
+			if tc.customData != nil {
+				request.CustomDetail = valuesFromCustomDetailAny(t, tc.customData)
+			}
+
+			_, err := server.UpdateInstalledPackage(context.Background(), connect.NewRequest(request))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			rel, err := actionConfig.Releases.Get("my-apache", 2)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := rel.Chart.Values, map[string]interface{}{}; !cmp.Equal(want, got, cmpopts.EquateEmpty()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, cmpopts.EquateEmpty()))
+			}
+			checkStoredReleaseWithout(t, rel, "s3cr3t", "postgres")
+
+			storage, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), releaseStorageName("my-apache", 2), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := storage.Annotations[valuesFromAnnotation], tc.expectedAnnotation; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+// checkStoredReleaseWithout checks that none of the referenced values is found
+// in the encoded release, as stored by Helm.
+func checkStoredReleaseWithout(t *testing.T, rel *release.Release, values ...string) {
+	t.Helper()
+	encoded, err := json.Marshal(rel)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, value := range values {
+		if strings.Contains(string(encoded), value) {
+			t.Errorf("the referenced value %q is stored with the release", value)
+		}
+	}
+}
+
+func TestValuesFromKeptAcrossReleaseUpdates(t *testing.T) {
+	staleAnnotation := `[{"kind":"ConfigMap","name":"app-config"}]`
+	storedAnnotation := `[{"kind":"Secret","name":"db-credentials"}]`
+
+	testCases := []struct {
+		name             string
+		updateRelease    func(t *testing.T, server *Server)
+		expectedRevision int
+	}{
+		{
+			name: "running the tests of the release",
+			updateRelease: func(t *testing.T, server *Server) {
+				err := server.runInstalledPackageTests(context.Background(), http.Header{}, &helmv1.RunInstalledPackageTestsRequest{
+					InstalledPackageRef: &corev1.InstalledPackageReference{
+						Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: "default"},
+						Identifier: "my-apache",
+					},
+				}, func(*helmv1.RunInstalledPackageTestsResponse) error { return nil })
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+			},
+			expectedRevision: 3,
+		},
+		{
+			name: "rolling back the release",
+			updateRelease: func(t *testing.T, server *Server) {
+				_, err := server.RollbackInstalledPackage(context.Background(), connect.NewRequest(&helmv1.RollbackInstalledPackageRequest{
+					InstalledPackageRef: &corev1.InstalledPackageReference{
+						Context:    &corev1.Context{Cluster: globalPackagingCluster, Namespace: "default"},
+						Identifier: "my-apache",
+					},
+					ReleaseRevision: 1,
+				}))
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+			},
+			expectedRevision: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret, configMap := newValuesFromObjects()
+			clientSet := typfake.NewSimpleClientset(secret, configMap)
+			// The releases are stored in secrets, as Helm does, so that their
+			// annotations are removed when Helm updates them.
+			actionConfig := newActionConfigFixture(t, "default", nil, nil)
+			actionConfig.Releases.Driver = driver.NewSecrets(clientSet.CoreV1().Secrets("default"))
+			for _, stub := range []releaseStub{
+				{name: "my-apache", namespace: "default", version: 1, chartVersion: "1.18.2", status: release.StatusSuperseded},
+				{name: "my-apache", namespace: "default", version: 2, chartVersion: "1.18.3", status: release.StatusDeployed,
+					hooks: []*release.Hook{{Name: "my-apache-test", Kind: "Job", Events: []release.HookEvent{release.HookTest}}}},
+			} {
+				if err := actionConfig.Releases.Create(releaseForStub(t, stub)); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+			for revision, annotation := range map[int]string{1: staleAnnotation, 2: storedAnnotation} {
+				storage, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), releaseStorageName("my-apache", revision), metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				storage.Labels[adoptedReleaseLabel] = "true"
+				storage.Annotations = map[string]string{
+					adoptedPackageIdentifierAnnotation:    "oci://registry.example.com/charts/apache",
+					adoptedPackageRepoNamespaceAnnotation: globalPackagingNamespace,
+					valuesFromAnnotation:                  annotation,
+				}
+				if _, err := clientSet.CoreV1().Secrets("default").Update(context.Background(), storage, metav1.UpdateOptions{}); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+			server, _, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			server.clientGetter = clientgetter.NewBuilder().WithTyped(clientSet).Build()
+
+			tc.updateRelease(t, server)
+
+			_, err := server.UpdateInstalledPackage(context.Background(), connect.NewRequest(&corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Namespace: "default"},
+					Identifier: "my-apache",
+				},
+				Values: "{\"foo\": \"bar\"}",
+			}))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			storage, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), releaseStorageName("my-apache", tc.expectedRevision), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := storage.Annotations[valuesFromAnnotation], storedAnnotation; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			// The values of the release are merged with the referenced ones.
+			detail, err := server.GetInstalledPackageDetail(context.Background(), connect.NewRequest(&corev1.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Namespace: "default"},
+					Identifier: "my-apache",
+				},
+			}))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if !detail.Msg.GetInstalledPackageDetail().GetServerSideValues() {
+				t.Errorf("got: false, want: server side values for the release")
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"helm.sh/helm/v3/pkg/release"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	log "k8s.io/klog/v2"
+)
+
+// helmReleaseStorageSelector selects the secrets or configmaps used by helm to store releases.
+const helmReleaseStorageSelector = "owner=helm"
+
+// gzipMagic is the header of the gzip-compressed releases stored by helm.
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// watchedRelease records the latest revision seen for a release on a watch.
+type watchedRelease struct {
+	revision int
+	deleted  bool
+}
+
+// WatchInstalledPackages streams the changes of the installed packages managed by the 'helm' plugin
+func (s *Server) WatchInstalledPackages(ctx context.Context, request *connect.Request[corev1.WatchInstalledPackagesRequest], stream *connect.ServerStream[corev1.WatchInstalledPackagesResponse]) error {
+	return s.WatchInstalledPackageEvents(ctx, request.Header(), request.Msg, stream.Send)
+}
+
+// WatchInstalledPackageEvents watches the helm release secrets of the requested
+// context, sending an event each time the latest revision of a release changes,
+// until the context is done.
+func (s *Server) WatchInstalledPackageEvents(ctx context.Context, headers http.Header, request *corev1.WatchInstalledPackagesRequest, send func(*corev1.WatchInstalledPackagesResponse) error) error {
+	cluster := request.GetContext().GetCluster()
+	namespace := request.GetContext().GetNamespace()
+	log.InfoS("+helm WatchInstalledPackages", "cluster", cluster, "namespace", namespace)
+
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	watcher, err := s.watchReleaseStorageObjects(ctx, headers, cluster, namespace)
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	// Helm stores one secret per release revision, so only the changes to the
+	// latest revision of each release are relevant for the installed package.
+	watchedReleases := map[types.NamespacedName]*watchedRelease{}
+	for {
+		var event watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok = <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+		}
+
+		if event.Type == watch.Error {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to watch the Helm release storage: %w", k8serrors.FromObject(event.Object)))
+		}
+		rel, object, ok, err := releaseFromStorageObject(event.Object)
+		if !ok {
+			continue
+		}
+		if err != nil {
+			log.Warningf("+helm unable to decode the release stored in %s/%s: %v", object.Namespace, object.Name, err)
+			continue
+		}
+
+		eventType := releaseEventType(watchedReleases, rel, event.Type)
+		if eventType == corev1.WatchInstalledPackagesResponse_EVENT_TYPE_UNSPECIFIED {
+			continue
+		}
+
+		summary := installedPkgSummaryFromRelease(rel)
+		summary.InstalledPackageRef.Context.Cluster = cluster
+		summary.InstalledPackageRef.Plugin = GetPluginDetail()
+		summary.Status = &corev1.InstalledPackageStatus{
+			Ready:      rel.Info.Status == release.StatusDeployed,
+			Reason:     statusReasonForHelmStatus(rel.Info.Status),
+			UserReason: rel.Info.Status.String(),
+		}
+		if err := send(&corev1.WatchInstalledPackagesResponse{
+			EventType:               eventType,
+			InstalledPackageSummary: summary,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// releaseEventType returns the installed package event for a change to a
+// release secret, updating the watched releases accordingly. Changes to
+// previous revisions, such as those superseded or pruned from the history,
+// result in an unspecified event type.
+func releaseEventType(watchedReleases map[types.NamespacedName]*watchedRelease, rel *release.Release, eventType watch.EventType) corev1.WatchInstalledPackagesResponse_EventType {
+	key := types.NamespacedName{Namespace: rel.Namespace, Name: rel.Name}
+	watched, known := watchedReleases[key]
+	isCurrent := known && !watched.deleted
+
+	if eventType == watch.Deleted {
+		if !isCurrent || rel.Version < watched.revision {
+			return corev1.WatchInstalledPackagesResponse_EVENT_TYPE_UNSPECIFIED
+		}
+		// Keep the deleted release so that the deletion of its previous
+		// revisions is ignored.
+		watched.deleted = true
+		return corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED
+	}
+
+	if isCurrent && rel.Version < watched.revision {
+		return corev1.WatchInstalledPackagesResponse_EVENT_TYPE_UNSPECIFIED
+	}
+	watchedReleases[key] = &watchedRelease{revision: rel.Version}
+	if isCurrent {
+		return corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED
+	}
+	return corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED
+}
+
+// decodeRelease decodes a release stored in a helm release secret or configmap
+// in the same way as the helm storage drivers.
+func decodeRelease(encoded string) (*release.Release, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > len(gzipMagic) && bytes.Equal(data[0:len(gzipMagic)], gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		data, err = io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var rel release.Release
+	if err := json.Unmarshal(data, &rel); err != nil {
+		return nil, err
+	}
+	return &rel, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// releaseSecret returns the secret in which the helm secrets storage driver
+// stores the given release revision.
+func releaseSecret(t *testing.T, name, namespace string, version int, status release.Status) *apiv1.Secret {
+	rel := &release.Release{
+		Name:      name,
+		Namespace: namespace,
+		Version:   version,
+		Info:      &release.Info{Status: status},
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{
+				Name:       "apache",
+				Version:    "1.18.3",
+				AppVersion: DefaultAppVersion,
+			},
+		},
+	}
+	releaseJSON, err := json.Marshal(rel)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err = writer.Write(releaseJSON); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = writer.Close(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, version),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"owner":   "helm",
+				"name":    name,
+				"version": fmt.Sprintf("%d", version),
+				"status":  status.String(),
+			},
+		},
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString(buf.Bytes())),
+		},
+	}
+}
+
+func TestWatchInstalledPackageEvents(t *testing.T) {
+	summary := func(status release.Status, ready bool, reason corev1.InstalledPackageStatus_StatusReason) *corev1.InstalledPackageSummary {
+		return &corev1.InstalledPackageSummary{
+			InstalledPackageRef: &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Cluster:   globalPackagingCluster,
+					Namespace: "default",
+				},
+				Identifier: "my-apache",
+				Plugin:     GetPluginDetail(),
+			},
+			Name: "my-apache",
+			PkgVersionReference: &corev1.VersionReference{
+				Version: "1.18.3",
+			},
+			CurrentVersion: &corev1.PackageAppVersion{
+				PkgVersion: "1.18.3",
+				AppVersion: DefaultAppVersion,
+			},
+			PkgDisplayName: "apache",
+			Status: &corev1.InstalledPackageStatus{
+				Ready:      ready,
+				Reason:     reason,
+				UserReason: status.String(),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		events            []watch.Event
+		expectedResponses []*corev1.WatchInstalledPackagesResponse
+	}{
+		{
+			name: "it sends the changes of the latest revision of a release",
+			events: []watch.Event{
+				{Type: watch.Added, Object: releaseSecret(t, "my-apache", "default", 1, release.StatusPendingInstall)},
+				{Type: watch.Modified, Object: releaseSecret(t, "my-apache", "default", 1, release.StatusDeployed)},
+				{Type: watch.Added, Object: releaseSecret(t, "my-apache", "default", 2, release.StatusPendingUpgrade)},
+				{Type: watch.Modified, Object: releaseSecret(t, "my-apache", "default", 1, release.StatusSuperseded)},
+				{Type: watch.Modified, Object: releaseSecret(t, "my-apache", "default", 2, release.StatusDeployed)},
+			},
+			expectedResponses: []*corev1.WatchInstalledPackagesResponse{
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: summary(release.StatusPendingInstall, false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: summary(release.StatusDeployed, true, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: summary(release.StatusPendingUpgrade, false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: summary(release.StatusDeployed, true, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED),
+				},
+			},
+		},
+		{
+			name: "it sends a single deletion when all the revisions of a release are deleted",
+			events: []watch.Event{
+				{Type: watch.Added, Object: releaseSecret(t, "my-apache", "default", 1, release.StatusSuperseded)},
+				{Type: watch.Added, Object: releaseSecret(t, "my-apache", "default", 2, release.StatusDeployed)},
+				{Type: watch.Deleted, Object: releaseSecret(t, "my-apache", "default", 2, release.StatusDeployed)},
+				{Type: watch.Deleted, Object: releaseSecret(t, "my-apache", "default", 1, release.StatusSuperseded)},
+			},
+			expectedResponses: []*corev1.WatchInstalledPackagesResponse{
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: summary(release.StatusSuperseded, false, corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: summary(release.StatusDeployed, true, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED,
+					InstalledPackageSummary: summary(release.StatusDeployed, true, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED),
+				},
+			},
+		},
+		{
+			name: "it ignores the deletion of previous revisions",
+			events: []watch.Event{
+				{Type: watch.Added, Object: releaseSecret(t, "my-apache", "default", 1, release.StatusSuperseded)},
+				{Type: watch.Added, Object: releaseSecret(t, "my-apache", "default", 2, release.StatusDeployed)},
+				{Type: watch.Deleted, Object: releaseSecret(t, "my-apache", "default", 1, release.StatusSuperseded)},
+			},
+			expectedResponses: []*corev1.WatchInstalledPackagesResponse{
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: summary(release.StatusSuperseded, false, corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED),
+				},
+				{
+					EventType:               corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: summary(release.StatusDeployed, true, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED),
+				},
+			},
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		corev1.WatchInstalledPackagesResponse{},
+		corev1.InstalledPackageSummary{},
+		corev1.InstalledPackageReference{},
+		corev1.InstalledPackageStatus{},
+		corev1.Context{},
+		corev1.VersionReference{},
+		corev1.PackageAppVersion{},
+		plugins.Plugin{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, _, cleanup := makeServer(t, true, nil)
+			defer cleanup()
+
+			fakeWatcher := watch.NewFake()
+			clientSet := typfake.NewSimpleClientset()
+			clientSet.PrependWatchReactor("secrets", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+				return true, fakeWatcher, nil
+			})
+			server.clientGetter = clientgetter.NewBuilder().WithTyped(clientSet).Build()
+
+			responses := []*corev1.WatchInstalledPackagesResponse{}
+			done := make(chan error)
+			go func() {
+				done <- server.WatchInstalledPackageEvents(context.Background(), http.Header{}, &corev1.WatchInstalledPackagesRequest{
+					Context: &corev1.Context{Namespace: "default"},
+				}, func(response *corev1.WatchInstalledPackagesResponse) error {
+					responses = append(responses, response)
+					return nil
+				})
+			}()
+
+			for _, event := range tc.events {
+				fakeWatcher.Action(event.Type, event.Object)
+			}
+			fakeWatcher.Stop()
+
+			if err := <-done; err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := responses, tc.expectedResponses; !cmp.Equal(got, want, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
@@ -45,6 +45,7 @@ var _ corev1connect.RepositoriesServiceHandler = (*Server)(nil)
 // Server implements the kapp-controller packages v1alpha1 interface.
 type Server struct {
 	v1alpha1.UnimplementedKappControllerPackagesServiceServer
+	pkgutils.CorePackagesRPCs
 	// clientGetter is a field so that it can be switched in tests for
 	// a fake client. NewServer() below sets this automatically with the
 	// non-test implementation.
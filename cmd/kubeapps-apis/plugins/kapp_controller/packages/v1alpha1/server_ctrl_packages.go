@@ -6,6 +6,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -14,7 +15,6 @@ import (
 	"github.com/bufbuild/connect-go"
 	packagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/packaging/v1alpha1"
 	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
-	kappctrlpackageinstall "github.com/vmware-tanzu/carvel-kapp-controller/pkg/packageinstall"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/connecterror"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
@@ -22,6 +22,9 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	log "k8s.io/klog/v2"
 )
 
@@ -566,6 +569,9 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *connect.Re
 	if request.Msg.GetReconciliationOptions() == nil || request.Msg.GetReconciliationOptions().GetServiceAccountName() == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("No request ReconciliationOptions serviceAccountName provided"))
 	}
+	if request.Msg.GetDryRun() {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.DryRun: [%v]", request.Msg.GetDryRun()))
+	}
 
 	// Retrieve additional parameters from the request
 	identifier := request.Msg.GetAvailablePackageRef().GetIdentifier()
@@ -684,6 +690,9 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Re
 	if request.Msg.GetInstalledPackageRef().GetIdentifier() == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("No request Name provided"))
 	}
+	if request.Msg.GetDryRun() {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Not supported yet: request.DryRun: [%v]", request.Msg.GetDryRun()))
+	}
 
 	// Retrieve additional parameters from the request
 	reconciliationOptions := request.Msg.GetReconciliationOptions()
@@ -732,7 +741,7 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *connect.Re
 		if pkgInstall.ObjectMeta.Annotations == nil {
 			pkgInstall.ObjectMeta.Annotations = map[string]string{}
 		}
-		pkgInstall.ObjectMeta.Annotations[kappctrlpackageinstall.DowngradableAnnKey] = ""
+		pkgInstall.ObjectMeta.Annotations[DOWNGRADABLE_ANNOTATION] = ""
 	}
 
 	// Update the rest of the fields
@@ -880,6 +889,78 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 	}), nil
 }
 
+// WatchInstalledPackages streams the changes of the installed packages managed by the 'kapp_controller' plugin
+func (s *Server) WatchInstalledPackages(ctx context.Context, request *connect.Request[corev1.WatchInstalledPackagesRequest], stream *connect.ServerStream[corev1.WatchInstalledPackagesResponse]) error {
+	return s.WatchInstalledPackageEvents(ctx, request.Header(), request.Msg, stream.Send)
+}
+
+// WatchInstalledPackageEvents watches the package installs of the requested
+// context, sending an event for each change until the context is done.
+func (s *Server) WatchInstalledPackageEvents(ctx context.Context, headers http.Header, request *corev1.WatchInstalledPackagesRequest, send func(*corev1.WatchInstalledPackagesResponse) error) error {
+	// Retrieve parameters from the request
+	cluster := request.GetContext().GetCluster()
+	namespace := request.GetContext().GetNamespace()
+	log.InfoS("+kapp-controller WatchInstalledPackages", "cluster", cluster, "namespace", namespace)
+
+	// Assume the default cluster if none is specified
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	resource, err := s.getPkgInstallResource(headers, cluster, namespace)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to get the PackageInstall resource: %w", err))
+	}
+	watcher, err := resource.Watch(ctx, metav1.ListOptions{})
+	if err != nil {
+		return connecterror.FromK8sError("watch", "PackageInstall", namespace+"/*", err)
+	}
+	defer watcher.Stop()
+
+	for {
+		var event watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok = <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+		}
+
+		var eventType corev1.WatchInstalledPackagesResponse_EventType
+		switch event.Type {
+		case watch.Added:
+			eventType = corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED
+		case watch.Modified:
+			eventType = corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED
+		case watch.Deleted:
+			eventType = corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED
+		case watch.Error:
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to watch the PackageInstalls: %w", errors.FromObject(event.Object)))
+		default:
+			continue
+		}
+
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var pkgInstall packagingv1alpha1.PackageInstall
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pkgInstall); err != nil {
+			log.Warningf("+kapp-controller unable to convert the PackageInstall %s/%s: %v", obj.GetNamespace(), obj.GetName(), err)
+			continue
+		}
+		if err := send(&corev1.WatchInstalledPackagesResponse{
+			EventType:               eventType,
+			InstalledPackageSummary: s.buildWatchedInstalledPackageSummary(&pkgInstall, cluster),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
 func (s *Server) GetAvailablePackageMetadatas(ctx context.Context, request *connect.Request[corev1.GetAvailablePackageMetadatasRequest]) (*connect.Response[corev1.GetAvailablePackageMetadatasResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
 }
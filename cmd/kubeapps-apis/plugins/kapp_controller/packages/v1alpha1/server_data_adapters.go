@@ -18,7 +18,6 @@ import (
 	kappctrlv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/kappctrl/v1alpha1"
 	packagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/packaging/v1alpha1"
 	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
-	kappctrlpackageinstall "github.com/vmware-tanzu/carvel-kapp-controller/pkg/packageinstall"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	kappcorev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
@@ -210,11 +209,7 @@ func (s *Server) buildInstalledPackageSummary(pkgInstall *packagingv1alpha1.Pack
 			Version: pkgInstall.Status.LastAttemptedVersion,
 		},
 		ShortDescription: pkgMetadata.Spec.ShortDescription,
-		Status: &corev1.InstalledPackageStatus{
-			Ready:      false,
-			Reason:     corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
-			UserReason: simpleUserReasonForKappStatus(""),
-		},
+		Status:           buildInstalledPackageStatus(pkgInstall),
 	}
 
 	if latestMatchingVersion != nil {
@@ -226,15 +221,50 @@ func (s *Server) buildInstalledPackageSummary(pkgInstall *packagingv1alpha1.Pack
 		}
 	}
 
-	if len(pkgInstall.Status.Conditions) > 0 {
-		installedPackageSummary.Status = &corev1.InstalledPackageStatus{
-			Ready:      pkgInstall.Status.Conditions[0].Type == kappctrlv1alpha1.ReconcileSucceeded,
-			Reason:     statusReasonForKappStatus(pkgInstall.Status.Conditions[0].Type),
-			UserReason: simpleUserReasonForKappStatus(pkgInstall.Status.Conditions[0].Type),
-		}
+	return installedPackageSummary, nil
+}
+
+// buildWatchedInstalledPackageSummary returns the summary of a watched package
+// install, which only includes the data available in the PackageInstall itself.
+func (s *Server) buildWatchedInstalledPackageSummary(pkgInstall *packagingv1alpha1.PackageInstall, cluster string) *corev1.InstalledPackageSummary {
+	return &corev1.InstalledPackageSummary{
+		// Currently, PkgVersion and AppVersion are the same
+		// https://kubernetes.slack.com/archives/CH8KCCKA5/p1636386358322000?thread_ts=1636371493.320900&cid=CH8KCCKA5
+		CurrentVersion: &corev1.PackageAppVersion{
+			PkgVersion: pkgInstall.Status.LastAttemptedVersion,
+			AppVersion: pkgInstall.Status.LastAttemptedVersion,
+		},
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Namespace: pkgInstall.Namespace,
+				Cluster:   cluster,
+			},
+			Plugin:     &pluginDetail,
+			Identifier: pkgInstall.Name,
+		},
+		Name: pkgInstall.Name,
+		PkgVersionReference: &corev1.VersionReference{
+			Version: pkgInstall.Status.LastAttemptedVersion,
+		},
+		Status: buildInstalledPackageStatus(pkgInstall),
 	}
+}
 
-	return installedPackageSummary, nil
+// buildInstalledPackageStatus returns the status of a package install based on
+// its first condition, being pending if there is none yet.
+func buildInstalledPackageStatus(pkgInstall *packagingv1alpha1.PackageInstall) *corev1.InstalledPackageStatus {
+	if len(pkgInstall.Status.Conditions) == 0 {
+		return &corev1.InstalledPackageStatus{
+			Ready:      false,
+			Reason:     corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+			UserReason: simpleUserReasonForKappStatus(""),
+		}
+	}
+	return &corev1.InstalledPackageStatus{
+		Ready:      pkgInstall.Status.Conditions[0].Type == kappctrlv1alpha1.ReconcileSucceeded,
+		Reason:     statusReasonForKappStatus(pkgInstall.Status.Conditions[0].Type),
+		UserReason: simpleUserReasonForKappStatus(pkgInstall.Status.Conditions[0].Type),
+	}
 }
 
 func (s *Server) buildInstalledPackageDetail(pkgInstall *packagingv1alpha1.PackageInstall, pkgMetadata *datapackagingv1alpha1.PackageMetadata, pkgVersionsMap map[string][]pkgSemver, app *kappctrlv1alpha1.App, valuesApplied, cluster string) (*corev1.InstalledPackageDetail, error) {
@@ -398,7 +428,7 @@ func (s *Server) buildPkgInstall(installedPackageName, targetCluster, targetName
 	// Allow this PackageInstall to be downgraded
 	// https://carvel.dev/kapp-controller/docs/v0.32.0/package-consumer-concepts/#downgrading
 	if s.pluginConfig.defaultAllowDowngrades {
-		pkgInstall.ObjectMeta.Annotations[kappctrlpackageinstall.DowngradableAnnKey] = ""
+		pkgInstall.ObjectMeta.Annotations[DOWNGRADABLE_ANNOTATION] = ""
 	}
 
 	if reconciliationOptions != nil {
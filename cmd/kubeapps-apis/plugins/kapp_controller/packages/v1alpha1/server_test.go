@@ -32,7 +32,6 @@ import (
 	kappctrlv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/kappctrl/v1alpha1"
 	packagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/packaging/v1alpha1"
 	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
-	kappctrlpackageinstall "github.com/vmware-tanzu/carvel-kapp-controller/pkg/packageinstall"
 	ctlapp "github.com/vmware-tanzu/carvel-kapp/pkg/kapp/app"
 	kappcmdapp "github.com/vmware-tanzu/carvel-kapp/pkg/kapp/cmd/app"
 	kappcmdcore "github.com/vmware-tanzu/carvel-kapp/pkg/kapp/cmd/core"
@@ -49,6 +48,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	disfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynfake "k8s.io/client-go/dynamic/fake"
@@ -97,6 +97,7 @@ var ignoreUnexported = cmpopts.IgnoreUnexported(
 	corev1.TlsCertKey{},
 	corev1.UsernamePassword{},
 	corev1.UpdateInstalledPackageResponse{},
+	corev1.WatchInstalledPackagesResponse{},
 	corev1.VersionReference{},
 	kappControllerPluginParsedConfig{},
 	pluginv1.Plugin{},
@@ -2830,6 +2831,150 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
+func TestWatchInstalledPackageEvents(t *testing.T) {
+	pkgInstall := func(conditionType kappctrlv1alpha1.ConditionType) *unstructured.Unstructured {
+		pkgInstall := &packagingv1alpha1.PackageInstall{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgInstallResource,
+				APIVersion: packagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "my-installation",
+			},
+			Spec: packagingv1alpha1.PackageInstallSpec{
+				PackageRef: &packagingv1alpha1.PackageRef{
+					RefName: "tetris.foo.example.com",
+				},
+			},
+			Status: packagingv1alpha1.PackageInstallStatus{
+				Version:              "1.2.3",
+				LastAttemptedVersion: "1.2.3",
+			},
+		}
+		if conditionType != "" {
+			pkgInstall.Status.Conditions = []kappctrlv1alpha1.Condition{{
+				Type:   conditionType,
+				Status: k8scorev1.ConditionTrue,
+			}}
+		}
+		unstructuredContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(pkgInstall)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return &unstructured.Unstructured{Object: unstructuredContent}
+	}
+	summary := func(status *corev1.InstalledPackageStatus) *corev1.InstalledPackageSummary {
+		return &corev1.InstalledPackageSummary{
+			CurrentVersion: &corev1.PackageAppVersion{
+				PkgVersion: "1.2.3",
+				AppVersion: "1.2.3",
+			},
+			InstalledPackageRef: &corev1.InstalledPackageReference{
+				Context:    defaultContext,
+				Plugin:     &pluginDetail,
+				Identifier: "my-installation",
+			},
+			Name: "my-installation",
+			PkgVersionReference: &corev1.VersionReference{
+				Version: "1.2.3",
+			},
+			Status: status,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		events            []watch.Event
+		expectedResponses []*corev1.WatchInstalledPackagesResponse
+	}{
+		{
+			name: "it sends the status changes of a package install",
+			events: []watch.Event{
+				{Type: watch.Added, Object: pkgInstall("")},
+				{Type: watch.Modified, Object: pkgInstall(kappctrlv1alpha1.Reconciling)},
+				{Type: watch.Modified, Object: pkgInstall(kappctrlv1alpha1.ReconcileSucceeded)},
+				{Type: watch.Deleted, Object: pkgInstall(kappctrlv1alpha1.ReconcileSucceeded)},
+			},
+			expectedResponses: []*corev1.WatchInstalledPackagesResponse{
+				{
+					EventType: corev1.WatchInstalledPackagesResponse_EVENT_TYPE_ADDED,
+					InstalledPackageSummary: summary(&corev1.InstalledPackageStatus{
+						Reason:     corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+						UserReason: "No status information yet",
+					}),
+				},
+				{
+					EventType: corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: summary(&corev1.InstalledPackageStatus{
+						Reason:     corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+						UserReason: "Reconciling",
+					}),
+				},
+				{
+					EventType: corev1.WatchInstalledPackagesResponse_EVENT_TYPE_MODIFIED,
+					InstalledPackageSummary: summary(&corev1.InstalledPackageStatus{
+						Ready:      true,
+						Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+						UserReason: "Deployed",
+					}),
+				},
+				{
+					EventType: corev1.WatchInstalledPackagesResponse_EVENT_TYPE_DELETED,
+					InstalledPackageSummary: summary(&corev1.InstalledPackageStatus{
+						Ready:      true,
+						Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+						UserReason: "Deployed",
+					}),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeWatcher := watch.NewFake()
+			dynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				k8sruntime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}: pkgInstallResource + "List",
+				},
+			)
+			dynClient.PrependWatchReactor(pkgInstallsResource, func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+				return true, fakeWatcher, nil
+			})
+			s := Server{
+				pluginConfig:           defaultPluginConfig,
+				globalPackagingCluster: defaultContext.Cluster,
+				clientGetter:           clientgetter.NewBuilder().WithDynamic(dynClient).Build(),
+			}
+
+			responses := []*corev1.WatchInstalledPackagesResponse{}
+			done := make(chan error)
+			go func() {
+				done <- s.WatchInstalledPackageEvents(context.Background(), http.Header{}, &corev1.WatchInstalledPackagesRequest{
+					Context: defaultContext,
+				}, func(response *corev1.WatchInstalledPackagesResponse) error {
+					responses = append(responses, response)
+					return nil
+				})
+			}()
+
+			for _, event := range tc.events {
+				fakeWatcher.Action(event.Type, event.Object)
+			}
+			fakeWatcher.Stop()
+
+			if err := <-done; err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := responses, tc.expectedResponses; !cmp.Equal(got, want, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageDetail(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -5431,7 +5576,7 @@ func TestCreateInstalledPackage(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:   "default",
 					Name:        "my-installation",
-					Annotations: map[string]string{kappctrlpackageinstall.DowngradableAnnKey: ""},
+					Annotations: map[string]string{DOWNGRADABLE_ANNOTATION: ""},
 				},
 				Spec: packagingv1alpha1.PackageInstallSpec{
 					ServiceAccountName: "default",
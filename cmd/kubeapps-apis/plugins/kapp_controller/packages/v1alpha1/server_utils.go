@@ -31,6 +31,11 @@ import (
 const REPO_REF_ANNOTATION = "packaging.carvel.dev/package-repository-ref"
 const DEFAULT_REPO_NAME = "unknown"
 
+// DOWNGRADABLE_ANNOTATION allows a PackageInstall to be downgraded, as defined
+// by the packageinstall package of kapp-controller, which is not imported as it
+// depends on the kapp-controller reconcilers.
+const DOWNGRADABLE_ANNOTATION = "packaging.carvel.dev/downgradable"
+
 type pkgSemver struct {
 	pkg     *datapackagingv1alpha1.Package
 	version *semver.Version
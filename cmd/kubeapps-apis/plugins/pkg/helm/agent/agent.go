@@ -4,7 +4,9 @@
 package agent
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,34 +24,158 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// dryRunOption is the Helm dry-run mode used when rendering releases. The "server"
+// mode allows charts using the lookup function to query the cluster.
+const dryRunOption = "server"
+
+// defaultWaitTimeout is the timeout used when waiting for the resources of a
+// release to be ready without a configured timeout, as for the Helm CLI.
+const defaultWaitTimeout = 5 * time.Minute
+
+// WaitOptions configures whether an install or upgrade waits for the
+// resources of the release to be ready before being considered successful.
+type WaitOptions struct {
+	// Wait until the resources are ready, up to the timeout.
+	Wait bool
+	// WaitForJobs also waits until the jobs are completed. Implies Wait.
+	WaitForJobs bool
+	// Atomic rolls back an upgrade which fails or times out. Implies Wait.
+	Atomic bool
+}
+
+// wait returns whether the resources of the release are waited for.
+func (o WaitOptions) wait() bool {
+	return o.Wait || o.WaitForJobs || o.Atomic
+}
+
+// commandTimeout returns the timeout of an install or upgrade. As the timeout
+// also bounds the wait for the resources to be ready, a default one is used
+// when waiting without a configured timeout.
+func commandTimeout(timeoutSeconds int32, waitOptions WaitOptions) time.Duration {
+	if timeoutSeconds > 0 {
+		return time.Duration(timeoutSeconds) * time.Second
+	}
+	if waitOptions.wait() {
+		return defaultWaitTimeout
+	}
+	return 0
+}
+
+// The names of the Helm storage drivers, as for the HELM_DRIVER environment
+// variable of the Helm CLI.
+const (
+	StorageDriverSecret    = "secret"
+	StorageDriverConfigMap = "configmap"
+	StorageDriverSQL       = "sql"
+)
+
 // StorageForDriver is a function type which returns a specific storage.
-type StorageForDriver func(namespace string, clientset *kubernetes.Clientset) *storage.Storage
+type StorageForDriver func(namespace string, clientset *kubernetes.Clientset) (*storage.Storage, error)
 
 // StorageForSecrets returns a storage using the Secret driver.
-func StorageForSecrets(namespace string, clientset *kubernetes.Clientset) *storage.Storage {
+func StorageForSecrets(namespace string, clientset *kubernetes.Clientset) (*storage.Storage, error) {
 	d := driver.NewSecrets(clientset.CoreV1().Secrets(namespace))
 	d.Log = log.Infof
-	return storage.Init(d)
+	return storage.Init(d), nil
+}
+
+// StorageForConfigMaps returns a storage using the ConfigMap driver.
+func StorageForConfigMaps(namespace string, clientset *kubernetes.Clientset) (*storage.Storage, error) {
+	d := driver.NewConfigMaps(clientset.CoreV1().ConfigMaps(namespace))
+	d.Log = log.Infof
+	return storage.Init(d), nil
+}
+
+// NewStorageForDriver returns the function returning the storages of the named
+// driver. The connection string is only used by the SQL driver.
+func NewStorageForDriver(driverName, sqlConnectionString string) (StorageForDriver, error) {
+	switch driverName {
+	case "", StorageDriverSecret:
+		return StorageForSecrets, nil
+	case StorageDriverConfigMap:
+		return StorageForConfigMaps, nil
+	case StorageDriverSQL:
+		if sqlConnectionString == "" {
+			return nil, fmt.Errorf("the %q storage driver requires a database", StorageDriverSQL)
+		}
+		return StorageForSQL(sqlConnectionString), nil
+	}
+	return nil, fmt.Errorf("unsupported helm storage driver %q, expected one of %q, %q or %q", driverName, StorageDriverSecret, StorageDriverConfigMap, StorageDriverSQL)
+}
+
+// unstoredValuesDriver is a storage driver storing the releases of a chart
+// with its original default values rather than the ones used to render them.
+type unstoredValuesDriver struct {
+	driver.Driver
+	chart  *chart.Chart
+	values map[string]interface{}
+}
+
+func (d *unstoredValuesDriver) Create(key string, rls *release.Release) error {
+	return d.Driver.Create(key, d.storedRelease(rls))
+}
+
+func (d *unstoredValuesDriver) Update(key string, rls *release.Release) error {
+	return d.Driver.Update(key, d.storedRelease(rls))
+}
+
+// storedRelease returns a copy of the release with the original default values
+// of the chart, leaving the release rendered by Helm untouched.
+func (d *unstoredValuesDriver) storedRelease(rls *release.Release) *release.Release {
+	if rls == nil || rls.Chart != d.chart {
+		return rls
+	}
+	stored := *rls
+	ch := *rls.Chart
+	ch.Values = d.values
+	stored.Chart = &ch
+	return &stored
 }
 
-// CreateRelease creates a release.
+// WithUnstoredValues returns a copy of the chart with the given default values,
+// which are used to render the releases of the action config without being
+// stored with them: the storage of the action config keeps storing the original
+// default values of the chart.
+func WithUnstoredValues(actionConfig *action.Configuration, ch *chart.Chart, values map[string]interface{}) *chart.Chart {
+	rendered := *ch
+	rendered.Values = values
+	actionConfig.Releases.Driver = &unstoredValuesDriver{
+		Driver: actionConfig.Releases.Driver,
+		chart:  &rendered,
+		values: ch.Values,
+	}
+	return &rendered
+}
+
+// CreateRelease creates a release. When dryRun is set, the release is only rendered
+// (against the cluster) and neither stored nor installed.
 func CreateRelease(actionConfig *action.Configuration, name, namespace, valueString string,
-	ch *chart.Chart, registrySecrets map[string]string, timeoutSeconds int32) (*release.Release, error) {
+	ch *chart.Chart, registrySecrets map[string]string, postRenderers PostRendererChain, timeoutSeconds int32, dryRun bool, waitOptions WaitOptions) (*release.Release, error) {
 	// Check if the release already exists
 	_, err := GetRelease(actionConfig, name)
 	if err == nil {
 		return nil, fmt.Errorf("release %s already exists", name)
 	}
-	cmd, err := newInstallCommand(actionConfig, name, namespace, registrySecrets, timeoutSeconds)
+	cmd, err := newInstallCommand(actionConfig, name, namespace, registrySecrets, postRenderers, timeoutSeconds, dryRun, waitOptions)
 	if err != nil {
 		return nil, err
 	}
+	return runInstallCommand(cmd, actionConfig, valueString, ch, timeoutSeconds)
+}
+
+// runInstallCommand runs an install command, deleting the release if it fails.
+func runInstallCommand(cmd *action.Install, actionConfig *action.Configuration, valueString string, ch *chart.Chart, timeoutSeconds int32) (*release.Release, error) {
+	name, dryRun := cmd.ReleaseName, cmd.DryRun
 	values, err := getValues([]byte(valueString))
 	if err != nil {
 		return nil, err
 	}
 	release, err := cmd.Run(ch, values)
 	if err != nil {
+		if dryRun {
+			// Nothing was installed, so there is nothing to clean up.
+			return nil, fmt.Errorf("release %q failed to render: %v", name, err)
+		}
 		// Simulate the Atomic flag and delete the release if failed
 		errDelete := DeleteRelease(actionConfig, name, false, timeoutSeconds)
 		if errDelete != nil && !strings.Contains(errDelete.Error(), "release: not found") {
@@ -61,41 +187,83 @@ func CreateRelease(actionConfig *action.Configuration, name, namespace, valueStr
 }
 
 func newInstallCommand(actionConfig *action.Configuration, name string, namespace string,
-	registrySecrets map[string]string, timeoutSeconds int32) (*action.Install, error) {
+	registrySecrets map[string]string, postRenderers PostRendererChain, timeoutSeconds int32, dryRun bool, waitOptions WaitOptions) (*action.Install, error) {
 	cmd := action.NewInstall(actionConfig)
 	cmd.ReleaseName = name
 	cmd.Namespace = namespace
-	if timeoutSeconds > 0 {
-		// Given that `cmd.Wait` is not used, this timeout will only affect pre/post hooks
-		cmd.Timeout = time.Duration(timeoutSeconds) * time.Second
+	if dryRun {
+		cmd.DryRun = true
+		cmd.DryRunOption = dryRunOption
 	}
+	// Unless waiting, this timeout will only affect pre/post hooks. The Atomic
+	// flag is not used as a failed release is always deleted by CreateRelease.
+	cmd.Timeout = commandTimeout(timeoutSeconds, waitOptions)
+	cmd.Wait = waitOptions.wait()
+	cmd.WaitForJobs = waitOptions.WaitForJobs
 	var err error
-	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	cmd.PostRenderer, err = newPostRenderer(registrySecrets, postRenderers)
 	if err != nil {
 		return nil, err
 	}
 	return cmd, nil
 }
 
-// UpgradeRelease upgrades a release.
+// InstallOrUpgradeRelease installs a release, or upgrades it if it already
+// exists, adding the given labels to the stored release. It returns whether
+// the release was upgraded.
+func InstallOrUpgradeRelease(actionConfig *action.Configuration, name, namespace, valuesYaml string,
+	ch *chart.Chart, labels map[string]string, postRenderers PostRendererChain, timeoutSeconds int32, waitOptions WaitOptions) (*release.Release, bool, error) {
+	_, err := GetRelease(actionConfig, name)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, false, err
+	}
+	if err != nil {
+		cmd, err := newInstallCommand(actionConfig, name, namespace, nil, postRenderers, timeoutSeconds, false, waitOptions)
+		if err != nil {
+			return nil, false, err
+		}
+		cmd.Labels = labels
+		release, err := runInstallCommand(cmd, actionConfig, valuesYaml, ch, timeoutSeconds)
+		return release, false, err
+	}
+
+	log.InfoS("Upgrading release", "release-name", name)
+	cmd, err := newUpgradeCommand(actionConfig, nil, postRenderers, timeoutSeconds, false, waitOptions)
+	if err != nil {
+		return nil, true, err
+	}
+	cmd.Labels = labels
+	values, err := chartutil.ReadValues([]byte(valuesYaml))
+	if err != nil {
+		return nil, true, fmt.Errorf("unable to upgrade the release because values could not be parsed: %v", err)
+	}
+	release, err := cmd.Run(name, ch, values)
+	if err != nil {
+		return nil, true, fmt.Errorf("unable to upgrade the release: %v", err)
+	}
+	return release, true, nil
+}
+
+// RemovedLabel is the value of a label removing it from an upgraded release,
+// as Helm otherwise keeps the labels of the previous revision.
+const RemovedLabel = "null"
+
+// UpgradeRelease upgrades a release, merging the given labels with the ones of
+// the previous revision. When dryRun is set, the upgraded release is only
+// rendered and the deployed release is left untouched.
 func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
-	ch *chart.Chart, registrySecrets map[string]string, timeoutSeconds int32) (*release.Release, error) {
+	ch *chart.Chart, labels map[string]string, registrySecrets map[string]string, postRenderers PostRendererChain, timeoutSeconds int32, dryRun bool, waitOptions WaitOptions) (*release.Release, error) {
 	// Check if the release already exists:
 	_, err := GetRelease(actionConfig, name)
 	if err != nil {
 		return nil, err
 	}
 	log.InfoS("Upgrading release", "release-name", name)
-	cmd := action.NewUpgrade(actionConfig)
-	if timeoutSeconds > 0 {
-		// Given that `cmd.Wait` is not used, this timeout will only affect pre/post hooks
-		cmd.Timeout = time.Duration(timeoutSeconds) * time.Second
-	}
-
-	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	cmd, err := newUpgradeCommand(actionConfig, registrySecrets, postRenderers, timeoutSeconds, dryRun, waitOptions)
 	if err != nil {
 		return nil, err
 	}
+	cmd.Labels = labels
 	values, err := chartutil.ReadValues([]byte(valuesYaml))
 	if err != nil {
 		return nil, fmt.Errorf("unable to upgrade the release because values could not be parsed: %v", err)
@@ -107,6 +275,26 @@ func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
 	return res, nil
 }
 
+func newUpgradeCommand(actionConfig *action.Configuration, registrySecrets map[string]string, postRenderers PostRendererChain,
+	timeoutSeconds int32, dryRun bool, waitOptions WaitOptions) (*action.Upgrade, error) {
+	cmd := action.NewUpgrade(actionConfig)
+	// Unless waiting, this timeout will only affect pre/post hooks
+	cmd.Timeout = commandTimeout(timeoutSeconds, waitOptions)
+	cmd.Wait = waitOptions.wait()
+	cmd.WaitForJobs = waitOptions.WaitForJobs
+	cmd.Atomic = waitOptions.Atomic
+	if dryRun {
+		cmd.DryRun = true
+		cmd.DryRunOption = dryRunOption
+	}
+	var err error
+	cmd.PostRenderer, err = newPostRenderer(registrySecrets, postRenderers)
+	if err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
 // RollbackRelease rolls back a release to the specified revision.
 func RollbackRelease(actionConfig *action.Configuration, releaseName string, revision int, timeoutSeconds int32) (*release.Release, error) {
 	log.Infof("Rolling back %s to revision %d.", releaseName, revision)
@@ -126,6 +314,38 @@ func RollbackRelease(actionConfig *action.Configuration, releaseName string, rev
 	return GetRelease(actionConfig, releaseName)
 }
 
+// GetReleaseTestHooks returns the test hooks of a release, in the order in
+// which Helm runs them: by weight and then by name.
+func GetReleaseTestHooks(rel *release.Release) []*release.Hook {
+	testHooks := []*release.Hook{}
+	for _, h := range rel.Hooks {
+		for _, e := range h.Events {
+			if e == release.HookTest {
+				testHooks = append(testHooks, h)
+				break
+			}
+		}
+	}
+	sort.SliceStable(testHooks, func(i, j int) bool {
+		if testHooks[i].Weight == testHooks[j].Weight {
+			return testHooks[i].Name < testHooks[j].Name
+		}
+		return testHooks[i].Weight < testHooks[j].Weight
+	})
+	return testHooks
+}
+
+// RunReleaseTest runs a single test hook of a release. The last run of the hook
+// is recorded in the release, which is returned even when the test fails.
+func RunReleaseTest(actionConfig *action.Configuration, name, hookName string, timeoutSeconds int32) (*release.Release, error) {
+	log.Infof("Running the test %s of the release %s.", hookName, name)
+	cmd := action.NewReleaseTesting(actionConfig)
+	cmd.Filters[action.IncludeNameFilter] = []string{hookName}
+	// A test is only completed once its pod is, so the test is always waited for.
+	cmd.Timeout = commandTimeout(timeoutSeconds, WaitOptions{Wait: true})
+	return cmd.Run(name)
+}
+
 // GetRelease returns the info of a release.
 func GetRelease(actionConfig *action.Configuration, name string) (*release.Release, error) {
 	// Namespace is already known by the RESTClientGetter.
@@ -137,6 +357,33 @@ func GetRelease(actionConfig *action.Configuration, name string) (*release.Relea
 	return release, nil
 }
 
+// GetReleaseRevision returns the info of a specific revision of a release.
+func GetReleaseRevision(actionConfig *action.Configuration, name string, revision int) (*release.Release, error) {
+	// Namespace is already known by the RESTClientGetter.
+	cmd := action.NewGet(actionConfig)
+	cmd.Version = revision
+	return cmd.Run(name)
+}
+
+// GetReleaseHistory returns the revisions of a release, from the most recent
+// one. At most max revisions are returned, unless max is zero.
+func GetReleaseHistory(actionConfig *action.Configuration, name string, max int) ([]*release.Release, error) {
+	// Namespace is already known by the RESTClientGetter.
+	cmd := action.NewHistory(actionConfig)
+	history, err := cmd.Run(name)
+	if err != nil {
+		return nil, err
+	}
+	// The history action does not apply cmd.Max itself, nor sort the revisions.
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Version > history[j].Version
+	})
+	if max > 0 && len(history) > max {
+		history = history[:max]
+	}
+	return history, nil
+}
+
 // DeleteRelease deletes a release.
 func DeleteRelease(actionConfig *action.Configuration, name string, keepHistory bool, timeoutSeconds int32) error {
 	// Namespace is already known by the RESTClientGetter.
@@ -154,7 +401,10 @@ func DeleteRelease(actionConfig *action.Configuration, name string, keepHistory
 // Among other things, the action.Configuration controls which namespace the command is run against.
 func NewActionConfig(storageForDriver StorageForDriver, config *rest.Config, clientset *kubernetes.Clientset, namespace string) (*action.Configuration, error) {
 	actionConfig := new(action.Configuration)
-	store := storageForDriver(namespace, clientset)
+	store, err := storageForDriver(namespace, clientset)
+	if err != nil {
+		return nil, err
+	}
 	restClientGetter := NewConfigFlagsFromCluster(namespace, config)
 	actionConfig.RESTClientGetter = restClientGetter
 	actionConfig.KubeClient = kube.New(restClientGetter)
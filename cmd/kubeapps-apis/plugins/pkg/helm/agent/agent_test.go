@@ -6,9 +6,11 @@ package agent
 import (
 	"errors"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	kubechart "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1/utils"
 	chartFake "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1/utils/fake"
 	"helm.sh/helm/v3/pkg/action"
@@ -18,6 +20,7 @@ import (
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
@@ -79,6 +82,7 @@ func TestCreateReleases(t *testing.T) {
 		chartName         string
 		values            string
 		version           int
+		dryRun            bool
 		existingReleases  []releaseStub
 		remainingReleases int
 		shouldFail        bool
@@ -95,6 +99,19 @@ func TestCreateReleases(t *testing.T) {
 			remainingReleases: 2,
 			shouldFail:        false,
 		},
+		{
+			desc:      "dry-run a new release",
+			chartName: "mychart",
+			values:    "",
+			namespace: "default",
+			version:   1,
+			dryRun:    true,
+			existingReleases: []releaseStub{
+				{"otherchart", "default", 1, "1.0.0", release.StatusDeployed},
+			},
+			remainingReleases: 1,
+			shouldFail:        false,
+		},
 		{
 			desc:      "install with an existing name",
 			chartName: "mychart",
@@ -131,7 +148,7 @@ func TestCreateReleases(t *testing.T) {
 				ChartName: tc.chartName,
 			}, "")
 			// Perform test
-			rls, err := CreateRelease(actionConfig, tc.chartName, tc.namespace, tc.values, ch, nil, 0)
+			rls, err := CreateRelease(actionConfig, tc.chartName, tc.namespace, tc.values, ch, nil, nil, 0, tc.dryRun, WaitOptions{})
 			// Check result
 			if tc.shouldFail && err == nil {
 				t.Errorf("Should fail with %v; instead got %s in %s", tc.desc, tc.releaseName, tc.namespace)
@@ -279,6 +296,155 @@ func TestRollbackRelease(t *testing.T) {
 	}
 }
 
+func TestGetReleaseHistory(t *testing.T) {
+	testCases := []struct {
+		name             string
+		releases         []releaseStub
+		release          string
+		max              int
+		expectedVersions []int
+		shouldFail       bool
+	}{
+		{
+			name: "returns all revisions from the most recent",
+			releases: []releaseStub{
+				{"airwatch", "default", 1, "1.0.0", release.StatusSuperseded},
+				{"airwatch", "default", 3, "1.0.2", release.StatusDeployed},
+				{"airwatch", "default", 2, "1.0.1", release.StatusSuperseded},
+				{"otherrelease", "default", 1, "1.0.0", release.StatusDeployed},
+			},
+			release:          "airwatch",
+			expectedVersions: []int{3, 2, 1},
+		},
+		{
+			name: "returns at most max revisions",
+			releases: []releaseStub{
+				{"airwatch", "default", 1, "1.0.0", release.StatusSuperseded},
+				{"airwatch", "default", 2, "1.0.1", release.StatusSuperseded},
+				{"airwatch", "default", 3, "1.0.2", release.StatusDeployed},
+			},
+			release:          "airwatch",
+			max:              2,
+			expectedVersions: []int{3, 2},
+		},
+		{
+			name: "errors when the release does not exist",
+			releases: []releaseStub{
+				{"airwatch", "default", 1, "1.0.0", release.StatusDeployed},
+			},
+			release:    "apache",
+			shouldFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newActionConfigFixture(t)
+			makeReleases(t, cfg, tc.releases)
+
+			history, err := GetReleaseHistory(cfg, tc.release, tc.max)
+			if didFail := err != nil; didFail != tc.shouldFail {
+				t.Fatalf("wanted fail = %v, got err = %v", tc.shouldFail, err)
+			}
+
+			versions := []int{}
+			for _, r := range history {
+				versions = append(versions, r.Version)
+			}
+			if !tc.shouldFail && !cmp.Equal(versions, tc.expectedVersions) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tc.expectedVersions, versions))
+			}
+		})
+	}
+}
+
+func TestGetReleaseTestHooks(t *testing.T) {
+	rel := &release.Release{
+		Name: "airwatch",
+		Hooks: []*release.Hook{
+			{Name: "airwatch-test-b", Events: []release.HookEvent{release.HookTest}},
+			{Name: "airwatch-job", Events: []release.HookEvent{release.HookPostInstall}},
+			{Name: "airwatch-test-a", Events: []release.HookEvent{release.HookPreInstall, release.HookTest}},
+			{Name: "airwatch-test-first", Events: []release.HookEvent{release.HookTest}, Weight: -1},
+		},
+	}
+
+	names := []string{}
+	for _, h := range GetReleaseTestHooks(rel) {
+		names = append(names, h.Name)
+	}
+	if got, want := names, []string{"airwatch-test-first", "airwatch-test-a", "airwatch-test-b"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestRunReleaseTest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		testError     error
+		expectedPhase release.HookPhase
+		shouldFail    bool
+	}{
+		{
+			name:          "records a successful test",
+			expectedPhase: release.HookPhaseSucceeded,
+		},
+		{
+			name:          "records a failed test",
+			testError:     errors.New("pod failed"),
+			expectedPhase: release.HookPhaseFailed,
+			shouldFail:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newActionConfigFixture(t)
+			cfg.KubeClient = &kubefake.FailingKubeClient{
+				PrintingKubeClient:   kubefake.PrintingKubeClient{Out: io.Discard},
+				WatchUntilReadyError: tc.testError,
+			}
+			err := cfg.Releases.Create(&release.Release{
+				Name:      "airwatch",
+				Namespace: "default",
+				Version:   1,
+				Info:      &release.Info{Status: release.StatusDeployed},
+				Chart:     &chart.Chart{Metadata: &chart.Metadata{Version: "1.0.0"}},
+				Hooks: []*release.Hook{
+					{Name: "airwatch-test", Kind: "Pod", Events: []release.HookEvent{release.HookTest}},
+					{Name: "airwatch-other-test", Kind: "Pod", Events: []release.HookEvent{release.HookTest}},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rel, err := RunReleaseTest(cfg, "airwatch", "airwatch-test", 0)
+			if didFail := err != nil; didFail != tc.shouldFail {
+				t.Fatalf("wanted fail = %v, got err = %v", tc.shouldFail, err)
+			}
+
+			stored, err := cfg.Releases.Get("airwatch", 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range []*release.Release{rel, stored} {
+				phases := map[string]release.HookPhase{}
+				for _, h := range r.Hooks {
+					phases[h.Name] = h.LastRun.Phase
+				}
+				expectedPhases := map[string]release.HookPhase{
+					"airwatch-test":       tc.expectedPhase,
+					"airwatch-other-test": "",
+				}
+				if got, want := phases, expectedPhases; !cmp.Equal(got, want) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+				}
+			}
+		})
+	}
+}
+
 func TestUpgradeRelease(t *testing.T) {
 	const revisionBeingUpdated = 1
 	testCases := []struct {
@@ -327,7 +493,7 @@ func TestUpgradeRelease(t *testing.T) {
 			ch, _ := fakechart.GetChart(&kubechart.ChartDetails{
 				ChartName: tc.chartName,
 			}, "")
-			newRelease, err := UpgradeRelease(cfg, tc.release, tc.valuesYaml, ch, nil, 0)
+			newRelease, err := UpgradeRelease(cfg, tc.release, tc.valuesYaml, ch, nil, nil, nil, 0, false, WaitOptions{})
 			// Check for errors
 			if got, want := err != nil, tc.shouldFail; got != want {
 				t.Errorf("Failure: got: %v, want: %v", got, want)
@@ -358,6 +524,95 @@ func TestUpgradeRelease(t *testing.T) {
 	}
 }
 
+func TestInstallOrUpgradeRelease(t *testing.T) {
+	labels := map[string]string{"example.com/source": "local"}
+	testCases := []struct {
+		description      string
+		releases         []releaseStub
+		expectedRevision int
+		expectedUpgraded bool
+	}{
+		{
+			description:      "installs a new release",
+			releases:         []releaseStub{{"otherrls", "default", 1, "mychart", release.StatusDeployed}},
+			expectedRevision: 1,
+		},
+		{
+			description:      "upgrades an existing release",
+			releases:         []releaseStub{{"myrls", "default", 1, "mychart", release.StatusDeployed}},
+			expectedRevision: 2,
+			expectedUpgraded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			cfg := newActionConfigFixture(t)
+			makeReleases(t, cfg, tc.releases)
+			fakechart := chartFake.ChartClient{}
+			ch, _ := fakechart.GetChart(&kubechart.ChartDetails{
+				ChartName: "mynewchart",
+			}, "")
+
+			newRelease, upgraded, err := InstallOrUpgradeRelease(cfg, "myrls", "default", "", ch, labels, nil, 0, WaitOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := upgraded, tc.expectedUpgraded; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+			if got, want := newRelease.Version, tc.expectedRevision; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+			rel, err := cfg.Releases.Get("myrls", tc.expectedRevision)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := rel.Info.Status, release.StatusDeployed; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := rel.Labels, labels; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestWithUnstoredValues(t *testing.T) {
+	cfg := newActionConfigFixture(t)
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "mychart", Version: "1.0.0", APIVersion: chart.APIVersionV2},
+		Values:   map[string]interface{}{"password": "default"},
+		Templates: []*chart.File{
+			{Name: "templates/config.yaml", Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  password: {{ .Values.password }}\n")},
+		},
+	}
+
+	rendered := WithUnstoredValues(cfg, ch, map[string]interface{}{"password": "s3cr3t"})
+	newRelease, err := CreateRelease(cfg, "myrls", "default", "", rendered, nil, nil, 0, false, WaitOptions{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !strings.Contains(newRelease.Manifest, "password: s3cr3t") {
+		t.Errorf("the release was not rendered with the unstored values:\n%s", newRelease.Manifest)
+	}
+
+	rel, err := cfg.Releases.Get("myrls", 1)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := rel.Chart.Values, ch.Values; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if got, want := rel.Info.Status, release.StatusDeployed; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	// The release rendered by Helm is left untouched.
+	if got, want := newRelease.Chart.Values, rendered.Values; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
 func TestNewConfigFlagsFromCluster(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -400,9 +655,12 @@ func TestNewConfigFlagsFromCluster(t *testing.T) {
 // Make sure that Helm command gets the timeout
 func TestNewInstallCommand(t *testing.T) {
 	testCases := []struct {
-		name     string
-		timeout  int32
-		expected time.Duration
+		name                string
+		timeout             int32
+		waitOptions         WaitOptions
+		expected            time.Duration
+		expectedWait        bool
+		expectedWaitForJobs bool
 	}{
 		{
 			name:     "install command with no timeout",
@@ -419,12 +677,82 @@ func TestNewInstallCommand(t *testing.T) {
 			timeout:  33,
 			expected: time.Duration(33) * time.Second,
 		},
+		{
+			name:         "install command waiting with the default timeout",
+			timeout:      0,
+			waitOptions:  WaitOptions{Wait: true},
+			expected:     defaultWaitTimeout,
+			expectedWait: true,
+		},
+		{
+			name:                "install command waiting for jobs with timeout",
+			timeout:             33,
+			waitOptions:         WaitOptions{WaitForJobs: true},
+			expected:            time.Duration(33) * time.Second,
+			expectedWait:        true,
+			expectedWaitForJobs: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newActionConfigFixture(t)
+			cmd, err := newInstallCommand(cfg, "", "", nil, nil, tc.timeout, false, tc.waitOptions)
+
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := cmd.Timeout, tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := cmd.Wait, tc.expectedWait; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+			if got, want := cmd.WaitForJobs, tc.expectedWaitForJobs; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestNewUpgradeCommand(t *testing.T) {
+	testCases := []struct {
+		name                string
+		timeout             int32
+		waitOptions         WaitOptions
+		expected            time.Duration
+		expectedWait        bool
+		expectedWaitForJobs bool
+		expectedAtomic      bool
+	}{
+		{
+			name:     "upgrade command without waiting",
+			timeout:  33,
+			expected: time.Duration(33) * time.Second,
+		},
+		{
+			name:           "atomic upgrade command waiting with the default timeout",
+			timeout:        0,
+			waitOptions:    WaitOptions{Atomic: true},
+			expected:       defaultWaitTimeout,
+			expectedWait:   true,
+			expectedAtomic: true,
+		},
+		{
+			name:                "upgrade command waiting for jobs with timeout",
+			timeout:             33,
+			waitOptions:         WaitOptions{Wait: true, WaitForJobs: true},
+			expected:            time.Duration(33) * time.Second,
+			expectedWait:        true,
+			expectedWaitForJobs: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			cfg := newActionConfigFixture(t)
-			cmd, err := newInstallCommand(cfg, "", "", nil, tc.timeout)
+			cmd, err := newUpgradeCommand(cfg, nil, nil, tc.timeout, false, tc.waitOptions)
 
 			if err != nil {
 				t.Fatalf("%+v", err)
@@ -433,6 +761,74 @@ func TestNewInstallCommand(t *testing.T) {
 			if got, want := cmd.Timeout, tc.expected; got != want {
 				t.Errorf("got: %q, want: %q", got, want)
 			}
+			if got, want := cmd.Wait, tc.expectedWait; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+			if got, want := cmd.WaitForJobs, tc.expectedWaitForJobs; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+			if got, want := cmd.Atomic, tc.expectedAtomic; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestNewStorageForDriver(t *testing.T) {
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: "https://example.com"})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name               string
+		driverName         string
+		expectedDriverName string
+		expectErr          bool
+	}{
+		{
+			name:               "defaults to the secrets driver",
+			expectedDriverName: driver.SecretsDriverName,
+		},
+		{
+			name:               "returns the secrets driver",
+			driverName:         StorageDriverSecret,
+			expectedDriverName: driver.SecretsDriverName,
+		},
+		{
+			name:               "returns the configmaps driver",
+			driverName:         StorageDriverConfigMap,
+			expectedDriverName: driver.ConfigMapsDriverName,
+		},
+		{
+			name:       "returns an error for the sql driver without database",
+			driverName: StorageDriverSQL,
+			expectErr:  true,
+		},
+		{
+			name:       "returns an error for an unknown driver",
+			driverName: "memory",
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storageForDriver, err := NewStorageForDriver(tc.driverName, "")
+			if got, want := err != nil, tc.expectErr; got != want {
+				t.Fatalf("got: %t, want: %t. err: %+v", got, want, err)
+			}
+			if tc.expectErr {
+				return
+			}
+
+			store, err := storageForDriver("default", clientset)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := store.Driver.Name(), tc.expectedDriverName; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
 		})
 	}
 }
@@ -56,6 +56,18 @@ func NewDockerSecretsPostRenderer(secrets map[string]string) (*DockerSecretsPost
 }
 
 func (r *DockerSecretsPostRenderer) processResourceList(resourceList []interface{}) {
+	forEachResource(resourceList, func(kind string, resource map[string]interface{}) {
+		podSpec := getResourcePodSpec(kind, resource)
+		if podSpec == nil {
+			return
+		}
+		r.updatePodSpecWithPullSecrets(podSpec)
+	})
+}
+
+// forEachResource calls the function with each resource of the list,
+// including the items of the resources of list type.
+func forEachResource(resourceList []interface{}, fn func(kind string, resource map[string]interface{})) {
 	for _, resourceItem := range resourceList {
 		resource, ok := resourceItem.(map[string]interface{})
 		if !ok {
@@ -74,18 +86,14 @@ func (r *DockerSecretsPostRenderer) processResourceList(resourceList []interface
 		}
 		if items, ok := resource["items"]; ok {
 			if itemsSlice, ok := items.([]interface{}); ok {
-				r.processResourceList(itemsSlice)
+				forEachResource(itemsSlice, fn)
 			} else {
 				log.Errorf("Items of list type did not contain a slice: %+v", resource)
 			}
 			continue
 		}
 
-		podSpec := getResourcePodSpec(kind, resource)
-		if podSpec == nil {
-			continue
-		}
-		r.updatePodSpecWithPullSecrets(podSpec)
+		fn(kind, resource)
 	}
 }
 
@@ -96,6 +104,22 @@ func (r *DockerSecretsPostRenderer) Run(renderedManifests *bytes.Buffer) (modifi
 		return renderedManifests, nil
 	}
 
+	resourceList, err := decodeManifests(renderedManifests)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(mnelson): If re-rendering the entire manifest creates issues, we
+	// could instead find the correct byte position and insert the image pull
+	// secret into the byte stream at the relevant points, but this will be
+	// more complex.
+	r.processResourceList(resourceList)
+
+	return encodeManifests(resourceList)
+}
+
+// decodeManifests returns the untyped resources of the rendered manifests.
+func decodeManifests(renderedManifests *bytes.Buffer) ([]interface{}, error) {
 	decoder := yaml.NewDecoder(renderedManifests)
 	var resourceList []interface{}
 	for {
@@ -109,19 +133,17 @@ func (r *DockerSecretsPostRenderer) Run(renderedManifests *bytes.Buffer) (modifi
 		}
 		resourceList = append(resourceList, resource)
 	}
+	return resourceList, nil
+}
 
-	// TODO(mnelson): If re-rendering the entire manifest creates issues, we
-	// could instead find the correct byte position and insert the image pull
-	// secret into the byte stream at the relevant points, but this will be
-	// more complex.
-	r.processResourceList(resourceList)
-
-	modifiedManifests = bytes.NewBuffer([]byte{})
+// encodeManifests renders the untyped resources as yaml documents.
+func encodeManifests(resourceList []interface{}) (*bytes.Buffer, error) {
+	modifiedManifests := bytes.NewBuffer([]byte{})
 	encoder := yaml.NewEncoder(modifiedManifests)
 	defer encoder.Close()
 
 	for _, resource := range resourceList {
-		err = encoder.Encode(resource)
+		err := encoder.Encode(resource)
 		if err != nil {
 			return nil, err
 		}
@@ -149,23 +171,7 @@ func (r *DockerSecretsPostRenderer) updatePodSpecWithPullSecrets(podSpec map[str
 		return
 	}
 
-	// If there are existing pull secrets, initialise our slice with that value
-	// and additionally initialize a map keyed by secret name which we can
-	// use to test existence more easily.
-	var imagePullSecrets []map[string]interface{}
-	existingNames := map[string]bool{}
-	if existingPullSecrets, ok := podSpec["imagePullSecrets"]; ok {
-		for _, s := range existingPullSecrets.([]interface{}) {
-			pullSecret := s.(map[string]interface{})
-			if name, ok := pullSecret["name"]; ok {
-				if n, ok := name.(string); ok {
-					existingNames[n] = true
-					imagePullSecrets = append(imagePullSecrets, map[string]interface{}{"name": n})
-				}
-			}
-		}
-	}
-
+	var secretNames []string
 	for _, c := range containers {
 		container, ok := c.(map[string]interface{})
 		if !ok {
@@ -192,9 +198,36 @@ func (r *DockerSecretsPostRenderer) updatePodSpecWithPullSecrets(podSpec map[str
 		if !ok {
 			continue
 		}
+		log.Infof("Using imagePullSecret %q for fetching image %s", secretName, image)
+		secretNames = append(secretNames, secretName)
+	}
+
+	addImagePullSecrets(podSpec, secretNames)
+}
+
+// addImagePullSecrets appends the image pull secrets to the podSpec, unless
+// they are already included.
+func addImagePullSecrets(podSpec map[string]interface{}, secretNames []string) {
+	// If there are existing pull secrets, initialise our slice with that value
+	// and additionally initialize a map keyed by secret name which we can
+	// use to test existence more easily.
+	var imagePullSecrets []map[string]interface{}
+	existingNames := map[string]bool{}
+	if existingPullSecrets, ok := podSpec["imagePullSecrets"]; ok {
+		for _, s := range existingPullSecrets.([]interface{}) {
+			pullSecret := s.(map[string]interface{})
+			if name, ok := pullSecret["name"]; ok {
+				if n, ok := name.(string); ok {
+					existingNames[n] = true
+					imagePullSecrets = append(imagePullSecrets, map[string]interface{}{"name": n})
+				}
+			}
+		}
+	}
+
+	for _, secretName := range secretNames {
 		// Only add the secret if it's not already included in the image pull secrets.
 		if _, ok := existingNames[secretName]; !ok {
-			log.Infof("Appending imagePullSecret %q for fetching image %s", secretName, image)
 			imagePullSecrets = append(imagePullSecrets, map[string]interface{}{"name": secretName})
 			existingNames[secretName] = true
 		}
@@ -0,0 +1,287 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/postrender"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	log "k8s.io/klog/v2"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// PostRendererChain is a helm post-renderer which runs each of its
+// post-renderers, in order, on the output of the previous one.
+type PostRendererChain []postrender.PostRenderer
+
+// Run returns the rendered yaml once processed by every post-renderer.
+func (c PostRendererChain) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	for _, r := range c {
+		var err error
+		renderedManifests, err = r.Run(renderedManifests)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return renderedManifests, nil
+}
+
+// newPostRenderer returns the post-renderer of an install or upgrade: the
+// docker secrets post-renderer followed by the given post-renderers.
+func newPostRenderer(registrySecrets map[string]string, postRenderers PostRendererChain) (postrender.PostRenderer, error) {
+	dockerSecretsPostRenderer, err := NewDockerSecretsPostRenderer(registrySecrets)
+	if err != nil {
+		return nil, err
+	}
+	if len(postRenderers) == 0 {
+		return dockerSecretsPostRenderer, nil
+	}
+	return append(PostRendererChain{dockerSecretsPostRenderer}, postRenderers...), nil
+}
+
+// MetadataPostRenderer is a helm post-renderer which adds labels and
+// annotations, such as the team owning a release or its cost center, to every
+// resource and to the templates of the pods they create.
+type MetadataPostRenderer struct {
+	labels      map[string]string
+	annotations map[string]string
+}
+
+// NewMetadataPostRenderer returns a post renderer adding the labels and annotations.
+func NewMetadataPostRenderer(labels, annotations map[string]string) *MetadataPostRenderer {
+	return &MetadataPostRenderer{
+		labels:      labels,
+		annotations: annotations,
+	}
+}
+
+// Run returns the rendered yaml with the labels and annotations added,
+// replacing the values of those already set.
+func (r *MetadataPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	if len(r.labels) == 0 && len(r.annotations) == 0 {
+		return renderedManifests, nil
+	}
+	resourceList, err := decodeManifests(renderedManifests)
+	if err != nil {
+		return nil, err
+	}
+	forEachResource(resourceList, func(kind string, resource map[string]interface{}) {
+		r.updateMetadata(resource)
+		if podTemplate := getResourcePodTemplate(kind, resource); podTemplate != nil {
+			r.updateMetadata(podTemplate)
+		}
+	})
+	return encodeManifests(resourceList)
+}
+
+// updateMetadata adds the labels and annotations to the metadata of the object.
+func (r *MetadataPostRenderer) updateMetadata(object map[string]interface{}) {
+	metadata, ok := object["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+		object["metadata"] = metadata
+	}
+	setStringMapEntries(metadata, "labels", r.labels)
+	setStringMapEntries(metadata, "annotations", r.annotations)
+}
+
+// setStringMapEntries sets the entries of the map under the given key of the metadata.
+func setStringMapEntries(metadata map[string]interface{}, key string, entries map[string]string) {
+	if len(entries) == 0 {
+		return
+	}
+	current, ok := metadata[key].(map[string]interface{})
+	if !ok {
+		current = map[string]interface{}{}
+		metadata[key] = current
+	}
+	for k, v := range entries {
+		current[k] = v
+	}
+}
+
+// getResourcePodTemplate returns the object defining the metadata and spec of
+// the pods created by the resource, if any.
+func getResourcePodTemplate(kind string, resource map[string]interface{}) map[string]interface{} {
+	switch kind {
+	case "DaemonSet", "Deployment", "Job", "ReplicaSet", "ReplicationController", "StatefulSet":
+		return getMapForKeys([]string{"spec", "template"}, resource)
+	case "PodTemplate":
+		return getMapForKeys([]string{"template"}, resource)
+	case "CronJob":
+		return getMapForKeys([]string{"spec", "jobTemplate", "spec", "template"}, resource)
+	}
+	return nil
+}
+
+// ImagePullSecretsPostRenderer is a helm post-renderer which appends default
+// image pull secrets to every pod spec, whichever the registry of its images.
+type ImagePullSecretsPostRenderer struct {
+	secretNames []string
+}
+
+// NewImagePullSecretsPostRenderer returns a post renderer appending the image pull secrets.
+func NewImagePullSecretsPostRenderer(secretNames []string) *ImagePullSecretsPostRenderer {
+	return &ImagePullSecretsPostRenderer{
+		secretNames: secretNames,
+	}
+}
+
+// Run returns the rendered yaml with the image pull secrets appended.
+func (r *ImagePullSecretsPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	if len(r.secretNames) == 0 {
+		return renderedManifests, nil
+	}
+	resourceList, err := decodeManifests(renderedManifests)
+	if err != nil {
+		return nil, err
+	}
+	forEachResource(resourceList, func(kind string, resource map[string]interface{}) {
+		if podSpec := getResourcePodSpec(kind, resource); podSpec != nil {
+			addImagePullSecrets(podSpec, r.secretNames)
+		}
+	})
+	return encodeManifests(resourceList)
+}
+
+// PatchesPostRenderer is a helm post-renderer which applies kustomize-style
+// strategic merge patches. Each patch is a partial resource targeting the
+// resource with the same kind and name, and with the same apiVersion and
+// namespace when set in the patch. Resources of a type unknown to the client,
+// such as custom resources, are patched with a JSON merge patch.
+type PatchesPostRenderer struct {
+	patches []map[string]interface{}
+}
+
+// NewPatchesPostRenderer returns a post renderer applying the patches, each
+// of which may contain several yaml documents.
+func NewPatchesPostRenderer(patches []string) (*PatchesPostRenderer, error) {
+	r := &PatchesPostRenderer{}
+	for _, p := range patches {
+		decoder := yaml.NewDecoder(strings.NewReader(p))
+		for {
+			var patch map[string]interface{}
+			err := decoder.Decode(&patch)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the patch: %w", err)
+			}
+			if patch == nil {
+				continue
+			}
+			kind, _ := patch["kind"].(string)
+			metadata, _ := patch["metadata"].(map[string]interface{})
+			name, _ := metadata["name"].(string)
+			if kind == "" || name == "" {
+				return nil, fmt.Errorf("the patch does not identify its target with a kind and metadata.name: %+v", patch)
+			}
+			r.patches = append(r.patches, patch)
+		}
+	}
+	return r, nil
+}
+
+// Run returns the rendered yaml with the patches applied to their targets.
+func (r *PatchesPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	if len(r.patches) == 0 {
+		return renderedManifests, nil
+	}
+	resourceList, err := decodeManifests(renderedManifests)
+	if err != nil {
+		return nil, err
+	}
+	var patchErr error
+	forEachResource(resourceList, func(kind string, resource map[string]interface{}) {
+		for _, patch := range r.patches {
+			if patchErr != nil || !patchTargets(patch, kind, resource) {
+				continue
+			}
+			patchErr = applyPatch(resource, patch)
+		}
+	})
+	if patchErr != nil {
+		return nil, patchErr
+	}
+	return encodeManifests(resourceList)
+}
+
+// patchTargets returns whether the patch targets the resource.
+func patchTargets(patch map[string]interface{}, kind string, resource map[string]interface{}) bool {
+	if patch["kind"] != kind {
+		return false
+	}
+	if apiVersion, ok := patch["apiVersion"]; ok && apiVersion != resource["apiVersion"] {
+		return false
+	}
+	patchMetadata, _ := patch["metadata"].(map[string]interface{})
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	if patchMetadata["name"] != metadata["name"] {
+		return false
+	}
+	if namespace, ok := patchMetadata["namespace"]; ok && namespace != metadata["namespace"] {
+		return false
+	}
+	return true
+}
+
+// applyPatch replaces the content of the resource with the patched one.
+func applyPatch(resource, patch map[string]interface{}) error {
+	original, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return fmt.Errorf("invalid apiVersion %q: %w", apiVersion, err)
+	}
+	if object, err := scheme.Scheme.New(gv.WithKind(kind)); err == nil {
+		patched, err = strategicpatch.StrategicMergePatch(original, patchJSON, object)
+		if err != nil {
+			return fmt.Errorf("unable to apply the strategic merge patch to the %s %v: %w", kind, resource["metadata"], err)
+		}
+	} else {
+		patched, err = jsonpatch.MergePatch(original, patchJSON)
+		if err != nil {
+			return fmt.Errorf("unable to apply the merge patch to the %s %v: %w", kind, resource["metadata"], err)
+		}
+	}
+
+	// The patched resource is converted back to yaml, rather than unmarshaled
+	// from JSON, to keep integers as such.
+	patchedYAML, err := sigsyaml.JSONToYAML(patched)
+	if err != nil {
+		return err
+	}
+	var patchedResource map[string]interface{}
+	if err := yaml.Unmarshal(patchedYAML, &patchedResource); err != nil {
+		return err
+	}
+	log.Infof("Patched the %s %v", kind, patch["metadata"].(map[string]interface{})["name"])
+	for k := range resource {
+		delete(resource, k)
+	}
+	for k, v := range patchedResource {
+		resource[k] = v
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPostRendererChain(t *testing.T) {
+	input := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+        - image: example.com/my-app:1.0
+          name: my-app
+`
+	dockerSecretsPostRenderer, err := newPostRenderer(map[string]string{"example.com": "registry-secret"}, PostRendererChain{
+		NewImagePullSecretsPostRenderer([]string{"default-secret", "registry-secret"}),
+		NewMetadataPostRenderer(map[string]string{"team": "payments"}, nil),
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	renderedManifests, err := dockerSecretsPostRenderer.Run(bytes.NewBufferString(input))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// The registry secret of the docker secrets post-renderer comes first and
+	// is not duplicated by the later post-renderers.
+	expected := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+    labels:
+        team: payments
+    name: my-app
+spec:
+    template:
+        metadata:
+            labels:
+                team: payments
+        spec:
+            containers:
+                - image: example.com/my-app:1.0
+                  name: my-app
+            imagePullSecrets:
+                - name: registry-secret
+                - name: default-secret
+`
+	if got, want := renderedManifests.String(), expected; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestNewPostRendererWithoutPostRenderers(t *testing.T) {
+	r, err := newPostRenderer(nil, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, ok := r.(*DockerSecretsPostRenderer); !ok {
+		t.Errorf("got: %T, want: *DockerSecretsPostRenderer", r)
+	}
+}
+
+func TestMetadataPostRenderer(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       string
+		labels      map[string]string
+		annotations map[string]string
+		output      string
+	}{
+		{
+			name:   "it returns the input without parsing when no labels or annotations set",
+			input:  `anything at : all`,
+			output: `anything at : all`,
+		},
+		{
+			name: "it adds the labels and annotations to the resources and their pod templates",
+			input: `apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: my-job
+  labels:
+    team: other
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: Never
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+`,
+			labels:      map[string]string{"team": "payments"},
+			annotations: map[string]string{"cost-center": "42"},
+			output: `apiVersion: batch/v1
+kind: CronJob
+metadata:
+    annotations:
+        cost-center: "42"
+    labels:
+        team: payments
+    name: my-job
+spec:
+    jobTemplate:
+        spec:
+            template:
+                metadata:
+                    annotations:
+                        cost-center: "42"
+                    labels:
+                        team: payments
+                spec:
+                    restartPolicy: Never
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+    annotations:
+        cost-center: "42"
+    labels:
+        team: payments
+    name: my-config
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			renderedManifests, err := NewMetadataPostRenderer(tc.labels, tc.annotations).Run(bytes.NewBufferString(tc.input))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := renderedManifests.String(), tc.output; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestImagePullSecretsPostRenderer(t *testing.T) {
+	input := `apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  containers:
+    - image: docker.io/bitnami/nginx
+      name: nginx
+  imagePullSecrets:
+    - name: existing-secret
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-service
+`
+	expected := `apiVersion: v1
+kind: Pod
+metadata:
+    name: my-pod
+spec:
+    containers:
+        - image: docker.io/bitnami/nginx
+          name: nginx
+    imagePullSecrets:
+        - name: existing-secret
+        - name: default-secret
+---
+apiVersion: v1
+kind: Service
+metadata:
+    name: my-service
+`
+
+	renderedManifests, err := NewImagePullSecretsPostRenderer([]string{"default-secret", "existing-secret"}).Run(bytes.NewBufferString(input))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if got, want := renderedManifests.String(), expected; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestNewPatchesPostRenderer(t *testing.T) {
+	testCases := []struct {
+		name            string
+		patches         []string
+		expectedPatches int
+		expectErr       bool
+	}{
+		{
+			name: "it parses each document of the patches",
+			patches: []string{`kind: Deployment
+metadata:
+  name: one
+---
+kind: Service
+metadata:
+  name: two
+`, `kind: Deployment
+metadata:
+  name: three
+`},
+			expectedPatches: 3,
+		},
+		{
+			name:      "it returns an error if a patch cannot be parsed as yaml",
+			patches:   []string{"v: [A,"},
+			expectErr: true,
+		},
+		{
+			name: "it returns an error if a patch has no target name",
+			patches: []string{`kind: Deployment
+spec:
+  replicas: 2
+`},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewPatchesPostRenderer(tc.patches)
+			if got, want := err != nil, tc.expectErr; got != want {
+				t.Fatalf("got: %t, want: %t. err: %+v", got, want, err)
+			}
+			if tc.expectErr {
+				return
+			}
+
+			if got, want := len(r.patches), tc.expectedPatches; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestPatchesPostRenderer(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		patches []string
+		output  string
+	}{
+		{
+			name: "it merges the containers of a known type by name",
+			input: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+        - image: nginx
+          name: nginx
+        - image: sidecar
+          name: sidecar
+`,
+			patches: []string{`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+        - name: sidecar
+          resources:
+            limits:
+              memory: 64Mi
+`},
+			output: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+    name: my-app
+spec:
+    replicas: 3
+    template:
+        spec:
+            containers:
+                - image: nginx
+                  name: nginx
+                - image: sidecar
+                  name: sidecar
+                  resources:
+                    limits:
+                        memory: 64Mi
+`,
+		},
+		{
+			name: "it merges a custom resource and ignores other targets",
+			input: `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: my-widget
+  namespace: default
+spec:
+  size: 1
+  color: blue
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: other-widget
+spec:
+  size: 1
+`,
+			patches: []string{`kind: Widget
+metadata:
+  name: my-widget
+spec:
+  size: 2
+  color: null
+---
+kind: Widget
+metadata:
+  name: my-widget
+  namespace: other
+spec:
+  size: 5
+`},
+			output: `apiVersion: example.com/v1
+kind: Widget
+metadata:
+    name: my-widget
+    namespace: default
+spec:
+    size: 2
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+    name: other-widget
+spec:
+    size: 1
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewPatchesPostRenderer(tc.patches)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			renderedManifests, err := r.Run(bytes.NewBufferString(tc.input))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := renderedManifests.String(), tc.output; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
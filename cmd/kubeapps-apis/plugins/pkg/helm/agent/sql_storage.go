@@ -0,0 +1,167 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	log "k8s.io/klog/v2"
+)
+
+// StorageForSQL returns a function returning storages using the SQL driver
+// against the PostgreSQL database of the connection string. As the SQL driver
+// opens a pool of connections and migrates the database when created, a single
+// driver is created and its pool shared by the storages of every namespace.
+// The database being accessed with the credentials of Kubeapps, each operation
+// of a storage is only allowed if the user could do the same on the secrets of
+// the namespace, in which the releases would be stored by the default driver.
+func StorageForSQL(connectionString string) StorageForDriver {
+	var mutex sync.Mutex
+	var shared *driver.SQL
+	return func(namespace string, clientset *kubernetes.Clientset) (*storage.Storage, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if shared == nil {
+			d, err := driver.NewSQL(connectionString, log.Infof, "")
+			if err != nil {
+				return nil, fmt.Errorf("unable to connect to the SQL storage of helm releases: %w", err)
+			}
+			shared = d
+		}
+		d := newAuthorizedDriver(sqlDriverForNamespace(shared, namespace), namespace, clientset.AuthorizationV1().SelfSubjectAccessReviews())
+		return storage.Init(d), nil
+	}
+}
+
+// sqlDriverForNamespace returns a copy of the SQL driver, sharing its pool of
+// connections, for the releases of the namespace. Helm only sets the namespace
+// of a SQL driver when creating it, which also opens a new pool.
+func sqlDriverForNamespace(shared *driver.SQL, namespace string) *driver.SQL {
+	d := *shared
+	field := reflect.ValueOf(&d).Elem().FieldByName("namespace")
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().SetString(namespace)
+	return &d
+}
+
+// authorizedDriver is a storage driver only running the operations of the
+// wrapped driver which the user is allowed to do on the secrets of the
+// namespace, as checked by a SelfSubjectAccessReview for each verb.
+type authorizedDriver struct {
+	driver.Driver
+	namespace     string
+	accessReviews authorizationv1client.SelfSubjectAccessReviewInterface
+
+	mutex        sync.Mutex
+	allowedVerbs map[string]bool
+}
+
+func newAuthorizedDriver(d driver.Driver, namespace string, accessReviews authorizationv1client.SelfSubjectAccessReviewInterface) *authorizedDriver {
+	return &authorizedDriver{
+		Driver:        d,
+		namespace:     namespace,
+		accessReviews: accessReviews,
+		allowedVerbs:  map[string]bool{},
+	}
+}
+
+// checkAccess returns a forbidden error if the user cannot use the verb on the
+// secrets of the namespace. The result of the review of each verb is kept for
+// the following operations.
+func (d *authorizedDriver) checkAccess(verb, key string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	allowed, ok := d.allowedVerbs[verb]
+	if !ok {
+		res, err := d.accessReviews.Create(context.TODO(), &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Group:     "",
+					Resource:  "secrets",
+					Verb:      verb,
+					Namespace: d.namespace,
+				},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to check the access to the helm releases of the namespace %q: %w", d.namespace, err)
+		}
+		allowed = res.Status.Allowed
+		d.allowedVerbs[verb] = allowed
+	}
+	if !allowed {
+		return k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, key, fmt.Errorf("the user cannot %s the secrets of the namespace %q, in which the helm releases are stored", verb, d.namespace))
+	}
+	return nil
+}
+
+// checkReleaseNamespace returns a forbidden error if the release is stored in
+// another namespace than the one for which the access was checked, as the SQL
+// driver stores a release in its own namespace.
+func (d *authorizedDriver) checkReleaseNamespace(key string, rls *release.Release) error {
+	if d.namespace != "" && rls.Namespace != d.namespace {
+		return k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, key, fmt.Errorf("the release of the namespace %q cannot be stored in the namespace %q", rls.Namespace, d.namespace))
+	}
+	return nil
+}
+
+func (d *authorizedDriver) Get(key string) (*release.Release, error) {
+	if err := d.checkAccess("get", key); err != nil {
+		return nil, err
+	}
+	return d.Driver.Get(key)
+}
+
+func (d *authorizedDriver) List(filter func(*release.Release) bool) ([]*release.Release, error) {
+	if err := d.checkAccess("list", ""); err != nil {
+		return nil, err
+	}
+	return d.Driver.List(filter)
+}
+
+func (d *authorizedDriver) Query(labels map[string]string) ([]*release.Release, error) {
+	if err := d.checkAccess("list", ""); err != nil {
+		return nil, err
+	}
+	return d.Driver.Query(labels)
+}
+
+func (d *authorizedDriver) Create(key string, rls *release.Release) error {
+	if err := d.checkReleaseNamespace(key, rls); err != nil {
+		return err
+	}
+	if err := d.checkAccess("create", key); err != nil {
+		return err
+	}
+	return d.Driver.Create(key, rls)
+}
+
+func (d *authorizedDriver) Update(key string, rls *release.Release) error {
+	if err := d.checkReleaseNamespace(key, rls); err != nil {
+		return err
+	}
+	if err := d.checkAccess("update", key); err != nil {
+		return err
+	}
+	return d.Driver.Update(key, rls)
+}
+
+func (d *authorizedDriver) Delete(key string) (*release.Release, error) {
+	if err := d.checkAccess("delete", key); err != nil {
+		return nil, err
+	}
+	return d.Driver.Delete(key)
+}
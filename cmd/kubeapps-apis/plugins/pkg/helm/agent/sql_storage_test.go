@@ -0,0 +1,134 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"reflect"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newAuthorizedMemoryStorage returns a storage of the releases of the default
+// namespace, only allowing the given verbs on its secrets, and the number of
+// access reviews run.
+func newAuthorizedMemoryStorage(t *testing.T, allowedVerbs ...string) (*storage.Storage, *int) {
+	t.Helper()
+	reviews := 0
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret k8sruntime.Object, err error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		allowed := false
+		for _, verb := range allowedVerbs {
+			allowed = allowed || (attributes.Verb == verb && attributes.Resource == "secrets" && attributes.Namespace == "default")
+		}
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	memory := driver.NewMemory()
+	memory.SetNamespace("default")
+	return storage.Init(newAuthorizedDriver(memory, "default", clientset.AuthorizationV1().SelfSubjectAccessReviews())), &reviews
+}
+
+func TestAuthorizedDriver(t *testing.T) {
+	rls := &release.Release{Name: "my-release", Namespace: "default", Version: 1, Info: &release.Info{Status: release.StatusDeployed}}
+
+	t.Run("it runs the operations allowed to the user", func(t *testing.T) {
+		store, reviews := newAuthorizedMemoryStorage(t, "get", "list", "create", "update", "delete")
+
+		if err := store.Create(rls); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if err := store.Update(rls); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := store.Get("my-release", 1); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := store.Get("my-release", 1); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		releases, err := store.ListReleases()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := len(releases), 1; got != want {
+			t.Errorf("got: %d, want: %d", got, want)
+		}
+		if _, err := store.Delete("my-release", 1); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		// each verb is reviewed once
+		if got, want := *reviews, 5; got != want {
+			t.Errorf("got: %d reviews, want: %d", got, want)
+		}
+	})
+
+	t.Run("it forbids the operations not allowed to the user", func(t *testing.T) {
+		store, _ := newAuthorizedMemoryStorage(t, "get", "list")
+
+		if err := store.Create(rls); !k8serrors.IsForbidden(err) {
+			t.Fatalf("got: %+v, want: forbidden", err)
+		}
+		releases, err := store.ListReleases()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := len(releases), 0; got != want {
+			t.Errorf("got: %d, want: %d", got, want)
+		}
+		if err := store.Update(rls); !k8serrors.IsForbidden(err) {
+			t.Errorf("got: %+v, want: forbidden", err)
+		}
+		if _, err := store.Delete("my-release", 1); !k8serrors.IsForbidden(err) {
+			t.Errorf("got: %+v, want: forbidden", err)
+		}
+	})
+
+	t.Run("it forbids reading the releases of a namespace not allowed to the user", func(t *testing.T) {
+		store, _ := newAuthorizedMemoryStorage(t)
+
+		if _, err := store.Get("my-release", 1); !k8serrors.IsForbidden(err) {
+			t.Errorf("got: %+v, want: forbidden", err)
+		}
+		if _, err := store.ListReleases(); !k8serrors.IsForbidden(err) {
+			t.Errorf("got: %+v, want: forbidden", err)
+		}
+		if _, err := store.History("my-release"); !k8serrors.IsForbidden(err) {
+			t.Errorf("got: %+v, want: forbidden", err)
+		}
+	})
+
+	t.Run("it forbids storing a release in another namespace", func(t *testing.T) {
+		store, _ := newAuthorizedMemoryStorage(t, "create")
+
+		other := &release.Release{Name: "my-release", Namespace: "other", Version: 1, Info: &release.Info{Status: release.StatusDeployed}}
+		if err := store.Create(other); !k8serrors.IsForbidden(err) {
+			t.Errorf("got: %+v, want: forbidden", err)
+		}
+	})
+}
+
+func TestSQLDriverForNamespace(t *testing.T) {
+	shared := &driver.SQL{}
+
+	d := sqlDriverForNamespace(shared, "my-namespace")
+
+	if got, want := reflect.ValueOf(d).Elem().FieldByName("namespace").String(), "my-namespace"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := reflect.ValueOf(shared).Elem().FieldByName("namespace").String(), ""; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
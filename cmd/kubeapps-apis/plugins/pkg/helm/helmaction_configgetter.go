@@ -18,7 +18,9 @@ import (
 
 type HelmActionConfigGetterFunc func(headers http.Header, namespace string) (*action.Configuration, error)
 
-func NewHelmActionConfigGetter(configGetter core.KubernetesConfigGetter, cluster string) HelmActionConfigGetterFunc {
+// NewHelmActionConfigGetter returns a function creating the action configs of
+// the cluster, storing the releases with the given storage driver.
+func NewHelmActionConfigGetter(configGetter core.KubernetesConfigGetter, cluster string, storageForDriver agent.StorageForDriver) HelmActionConfigGetterFunc {
 	return func(headers http.Header, namespace string) (*action.Configuration, error) {
 		if configGetter == nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("The configGetter arg is required"))
@@ -33,8 +35,10 @@ func NewHelmActionConfigGetter(configGetter core.KubernetesConfigGetter, cluster
 		if err != nil {
 			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("Unable to create kubernetes client due to: %w", err))
 		}
-		// TODO(mnelson): Update to allow different helm storage options.
-		storage := agent.StorageForSecrets(namespace, clientSet)
+		storage, err := storageForDriver(namespace, clientSet)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("Unable to create the helm storage due to: %w", err))
+		}
 		return &action.Configuration{
 			RESTClientGetter: restClientGetter,
 			KubeClient:       kube.New(restClientGetter),
@@ -0,0 +1,41 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pkgutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+)
+
+// CorePackagesRPCs is embedded by the packaging plugins for the RPCs of the
+// core packages service which are implemented by the core itself, using the
+// other RPCs of each plugin, rather than by the plugins.
+type CorePackagesRPCs struct{}
+
+// ValidateInstalledPackageValues is implemented by the core using the available
+// package detail of each plugin.
+func (CorePackagesRPCs) ValidateInstalledPackageValues(ctx context.Context, request *connect.Request[corev1.ValidateInstalledPackageValuesRequest]) (*connect.Response[corev1.ValidateInstalledPackageValuesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
+
+// BulkUpdateInstalledPackages is implemented by the core using the installed
+// package operations of each plugin.
+func (CorePackagesRPCs) BulkUpdateInstalledPackages(ctx context.Context, request *connect.Request[corev1.BulkUpdateInstalledPackagesRequest]) (*connect.Response[corev1.BulkUpdateInstalledPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
+
+// ExportInstalledPackages is implemented by the core using the installed
+// package operations of each plugin.
+func (CorePackagesRPCs) ExportInstalledPackages(ctx context.Context, request *connect.Request[corev1.ExportInstalledPackagesRequest]) (*connect.Response[corev1.ExportInstalledPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
+
+// ImportInstalledPackages is implemented by the core using the installed
+// package operations of each plugin.
+func (CorePackagesRPCs) ImportInstalledPackages(ctx context.Context, request *connect.Request[corev1.ImportInstalledPackagesRequest]) (*connect.Response[corev1.ImportInstalledPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Unimplemented"))
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pkgutils
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidateValuesAgainstSchema validates the values, once layered on top of the
+// default values, against the values schema of a package, returning the
+// violations sorted by path. Both the values and the schema can be either yaml
+// or json. Values of packages without a schema have no violations.
+func ValidateValuesAgainstSchema(values, defaultValues, schema string) ([]*corev1.ValuesSchemaViolation, error) {
+	if schema == "" {
+		return nil, nil
+	}
+
+	var schemaObj interface{}
+	if err := yaml.Unmarshal([]byte(schema), &schemaObj); err != nil {
+		return nil, fmt.Errorf("unable to parse the values schema: %w", err)
+	}
+	defaultValuesMap := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(defaultValues), &defaultValuesMap); err != nil {
+		return nil, fmt.Errorf("unable to parse the default values: %w", err)
+	}
+	valuesMap := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(values), &valuesMap); err != nil {
+		return nil, fmt.Errorf("unable to parse the values: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewGoLoader(schemaObj),
+		gojsonschema.NewGoLoader(mergeValues(defaultValuesMap, valuesMap)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate the values: %w", err)
+	}
+
+	violations := []*corev1.ValuesSchemaViolation{}
+	for _, resultErr := range result.Errors() {
+		violations = append(violations, &corev1.ValuesSchemaViolation{
+			Path:        resultErr.Field(),
+			Description: resultErr.Description(),
+		})
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+	return violations, nil
+}
+
+// mergeValues returns the values layered on top of the base values, merging
+// nested maps in the same way as helm does for the chart values.
+func mergeValues(base, values map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range values {
+		if vMap, ok := v.(map[string]interface{}); ok {
+			if baseMap, ok := merged[k].(map[string]interface{}); ok {
+				merged[k] = mergeValues(baseMap, vMap)
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pkgutils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+)
+
+func TestValidateValuesAgainstSchema(t *testing.T) {
+	const schema = `{
+  "$schema": "http://json-schema.org/schema#",
+  "type": "object",
+  "required": ["image"],
+  "properties": {
+    "replicaCount": {"type": "integer", "minimum": 1},
+    "image": {
+      "type": "object",
+      "required": ["repository"],
+      "properties": {
+        "repository": {"type": "string"},
+        "tag": {"type": "string"}
+      }
+    }
+  }
+}`
+	const defaultValues = `
+replicaCount: 1
+image:
+  repository: bitnami/apache
+  tag: "2.4"
+`
+
+	testCases := []struct {
+		name               string
+		values             string
+		defaultValues      string
+		schema             string
+		expectedViolations []*corev1.ValuesSchemaViolation
+		expectedErr        bool
+	}{
+		{
+			name:               "it returns no violations without a schema",
+			values:             "replicaCount: foo",
+			expectedViolations: nil,
+		},
+		{
+			name:               "it returns no violations for valid values layered on the default values",
+			values:             "image:\n  tag: \"2.5\"",
+			defaultValues:      defaultValues,
+			schema:             schema,
+			expectedViolations: []*corev1.ValuesSchemaViolation{},
+		},
+		{
+			name:               "it accepts json values",
+			values:             `{"replicaCount": 3}`,
+			defaultValues:      defaultValues,
+			schema:             schema,
+			expectedViolations: []*corev1.ValuesSchemaViolation{},
+		},
+		{
+			name:          "it returns the violations of each path",
+			values:        "replicaCount: 0\nimage:\n  tag: 2",
+			defaultValues: defaultValues,
+			schema:        schema,
+			expectedViolations: []*corev1.ValuesSchemaViolation{
+				{Path: "image.tag", Description: "Invalid type. Expected: string, given: integer"},
+				{Path: "replicaCount", Description: "Must be greater than or equal to 1"},
+			},
+		},
+		{
+			name:   "it returns the violations of required values missing from the default values",
+			values: "replicaCount: 2",
+			schema: schema,
+			expectedViolations: []*corev1.ValuesSchemaViolation{
+				{Path: "(root)", Description: "image is required"},
+			},
+		},
+		{
+			name:        "it returns an error for values which cannot be parsed",
+			values:      "replicaCount: [",
+			schema:      schema,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			violations, err := ValidateValuesAgainstSchema(tc.values, tc.defaultValues, tc.schema)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.ValuesSchemaViolation{})
+			if got, want := violations, tc.expectedViolations; !cmp.Equal(got, want, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
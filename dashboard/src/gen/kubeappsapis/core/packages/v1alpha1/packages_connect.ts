@@ -7,10 +7,14 @@
 // @ts-nocheck
 
 import {
+  BulkUpdateInstalledPackagesRequest,
+  BulkUpdateInstalledPackagesResponse,
   CreateInstalledPackageRequest,
   CreateInstalledPackageResponse,
   DeleteInstalledPackageRequest,
   DeleteInstalledPackageResponse,
+  ExportInstalledPackagesRequest,
+  ExportInstalledPackagesResponse,
   GetAvailablePackageDetailRequest,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageMetadatasRequest,
@@ -25,8 +29,14 @@ import {
   GetInstalledPackageResourceRefsResponse,
   GetInstalledPackageSummariesRequest,
   GetInstalledPackageSummariesResponse,
+  ImportInstalledPackagesRequest,
+  ImportInstalledPackagesResponse,
   UpdateInstalledPackageRequest,
   UpdateInstalledPackageResponse,
+  ValidateInstalledPackageValuesRequest,
+  ValidateInstalledPackageValuesResponse,
+  WatchInstalledPackagesRequest,
+  WatchInstalledPackagesResponse,
 } from "./packages_pb";
 import { MethodKind } from "@bufbuild/protobuf";
 
@@ -111,6 +121,42 @@ export const PackagesService = {
       O: UpdateInstalledPackageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc kubeappsapis.core.packages.v1alpha1.PackagesService.ValidateInstalledPackageValues
+     */
+    validateInstalledPackageValues: {
+      name: "ValidateInstalledPackageValues",
+      I: ValidateInstalledPackageValuesRequest,
+      O: ValidateInstalledPackageValuesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc kubeappsapis.core.packages.v1alpha1.PackagesService.BulkUpdateInstalledPackages
+     */
+    bulkUpdateInstalledPackages: {
+      name: "BulkUpdateInstalledPackages",
+      I: BulkUpdateInstalledPackagesRequest,
+      O: BulkUpdateInstalledPackagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc kubeappsapis.core.packages.v1alpha1.PackagesService.ExportInstalledPackages
+     */
+    exportInstalledPackages: {
+      name: "ExportInstalledPackages",
+      I: ExportInstalledPackagesRequest,
+      O: ExportInstalledPackagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc kubeappsapis.core.packages.v1alpha1.PackagesService.ImportInstalledPackages
+     */
+    importInstalledPackages: {
+      name: "ImportInstalledPackages",
+      I: ImportInstalledPackagesRequest,
+      O: ImportInstalledPackagesResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage
      */
@@ -129,5 +175,14 @@ export const PackagesService = {
       O: GetInstalledPackageResourceRefsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc kubeappsapis.core.packages.v1alpha1.PackagesService.WatchInstalledPackages
+     */
+    watchInstalledPackages: {
+      name: "WatchInstalledPackages",
+      I: WatchInstalledPackagesRequest,
+      O: WatchInstalledPackagesResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   },
 } as const;
@@ -503,6 +503,28 @@ export class CreateInstalledPackageRequest extends Message<CreateInstalledPackag
    */
   reconciliationOptions?: ReconciliationOptions;
 
+  /**
+   * An optional flag to request that the package is only rendered, without
+   * being installed. When set, the response includes the rendered package.
+   * Plugins which cannot render a package return Unimplemented.
+   *
+   * @generated from field: bool dry_run = 7;
+   */
+  dryRun = false;
+
+  /**
+   * Custom data added by the plugin
+   *
+   * A plugin can define custom options for data which is not yet, or never will
+   * be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+   * of an `Any` field means that each plugin can define the structure of this
+   * message as required, while still satisfying the core interface.
+   * See https://developers.google.com/protocol-buffers/docs/proto3#any
+   *
+   * @generated from field: google.protobuf.Any custom_detail = 8;
+   */
+  customDetail?: Any;
+
   constructor(data?: PartialMessage<CreateInstalledPackageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -517,6 +539,8 @@ export class CreateInstalledPackageRequest extends Message<CreateInstalledPackag
     { no: 4, name: "pkg_version_reference", kind: "message", T: VersionReference },
     { no: 5, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "reconciliation_options", kind: "message", T: ReconciliationOptions },
+    { no: 7, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "custom_detail", kind: "message", T: Any },
   ]);
 
   static fromBinary(
@@ -601,6 +625,28 @@ export class UpdateInstalledPackageRequest extends Message<UpdateInstalledPackag
    */
   reconciliationOptions?: ReconciliationOptions;
 
+  /**
+   * An optional flag to request that the package is only rendered, without
+   * being updated. When set, the response includes the rendered package.
+   * Plugins which cannot render a package return Unimplemented.
+   *
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun = false;
+
+  /**
+   * Custom data added by the plugin
+   *
+   * A plugin can define custom options for data which is not yet, or never will
+   * be specified in the core.packaging.UpdateInstalledPackageRequest fields. The use
+   * of an `Any` field means that each plugin can define the structure of this
+   * message as required, while still satisfying the core interface.
+   * See https://developers.google.com/protocol-buffers/docs/proto3#any
+   *
+   * @generated from field: google.protobuf.Any custom_detail = 6;
+   */
+  customDetail?: Any;
+
   constructor(data?: PartialMessage<UpdateInstalledPackageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -613,6 +659,8 @@ export class UpdateInstalledPackageRequest extends Message<UpdateInstalledPackag
     { no: 2, name: "pkg_version_reference", kind: "message", T: VersionReference },
     { no: 3, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "reconciliation_options", kind: "message", T: ReconciliationOptions },
+    { no: 5, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "custom_detail", kind: "message", T: Any },
   ]);
 
   static fromBinary(
@@ -645,828 +693,2264 @@ export class UpdateInstalledPackageRequest extends Message<UpdateInstalledPackag
 }
 
 /**
- * DeleteInstalledPackageRequest
+ * ValidateInstalledPackageValuesRequest
  *
- * Request for DeleteInstalledPackage
+ * Request for ValidateInstalledPackageValues
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ValidateInstalledPackageValuesRequest
  */
-export class DeleteInstalledPackageRequest extends Message<DeleteInstalledPackageRequest> {
+export class ValidateInstalledPackageValuesRequest extends Message<ValidateInstalledPackageValuesRequest> {
   /**
-   * A reference to uniquely identify the installed package to be deleted.
+   * A reference uniquely identifying the package whose values schema is used
+   * to validate the values.
    *
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference available_package_ref = 1;
    */
-  installedPackageRef?: InstalledPackageReference;
+  availablePackageRef?: AvailablePackageReference;
 
-  constructor(data?: PartialMessage<DeleteInstalledPackageRequest>) {
+  /**
+   * The version of the package whose values schema is used. Optional,
+   * defaults to the latest version of the package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.VersionReference pkg_version_reference = 2;
+   */
+  pkgVersionReference?: VersionReference;
+
+  /**
+   * The serialized values string, in the format expected by the plugin, to
+   * be validated once layered on top of the default values of the package.
+   *
+   * @generated from field: string values = 3;
+   */
+  values = "";
+
+  constructor(data?: PartialMessage<ValidateInstalledPackageValuesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest";
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.ValidateInstalledPackageValuesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 1, name: "available_package_ref", kind: "message", T: AvailablePackageReference },
+    { no: 2, name: "pkg_version_reference", kind: "message", T: VersionReference },
+    { no: 3, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): DeleteInstalledPackageRequest {
-    return new DeleteInstalledPackageRequest().fromBinary(bytes, options);
+  ): ValidateInstalledPackageValuesRequest {
+    return new ValidateInstalledPackageValuesRequest().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): DeleteInstalledPackageRequest {
-    return new DeleteInstalledPackageRequest().fromJson(jsonValue, options);
+  ): ValidateInstalledPackageValuesRequest {
+    return new ValidateInstalledPackageValuesRequest().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): DeleteInstalledPackageRequest {
-    return new DeleteInstalledPackageRequest().fromJsonString(jsonString, options);
+  ): ValidateInstalledPackageValuesRequest {
+    return new ValidateInstalledPackageValuesRequest().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a: DeleteInstalledPackageRequest | PlainMessage<DeleteInstalledPackageRequest> | undefined,
-    b: DeleteInstalledPackageRequest | PlainMessage<DeleteInstalledPackageRequest> | undefined,
+    a:
+      | ValidateInstalledPackageValuesRequest
+      | PlainMessage<ValidateInstalledPackageValuesRequest>
+      | undefined,
+    b:
+      | ValidateInstalledPackageValuesRequest
+      | PlainMessage<ValidateInstalledPackageValuesRequest>
+      | undefined,
   ): boolean {
-    return proto3.util.equals(DeleteInstalledPackageRequest, a, b);
+    return proto3.util.equals(ValidateInstalledPackageValuesRequest, a, b);
   }
 }
 
 /**
- * GetInstalledPackageResourceRefsRequest
+ * BulkUpdateInstalledPackagesRequest
  *
- * Request for GetInstalledPackageResourceRefs
+ * Request for BulkUpdateInstalledPackages. Each installed package matching the
+ * selector is updated to the latest available version allowed by the upgrade
+ * policy, reusing the values currently applied to it.
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsRequest
+ * @generated from message kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackagesRequest
  */
-export class GetInstalledPackageResourceRefsRequest extends Message<GetInstalledPackageResourceRefsRequest> {
+export class BulkUpdateInstalledPackagesRequest extends Message<BulkUpdateInstalledPackagesRequest> {
   /**
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   * The context (cluster/namespace) of the installed packages to update.
+   * An empty namespace selects the installed packages of all namespaces.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.Context context = 1;
    */
-  installedPackageRef?: InstalledPackageReference;
+  context?: Context;
 
-  constructor(data?: PartialMessage<GetInstalledPackageResourceRefsRequest>) {
+  /**
+   * An optional list of namespaces which, when not empty, takes precedence
+   * over the namespace of the context.
+   *
+   * @generated from field: repeated string namespaces = 2;
+   */
+  namespaces: string[] = [];
+
+  /**
+   * An optional plugin to which the update is restricted.
+   *
+   * @generated from field: kubeappsapis.core.plugins.v1alpha1.Plugin plugin = 3;
+   */
+  plugin?: Plugin;
+
+  /**
+   * An optional identifier of the available package (eg. "bitnami/apache")
+   * to which the update is restricted.
+   *
+   * @generated from field: string available_package_identifier = 4;
+   */
+  availablePackageIdentifier = "";
+
+  /**
+   * The upgrade policy to apply. Required
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackagesRequest.UpgradePolicy upgrade_policy = 5;
+   */
+  upgradePolicy = BulkUpdateInstalledPackagesRequest_UpgradePolicy.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<BulkUpdateInstalledPackagesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsRequest";
+    "kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackagesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 1, name: "context", kind: "message", T: Context },
+    { no: 2, name: "namespaces", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "plugin", kind: "message", T: Plugin },
+    { no: 4, name: "available_package_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    {
+      no: 5,
+      name: "upgrade_policy",
+      kind: "enum",
+      T: proto3.getEnumType(BulkUpdateInstalledPackagesRequest_UpgradePolicy),
+    },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetInstalledPackageResourceRefsRequest {
-    return new GetInstalledPackageResourceRefsRequest().fromBinary(bytes, options);
+  ): BulkUpdateInstalledPackagesRequest {
+    return new BulkUpdateInstalledPackagesRequest().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageResourceRefsRequest {
-    return new GetInstalledPackageResourceRefsRequest().fromJson(jsonValue, options);
+  ): BulkUpdateInstalledPackagesRequest {
+    return new BulkUpdateInstalledPackagesRequest().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageResourceRefsRequest {
-    return new GetInstalledPackageResourceRefsRequest().fromJsonString(jsonString, options);
+  ): BulkUpdateInstalledPackagesRequest {
+    return new BulkUpdateInstalledPackagesRequest().fromJsonString(jsonString, options);
   }
 
   static equals(
     a:
-      | GetInstalledPackageResourceRefsRequest
-      | PlainMessage<GetInstalledPackageResourceRefsRequest>
+      | BulkUpdateInstalledPackagesRequest
+      | PlainMessage<BulkUpdateInstalledPackagesRequest>
       | undefined,
     b:
-      | GetInstalledPackageResourceRefsRequest
-      | PlainMessage<GetInstalledPackageResourceRefsRequest>
+      | BulkUpdateInstalledPackagesRequest
+      | PlainMessage<BulkUpdateInstalledPackagesRequest>
       | undefined,
   ): boolean {
-    return proto3.util.equals(GetInstalledPackageResourceRefsRequest, a, b);
+    return proto3.util.equals(BulkUpdateInstalledPackagesRequest, a, b);
   }
 }
 
 /**
- * GetAvailablePackageSummariesResponse
- *
- * Response for GetAvailablePackageSummaries
+ * UpgradePolicy
  *
- * TODO: add example for API docs
- * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
- *   example: '{"available_package_summaries": [{}]}'
- * };
+ * The version range, relative to the current version of each installed
+ * package, within which the package can be updated.
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
+ * @generated from enum kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackagesRequest.UpgradePolicy
  */
-export class GetAvailablePackageSummariesResponse extends Message<GetAvailablePackageSummariesResponse> {
+export enum BulkUpdateInstalledPackagesRequest_UpgradePolicy {
   /**
-   * Available packages summaries
-   *
-   * List of AvailablePackageSummary
-   *
-   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary available_package_summaries = 1;
+   * @generated from enum value: UPGRADE_POLICY_UNSPECIFIED = 0;
    */
-  availablePackageSummaries: AvailablePackageSummary[] = [];
+  UNSPECIFIED = 0,
 
   /**
-   * Next page token
-   *
-   * This field represents the pagination token to retrieve the next page of
-   * results. If the value is "", it means no further results for the request.
-   *
-   * @generated from field: string next_page_token = 2;
+   * @generated from enum value: UPGRADE_POLICY_PATCH = 1;
    */
-  nextPageToken = "";
+  PATCH = 1,
 
   /**
-   * Categories
+   * @generated from enum value: UPGRADE_POLICY_MINOR = 2;
+   */
+  MINOR = 2,
+
+  /**
+   * @generated from enum value: UPGRADE_POLICY_MAJOR = 3;
+   */
+  MAJOR = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(BulkUpdateInstalledPackagesRequest_UpgradePolicy)
+proto3.util.setEnumType(
+  BulkUpdateInstalledPackagesRequest_UpgradePolicy,
+  "kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackagesRequest.UpgradePolicy",
+  [
+    { no: 0, name: "UPGRADE_POLICY_UNSPECIFIED" },
+    { no: 1, name: "UPGRADE_POLICY_PATCH" },
+    { no: 2, name: "UPGRADE_POLICY_MINOR" },
+    { no: 3, name: "UPGRADE_POLICY_MAJOR" },
+  ],
+);
+
+/**
+ * ExportInstalledPackagesRequest
+ *
+ * Request for ExportInstalledPackages
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest
+ */
+export class ExportInstalledPackagesRequest extends Message<ExportInstalledPackagesRequest> {
+  /**
+   * The context (cluster/namespace) of the installed packages to export.
+   * An empty namespace exports the installed packages of all namespaces.
    *
-   * This optional field contains the distinct category names considering the FilterOptions.
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.Context context = 1;
+   */
+  context?: Context;
+
+  /**
+   * The format of the exported manifest. Optional
    *
-   * @generated from field: repeated string categories = 3;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest.ManifestFormat format = 2;
    */
-  categories: string[] = [];
+  format = ExportInstalledPackagesRequest_ManifestFormat.UNSPECIFIED;
 
-  constructor(data?: PartialMessage<GetAvailablePackageSummariesResponse>) {
+  constructor(data?: PartialMessage<ExportInstalledPackagesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "context", kind: "message", T: Context },
     {
-      no: 1,
-      name: "available_package_summaries",
-      kind: "message",
-      T: AvailablePackageSummary,
-      repeated: true,
+      no: 2,
+      name: "format",
+      kind: "enum",
+      T: proto3.getEnumType(ExportInstalledPackagesRequest_ManifestFormat),
     },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "categories", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetAvailablePackageSummariesResponse {
-    return new GetAvailablePackageSummariesResponse().fromBinary(bytes, options);
+  ): ExportInstalledPackagesRequest {
+    return new ExportInstalledPackagesRequest().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageSummariesResponse {
-    return new GetAvailablePackageSummariesResponse().fromJson(jsonValue, options);
+  ): ExportInstalledPackagesRequest {
+    return new ExportInstalledPackagesRequest().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageSummariesResponse {
-    return new GetAvailablePackageSummariesResponse().fromJsonString(jsonString, options);
+  ): ExportInstalledPackagesRequest {
+    return new ExportInstalledPackagesRequest().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a:
-      | GetAvailablePackageSummariesResponse
-      | PlainMessage<GetAvailablePackageSummariesResponse>
-      | undefined,
-    b:
-      | GetAvailablePackageSummariesResponse
-      | PlainMessage<GetAvailablePackageSummariesResponse>
-      | undefined,
+    a: ExportInstalledPackagesRequest | PlainMessage<ExportInstalledPackagesRequest> | undefined,
+    b: ExportInstalledPackagesRequest | PlainMessage<ExportInstalledPackagesRequest> | undefined,
   ): boolean {
-    return proto3.util.equals(GetAvailablePackageSummariesResponse, a, b);
+    return proto3.util.equals(ExportInstalledPackagesRequest, a, b);
   }
 }
 
 /**
- * GetAvailablePackageDetailResponse
+ * ManifestFormat
  *
- * Response for GetAvailablePackageDetail
+ * The serialization format of an installed packages manifest.
  *
- * TODO: add example for API docs
- * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
- *   example: '{"available_package_detail": [{}]}'
- * };
+ * @generated from enum kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest.ManifestFormat
+ */
+export enum ExportInstalledPackagesRequest_ManifestFormat {
+  /**
+   * Defaults to yaml.
+   *
+   * @generated from enum value: MANIFEST_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MANIFEST_FORMAT_YAML = 1;
+   */
+  YAML = 1,
+
+  /**
+   * @generated from enum value: MANIFEST_FORMAT_JSON = 2;
+   */
+  JSON = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ExportInstalledPackagesRequest_ManifestFormat)
+proto3.util.setEnumType(
+  ExportInstalledPackagesRequest_ManifestFormat,
+  "kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesRequest.ManifestFormat",
+  [
+    { no: 0, name: "MANIFEST_FORMAT_UNSPECIFIED" },
+    { no: 1, name: "MANIFEST_FORMAT_YAML" },
+    { no: 2, name: "MANIFEST_FORMAT_JSON" },
+  ],
+);
+
+/**
+ * ImportInstalledPackagesRequest
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
+ * Request for ImportInstalledPackages. Each installed package of the manifest
+ * is installed in the target context.
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest
  */
-export class GetAvailablePackageDetailResponse extends Message<GetAvailablePackageDetailResponse> {
+export class ImportInstalledPackagesRequest extends Message<ImportInstalledPackagesRequest> {
   /**
-   * Available package detail
+   * The manifest, in yaml or json, as returned by ExportInstalledPackages.
    *
-   * The requested AvailablePackageDetail
+   * @generated from field: string manifest = 1;
+   */
+  manifest = "";
+
+  /**
+   * The context (cluster/namespace) in which the installed packages are
+   * created. An empty namespace creates each installed package in the
+   * namespace recorded in the manifest.
    *
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail available_package_detail = 1;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.Context target_context = 2;
    */
-  availablePackageDetail?: AvailablePackageDetail;
+  targetContext?: Context;
 
-  constructor(data?: PartialMessage<GetAvailablePackageDetailResponse>) {
+  /**
+   * The conflict policy to apply. Optional
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest.ConflictPolicy conflict_policy = 3;
+   */
+  conflictPolicy = ImportInstalledPackagesRequest_ConflictPolicy.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ImportInstalledPackagesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "available_package_detail", kind: "message", T: AvailablePackageDetail },
+    { no: 1, name: "manifest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target_context", kind: "message", T: Context },
+    {
+      no: 3,
+      name: "conflict_policy",
+      kind: "enum",
+      T: proto3.getEnumType(ImportInstalledPackagesRequest_ConflictPolicy),
+    },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetAvailablePackageDetailResponse {
-    return new GetAvailablePackageDetailResponse().fromBinary(bytes, options);
+  ): ImportInstalledPackagesRequest {
+    return new ImportInstalledPackagesRequest().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageDetailResponse {
-    return new GetAvailablePackageDetailResponse().fromJson(jsonValue, options);
+  ): ImportInstalledPackagesRequest {
+    return new ImportInstalledPackagesRequest().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageDetailResponse {
-    return new GetAvailablePackageDetailResponse().fromJsonString(jsonString, options);
+  ): ImportInstalledPackagesRequest {
+    return new ImportInstalledPackagesRequest().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a:
-      | GetAvailablePackageDetailResponse
-      | PlainMessage<GetAvailablePackageDetailResponse>
-      | undefined,
-    b:
-      | GetAvailablePackageDetailResponse
-      | PlainMessage<GetAvailablePackageDetailResponse>
-      | undefined,
+    a: ImportInstalledPackagesRequest | PlainMessage<ImportInstalledPackagesRequest> | undefined,
+    b: ImportInstalledPackagesRequest | PlainMessage<ImportInstalledPackagesRequest> | undefined,
   ): boolean {
-    return proto3.util.equals(GetAvailablePackageDetailResponse, a, b);
+    return proto3.util.equals(ImportInstalledPackagesRequest, a, b);
   }
 }
 
 /**
- * GetAvailablePackageVersionsResponse
+ * ConflictPolicy
  *
- * Response for GetAvailablePackageVersions
+ * How to handle an installed package of the manifest which already exists
+ * in the target context.
+ *
+ * @generated from enum kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest.ConflictPolicy
+ */
+export enum ImportInstalledPackagesRequest_ConflictPolicy {
+  /**
+   * Defaults to skip.
+   *
+   * @generated from enum value: CONFLICT_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Leave the existing installed package untouched.
+   *
+   * @generated from enum value: CONFLICT_POLICY_SKIP = 1;
+   */
+  SKIP = 1,
+
+  /**
+   * Update the existing installed package with the version, values and
+   * reconciliation options of the manifest.
+   *
+   * @generated from enum value: CONFLICT_POLICY_OVERWRITE = 2;
+   */
+  OVERWRITE = 2,
+
+  /**
+   * Install the package with a new name, adding a numeric suffix.
+   *
+   * @generated from enum value: CONFLICT_POLICY_RENAME = 3;
+   */
+  RENAME = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ImportInstalledPackagesRequest_ConflictPolicy)
+proto3.util.setEnumType(
+  ImportInstalledPackagesRequest_ConflictPolicy,
+  "kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesRequest.ConflictPolicy",
+  [
+    { no: 0, name: "CONFLICT_POLICY_UNSPECIFIED" },
+    { no: 1, name: "CONFLICT_POLICY_SKIP" },
+    { no: 2, name: "CONFLICT_POLICY_OVERWRITE" },
+    { no: 3, name: "CONFLICT_POLICY_RENAME" },
+  ],
+);
+
+/**
+ * DeleteInstalledPackageRequest
+ *
+ * Request for DeleteInstalledPackage
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
+ */
+export class DeleteInstalledPackageRequest extends Message<DeleteInstalledPackageRequest> {
+  /**
+   * A reference to uniquely identify the installed package to be deleted.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<DeleteInstalledPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): DeleteInstalledPackageRequest {
+    return new DeleteInstalledPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): DeleteInstalledPackageRequest {
+    return new DeleteInstalledPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): DeleteInstalledPackageRequest {
+    return new DeleteInstalledPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: DeleteInstalledPackageRequest | PlainMessage<DeleteInstalledPackageRequest> | undefined,
+    b: DeleteInstalledPackageRequest | PlainMessage<DeleteInstalledPackageRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(DeleteInstalledPackageRequest, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageResourceRefsRequest
+ *
+ * Request for GetInstalledPackageResourceRefs
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsRequest
+ */
+export class GetInstalledPackageResourceRefsRequest extends Message<GetInstalledPackageResourceRefsRequest> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<GetInstalledPackageResourceRefsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetInstalledPackageResourceRefsRequest {
+    return new GetInstalledPackageResourceRefsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageResourceRefsRequest {
+    return new GetInstalledPackageResourceRefsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageResourceRefsRequest {
+    return new GetInstalledPackageResourceRefsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetInstalledPackageResourceRefsRequest
+      | PlainMessage<GetInstalledPackageResourceRefsRequest>
+      | undefined,
+    b:
+      | GetInstalledPackageResourceRefsRequest
+      | PlainMessage<GetInstalledPackageResourceRefsRequest>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetInstalledPackageResourceRefsRequest, a, b);
+  }
+}
+
+/**
+ * WatchInstalledPackagesRequest
+ *
+ * Request for WatchInstalledPackages
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesRequest
+ */
+export class WatchInstalledPackagesRequest extends Message<WatchInstalledPackagesRequest> {
+  /**
+   * The context (cluster/namespace) for the request.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.Context context = 1;
+   */
+  context?: Context;
+
+  constructor(data?: PartialMessage<WatchInstalledPackagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "context", kind: "message", T: Context },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): WatchInstalledPackagesRequest {
+    return new WatchInstalledPackagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): WatchInstalledPackagesRequest {
+    return new WatchInstalledPackagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): WatchInstalledPackagesRequest {
+    return new WatchInstalledPackagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: WatchInstalledPackagesRequest | PlainMessage<WatchInstalledPackagesRequest> | undefined,
+    b: WatchInstalledPackagesRequest | PlainMessage<WatchInstalledPackagesRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(WatchInstalledPackagesRequest, a, b);
+  }
+}
+
+/**
+ * GetAvailablePackageSummariesResponse
+ *
+ * Response for GetAvailablePackageSummaries
+ *
+ * TODO: add example for API docs
+ * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
+ *   example: '{"available_package_summaries": [{}]}'
+ * };
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
+ */
+export class GetAvailablePackageSummariesResponse extends Message<GetAvailablePackageSummariesResponse> {
+  /**
+   * Available packages summaries
+   *
+   * List of AvailablePackageSummary
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary available_package_summaries = 1;
+   */
+  availablePackageSummaries: AvailablePackageSummary[] = [];
+
+  /**
+   * Next page token
+   *
+   * This field represents the pagination token to retrieve the next page of
+   * results. If the value is "", it means no further results for the request.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  /**
+   * Categories
+   *
+   * This optional field contains the distinct category names considering the FilterOptions.
+   *
+   * @generated from field: repeated string categories = 3;
+   */
+  categories: string[] = [];
+
+  constructor(data?: PartialMessage<GetAvailablePackageSummariesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    {
+      no: 1,
+      name: "available_package_summaries",
+      kind: "message",
+      T: AvailablePackageSummary,
+      repeated: true,
+    },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "categories", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetAvailablePackageSummariesResponse {
+    return new GetAvailablePackageSummariesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageSummariesResponse {
+    return new GetAvailablePackageSummariesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageSummariesResponse {
+    return new GetAvailablePackageSummariesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetAvailablePackageSummariesResponse
+      | PlainMessage<GetAvailablePackageSummariesResponse>
+      | undefined,
+    b:
+      | GetAvailablePackageSummariesResponse
+      | PlainMessage<GetAvailablePackageSummariesResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetAvailablePackageSummariesResponse, a, b);
+  }
+}
+
+/**
+ * GetAvailablePackageDetailResponse
+ *
+ * Response for GetAvailablePackageDetail
+ *
+ * TODO: add example for API docs
+ * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
+ *   example: '{"available_package_detail": [{}]}'
+ * };
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
+ */
+export class GetAvailablePackageDetailResponse extends Message<GetAvailablePackageDetailResponse> {
+  /**
+   * Available package detail
+   *
+   * The requested AvailablePackageDetail
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail available_package_detail = 1;
+   */
+  availablePackageDetail?: AvailablePackageDetail;
+
+  constructor(data?: PartialMessage<GetAvailablePackageDetailResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "available_package_detail", kind: "message", T: AvailablePackageDetail },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetAvailablePackageDetailResponse {
+    return new GetAvailablePackageDetailResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageDetailResponse {
+    return new GetAvailablePackageDetailResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageDetailResponse {
+    return new GetAvailablePackageDetailResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetAvailablePackageDetailResponse
+      | PlainMessage<GetAvailablePackageDetailResponse>
+      | undefined,
+    b:
+      | GetAvailablePackageDetailResponse
+      | PlainMessage<GetAvailablePackageDetailResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetAvailablePackageDetailResponse, a, b);
+  }
+}
+
+/**
+ * GetAvailablePackageVersionsResponse
+ *
+ * Response for GetAvailablePackageVersions
+ *
+ * TODO: add example for API docs
+ * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
+ *   example: '{"package_app_versions": [{}]}'
+ * };
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
+ */
+export class GetAvailablePackageVersionsResponse extends Message<GetAvailablePackageVersionsResponse> {
+  /**
+   * Package app versions
+   *
+   * By default (when version_query is empty or ignored) the response
+   * should contain an ordered summary of versions including the most recent three
+   * patch versions of the most recent three minor versions of the most recent three
+   * major versions when available, something like:
+   * [
+   *   { pkg_version: "10.3.19", app_version: "2.16.8" },
+   *   { pkg_version: "10.3.18", app_version: "2.16.8" },
+   *   { pkg_version: "10.3.17", app_version: "2.16.7" },
+   *   { pkg_version: "10.2.6", app_version: "2.15.3" },
+   *   { pkg_version: "10.2.5", app_version: "2.15.2" },
+   *   { pkg_version: "10.2.4", app_version: "2.15.2" },
+   *   { pkg_version: "10.1.8", app_version: "2.13.5" },
+   *   { pkg_version: "10.1.7", app_version: "2.13.5" },
+   *   { pkg_version: "10.1.6", app_version: "2.13.5" },
+   *   { pkg_version: "9.5.4", app_version: "2.8.9" },
+   *   ...
+   *   { pkg_version: "8.2.5", app_version: "1.19.5" },
+   *   ...
+   * ]
+   * If a version_query is present and the plugin chooses to support it,
+   * the full history of versions matching the version query should be returned.
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.PackageAppVersion package_app_versions = 1;
+   */
+  packageAppVersions: PackageAppVersion[] = [];
+
+  constructor(data?: PartialMessage<GetAvailablePackageVersionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_app_versions", kind: "message", T: PackageAppVersion, repeated: true },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetAvailablePackageVersionsResponse {
+    return new GetAvailablePackageVersionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageVersionsResponse {
+    return new GetAvailablePackageVersionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageVersionsResponse {
+    return new GetAvailablePackageVersionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetAvailablePackageVersionsResponse
+      | PlainMessage<GetAvailablePackageVersionsResponse>
+      | undefined,
+    b:
+      | GetAvailablePackageVersionsResponse
+      | PlainMessage<GetAvailablePackageVersionsResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetAvailablePackageVersionsResponse, a, b);
+  }
+}
+
+/**
+ * GetAvailablePackageMetadatasResponse
+ *
+ * Response for GetAvailablePackageMetadatas
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageMetadatasResponse
+ */
+export class GetAvailablePackageMetadatasResponse extends Message<GetAvailablePackageMetadatasResponse> {
+  /**
+   * Available Package Reference
+   *
+   * The reference to the available package related to this metadata.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference available_package_ref = 1;
+   */
+  availablePackageRef?: AvailablePackageReference;
+
+  /**
+   * Package Metadata
+   *
+   * A list of metadata associated with this available package.
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.PackageMetadata package_metadata = 2;
+   */
+  packageMetadata: PackageMetadata[] = [];
+
+  constructor(data?: PartialMessage<GetAvailablePackageMetadatasResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageMetadatasResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "available_package_ref", kind: "message", T: AvailablePackageReference },
+    { no: 2, name: "package_metadata", kind: "message", T: PackageMetadata, repeated: true },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetAvailablePackageMetadatasResponse {
+    return new GetAvailablePackageMetadatasResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageMetadatasResponse {
+    return new GetAvailablePackageMetadatasResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetAvailablePackageMetadatasResponse {
+    return new GetAvailablePackageMetadatasResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetAvailablePackageMetadatasResponse
+      | PlainMessage<GetAvailablePackageMetadatasResponse>
+      | undefined,
+    b:
+      | GetAvailablePackageMetadatasResponse
+      | PlainMessage<GetAvailablePackageMetadatasResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetAvailablePackageMetadatasResponse, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageSummariesResponse
+ *
+ * Response for GetInstalledPackageSummaries
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
+ */
+export class GetInstalledPackageSummariesResponse extends Message<GetInstalledPackageSummariesResponse> {
+  /**
+   * Installed packages summaries
+   *
+   * List of InstalledPackageSummary
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary installed_package_summaries = 1;
+   */
+  installedPackageSummaries: InstalledPackageSummary[] = [];
+
+  /**
+   * Next page token
+   *
+   * This field represents the pagination token to retrieve the next page of
+   * results. If the value is "", it means no further results for the request.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<GetInstalledPackageSummariesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    {
+      no: 1,
+      name: "installed_package_summaries",
+      kind: "message",
+      T: InstalledPackageSummary,
+      repeated: true,
+    },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetInstalledPackageSummariesResponse {
+    return new GetInstalledPackageSummariesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageSummariesResponse {
+    return new GetInstalledPackageSummariesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageSummariesResponse {
+    return new GetInstalledPackageSummariesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetInstalledPackageSummariesResponse
+      | PlainMessage<GetInstalledPackageSummariesResponse>
+      | undefined,
+    b:
+      | GetInstalledPackageSummariesResponse
+      | PlainMessage<GetInstalledPackageSummariesResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetInstalledPackageSummariesResponse, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageDetailResponse
+ *
+ * Response for GetInstalledPackageDetail
+ *
+ * TODO: add example for API docs
+ * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
+ *   example: '{"installed_package_detail": [{}]}'
+ * };
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
+ */
+export class GetInstalledPackageDetailResponse extends Message<GetInstalledPackageDetailResponse> {
+  /**
+   * InstalledPackageDetail
+   *
+   * The requested InstalledPackageDetail
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail installed_package_detail = 1;
+   */
+  installedPackageDetail?: InstalledPackageDetail;
+
+  constructor(data?: PartialMessage<GetInstalledPackageDetailResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_detail", kind: "message", T: InstalledPackageDetail },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetInstalledPackageDetailResponse {
+    return new GetInstalledPackageDetailResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageDetailResponse {
+    return new GetInstalledPackageDetailResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageDetailResponse {
+    return new GetInstalledPackageDetailResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetInstalledPackageDetailResponse
+      | PlainMessage<GetInstalledPackageDetailResponse>
+      | undefined,
+    b:
+      | GetInstalledPackageDetailResponse
+      | PlainMessage<GetInstalledPackageDetailResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetInstalledPackageDetailResponse, a, b);
+  }
+}
+
+/**
+ * CreateInstalledPackageResponse
+ *
+ * Response for CreateInstalledPackage
+ *
+ * TODO: add example for API docs
+ * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
+ *   example: '{"installed_package_ref": {}}'
+ * };
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
+ */
+export class CreateInstalledPackageResponse extends Message<CreateInstalledPackageResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * Rendered package
+   *
+   * The result of rendering the package, only present when the request
+   * was a dry run.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.RenderedPackage rendered_package = 2;
+   */
+  renderedPackage?: RenderedPackage;
+
+  constructor(data?: PartialMessage<CreateInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "rendered_package", kind: "message", T: RenderedPackage },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): CreateInstalledPackageResponse {
+    return new CreateInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): CreateInstalledPackageResponse {
+    return new CreateInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): CreateInstalledPackageResponse {
+    return new CreateInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: CreateInstalledPackageResponse | PlainMessage<CreateInstalledPackageResponse> | undefined,
+    b: CreateInstalledPackageResponse | PlainMessage<CreateInstalledPackageResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(CreateInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * UpdateInstalledPackageResponse
+ *
+ * Response for UpdateInstalledPackage
  *
  * TODO: add example for API docs
  * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
- *   example: '{"package_app_versions": [{}]}'
+ *   example: '{"installed_package_ref": {}}'
  * };
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
+ * @generated from message kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
  */
-export class GetAvailablePackageVersionsResponse extends Message<GetAvailablePackageVersionsResponse> {
+export class UpdateInstalledPackageResponse extends Message<UpdateInstalledPackageResponse> {
   /**
-   * Package app versions
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * Rendered package
    *
-   * By default (when version_query is empty or ignored) the response
-   * should contain an ordered summary of versions including the most recent three
-   * patch versions of the most recent three minor versions of the most recent three
-   * major versions when available, something like:
-   * [
-   *   { pkg_version: "10.3.19", app_version: "2.16.8" },
-   *   { pkg_version: "10.3.18", app_version: "2.16.8" },
-   *   { pkg_version: "10.3.17", app_version: "2.16.7" },
-   *   { pkg_version: "10.2.6", app_version: "2.15.3" },
-   *   { pkg_version: "10.2.5", app_version: "2.15.2" },
-   *   { pkg_version: "10.2.4", app_version: "2.15.2" },
-   *   { pkg_version: "10.1.8", app_version: "2.13.5" },
-   *   { pkg_version: "10.1.7", app_version: "2.13.5" },
-   *   { pkg_version: "10.1.6", app_version: "2.13.5" },
-   *   { pkg_version: "9.5.4", app_version: "2.8.9" },
-   *   ...
-   *   { pkg_version: "8.2.5", app_version: "1.19.5" },
-   *   ...
-   * ]
-   * If a version_query is present and the plugin chooses to support it,
-   * the full history of versions matching the version query should be returned.
+   * The result of rendering the package, only present when the request
+   * was a dry run.
    *
-   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.PackageAppVersion package_app_versions = 1;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.RenderedPackage rendered_package = 2;
+   */
+  renderedPackage?: RenderedPackage;
+
+  constructor(data?: PartialMessage<UpdateInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "rendered_package", kind: "message", T: RenderedPackage },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): UpdateInstalledPackageResponse {
+    return new UpdateInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): UpdateInstalledPackageResponse {
+    return new UpdateInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): UpdateInstalledPackageResponse {
+    return new UpdateInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: UpdateInstalledPackageResponse | PlainMessage<UpdateInstalledPackageResponse> | undefined,
+    b: UpdateInstalledPackageResponse | PlainMessage<UpdateInstalledPackageResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(UpdateInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * ValidateInstalledPackageValuesResponse
+ *
+ * Response for ValidateInstalledPackageValues
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ValidateInstalledPackageValuesResponse
+ */
+export class ValidateInstalledPackageValuesResponse extends Message<ValidateInstalledPackageValuesResponse> {
+  /**
+   * Valid
+   *
+   * Whether the values are valid according to the values schema of the package.
+   * Values of packages without a values schema are always valid.
+   *
+   * @generated from field: bool valid = 1;
+   */
+  valid = false;
+
+  /**
+   * Violations
+   *
+   * The violations of the values schema found in the values, if any.
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.ValuesSchemaViolation violations = 2;
+   */
+  violations: ValuesSchemaViolation[] = [];
+
+  constructor(data?: PartialMessage<ValidateInstalledPackageValuesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.ValidateInstalledPackageValuesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "violations", kind: "message", T: ValuesSchemaViolation, repeated: true },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ValidateInstalledPackageValuesResponse {
+    return new ValidateInstalledPackageValuesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ValidateInstalledPackageValuesResponse {
+    return new ValidateInstalledPackageValuesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ValidateInstalledPackageValuesResponse {
+    return new ValidateInstalledPackageValuesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | ValidateInstalledPackageValuesResponse
+      | PlainMessage<ValidateInstalledPackageValuesResponse>
+      | undefined,
+    b:
+      | ValidateInstalledPackageValuesResponse
+      | PlainMessage<ValidateInstalledPackageValuesResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(ValidateInstalledPackageValuesResponse, a, b);
+  }
+}
+
+/**
+ * BulkUpdateInstalledPackagesResponse
+ *
+ * Response for BulkUpdateInstalledPackages
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackagesResponse
+ */
+export class BulkUpdateInstalledPackagesResponse extends Message<BulkUpdateInstalledPackagesResponse> {
+  /**
+   * Results
+   *
+   * The result of the update for each installed package matching the selector.
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackageResult results = 1;
+   */
+  results: BulkUpdateInstalledPackageResult[] = [];
+
+  constructor(data?: PartialMessage<BulkUpdateInstalledPackagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    {
+      no: 1,
+      name: "results",
+      kind: "message",
+      T: BulkUpdateInstalledPackageResult,
+      repeated: true,
+    },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): BulkUpdateInstalledPackagesResponse {
+    return new BulkUpdateInstalledPackagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): BulkUpdateInstalledPackagesResponse {
+    return new BulkUpdateInstalledPackagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): BulkUpdateInstalledPackagesResponse {
+    return new BulkUpdateInstalledPackagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | BulkUpdateInstalledPackagesResponse
+      | PlainMessage<BulkUpdateInstalledPackagesResponse>
+      | undefined,
+    b:
+      | BulkUpdateInstalledPackagesResponse
+      | PlainMessage<BulkUpdateInstalledPackagesResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(BulkUpdateInstalledPackagesResponse, a, b);
+  }
+}
+
+/**
+ * ExportInstalledPackagesResponse
+ *
+ * Response for ExportInstalledPackages
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesResponse
+ */
+export class ExportInstalledPackagesResponse extends Message<ExportInstalledPackagesResponse> {
+  /**
+   * Manifest
+   *
+   * The serialized InstalledPackagesManifest in the requested format.
+   *
+   * @generated from field: string manifest = 1;
+   */
+  manifest = "";
+
+  constructor(data?: PartialMessage<ExportInstalledPackagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.ExportInstalledPackagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "manifest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ExportInstalledPackagesResponse {
+    return new ExportInstalledPackagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ExportInstalledPackagesResponse {
+    return new ExportInstalledPackagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ExportInstalledPackagesResponse {
+    return new ExportInstalledPackagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ExportInstalledPackagesResponse | PlainMessage<ExportInstalledPackagesResponse> | undefined,
+    b: ExportInstalledPackagesResponse | PlainMessage<ExportInstalledPackagesResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(ExportInstalledPackagesResponse, a, b);
+  }
+}
+
+/**
+ * ImportInstalledPackagesResponse
+ *
+ * Response for ImportInstalledPackages
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesResponse
+ */
+export class ImportInstalledPackagesResponse extends Message<ImportInstalledPackagesResponse> {
+  /**
+   * Results
+   *
+   * The result of the import for each installed package of the manifest.
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.ImportInstalledPackageResult results = 1;
    */
-  packageAppVersions: PackageAppVersion[] = [];
+  results: ImportInstalledPackageResult[] = [];
 
-  constructor(data?: PartialMessage<GetAvailablePackageVersionsResponse>) {
+  constructor(data?: PartialMessage<ImportInstalledPackagesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.ImportInstalledPackagesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "package_app_versions", kind: "message", T: PackageAppVersion, repeated: true },
+    { no: 1, name: "results", kind: "message", T: ImportInstalledPackageResult, repeated: true },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetAvailablePackageVersionsResponse {
-    return new GetAvailablePackageVersionsResponse().fromBinary(bytes, options);
+  ): ImportInstalledPackagesResponse {
+    return new ImportInstalledPackagesResponse().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageVersionsResponse {
-    return new GetAvailablePackageVersionsResponse().fromJson(jsonValue, options);
+  ): ImportInstalledPackagesResponse {
+    return new ImportInstalledPackagesResponse().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageVersionsResponse {
-    return new GetAvailablePackageVersionsResponse().fromJsonString(jsonString, options);
+  ): ImportInstalledPackagesResponse {
+    return new ImportInstalledPackagesResponse().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a:
-      | GetAvailablePackageVersionsResponse
-      | PlainMessage<GetAvailablePackageVersionsResponse>
-      | undefined,
-    b:
-      | GetAvailablePackageVersionsResponse
-      | PlainMessage<GetAvailablePackageVersionsResponse>
-      | undefined,
+    a: ImportInstalledPackagesResponse | PlainMessage<ImportInstalledPackagesResponse> | undefined,
+    b: ImportInstalledPackagesResponse | PlainMessage<ImportInstalledPackagesResponse> | undefined,
   ): boolean {
-    return proto3.util.equals(GetAvailablePackageVersionsResponse, a, b);
+    return proto3.util.equals(ImportInstalledPackagesResponse, a, b);
   }
 }
 
 /**
- * GetAvailablePackageMetadatasResponse
+ * DeleteInstalledPackageResponse
  *
- * Response for GetAvailablePackageMetadatas
+ * Response for DeleteInstalledPackage
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetAvailablePackageMetadatasResponse
+ * For future extensibility only.
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
  */
-export class GetAvailablePackageMetadatasResponse extends Message<GetAvailablePackageMetadatasResponse> {
+export class DeleteInstalledPackageResponse extends Message<DeleteInstalledPackageResponse> {
+  constructor(data?: PartialMessage<DeleteInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => []);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): DeleteInstalledPackageResponse {
+    return new DeleteInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): DeleteInstalledPackageResponse {
+    return new DeleteInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): DeleteInstalledPackageResponse {
+    return new DeleteInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: DeleteInstalledPackageResponse | PlainMessage<DeleteInstalledPackageResponse> | undefined,
+    b: DeleteInstalledPackageResponse | PlainMessage<DeleteInstalledPackageResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(DeleteInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageResourceRefsResponse
+ *
+ * Response for GetInstalledPackageResourceRefs
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsResponse
+ */
+export class GetInstalledPackageResourceRefsResponse extends Message<GetInstalledPackageResourceRefsResponse> {
   /**
-   * Available Package Reference
-   *
-   * The reference to the available package related to this metadata.
-   *
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference available_package_ref = 1;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.Context context = 1;
    */
-  availablePackageRef?: AvailablePackageReference;
+  context?: Context;
 
   /**
-   * Package Metadata
-   *
-   * A list of metadata associated with this available package.
-   *
-   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.PackageMetadata package_metadata = 2;
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.ResourceRef resource_refs = 2;
    */
-  packageMetadata: PackageMetadata[] = [];
+  resourceRefs: ResourceRef[] = [];
 
-  constructor(data?: PartialMessage<GetAvailablePackageMetadatasResponse>) {
+  constructor(data?: PartialMessage<GetInstalledPackageResourceRefsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetAvailablePackageMetadatasResponse";
+    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "available_package_ref", kind: "message", T: AvailablePackageReference },
-    { no: 2, name: "package_metadata", kind: "message", T: PackageMetadata, repeated: true },
+    { no: 1, name: "context", kind: "message", T: Context },
+    { no: 2, name: "resource_refs", kind: "message", T: ResourceRef, repeated: true },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetAvailablePackageMetadatasResponse {
-    return new GetAvailablePackageMetadatasResponse().fromBinary(bytes, options);
+  ): GetInstalledPackageResourceRefsResponse {
+    return new GetInstalledPackageResourceRefsResponse().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageMetadatasResponse {
-    return new GetAvailablePackageMetadatasResponse().fromJson(jsonValue, options);
+  ): GetInstalledPackageResourceRefsResponse {
+    return new GetInstalledPackageResourceRefsResponse().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetAvailablePackageMetadatasResponse {
-    return new GetAvailablePackageMetadatasResponse().fromJsonString(jsonString, options);
+  ): GetInstalledPackageResourceRefsResponse {
+    return new GetInstalledPackageResourceRefsResponse().fromJsonString(jsonString, options);
   }
 
   static equals(
     a:
-      | GetAvailablePackageMetadatasResponse
-      | PlainMessage<GetAvailablePackageMetadatasResponse>
+      | GetInstalledPackageResourceRefsResponse
+      | PlainMessage<GetInstalledPackageResourceRefsResponse>
       | undefined,
     b:
-      | GetAvailablePackageMetadatasResponse
-      | PlainMessage<GetAvailablePackageMetadatasResponse>
+      | GetInstalledPackageResourceRefsResponse
+      | PlainMessage<GetInstalledPackageResourceRefsResponse>
       | undefined,
   ): boolean {
-    return proto3.util.equals(GetAvailablePackageMetadatasResponse, a, b);
+    return proto3.util.equals(GetInstalledPackageResourceRefsResponse, a, b);
   }
 }
 
 /**
- * GetInstalledPackageSummariesResponse
+ * WatchInstalledPackagesResponse
  *
- * Response for GetInstalledPackageSummaries
+ * Response for WatchInstalledPackages. One response is streamed each time an
+ * installed package is added, deleted or its status changes.
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
+ * @generated from message kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse
  */
-export class GetInstalledPackageSummariesResponse extends Message<GetInstalledPackageSummariesResponse> {
+export class WatchInstalledPackagesResponse extends Message<WatchInstalledPackagesResponse> {
   /**
-   * Installed packages summaries
+   * Event type
    *
-   * List of InstalledPackageSummary
+   * An enum indicating the kind of change.
    *
-   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary installed_package_summaries = 1;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse.EventType event_type = 1;
    */
-  installedPackageSummaries: InstalledPackageSummary[] = [];
+  eventType = WatchInstalledPackagesResponse_EventType.UNSPECIFIED;
 
   /**
-   * Next page token
+   * Installed package summary
    *
-   * This field represents the pagination token to retrieve the next page of
-   * results. If the value is "", it means no further results for the request.
+   * The summary of the installed package after the change. For deleted
+   * packages, this is the last known summary.
    *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary installed_package_summary = 2;
    */
-  nextPageToken = "";
+  installedPackageSummary?: InstalledPackageSummary;
 
-  constructor(data?: PartialMessage<GetInstalledPackageSummariesResponse>) {
+  /**
+   * Previous status
+   *
+   * The status of the installed package before the change, if it was
+   * already known on this stream.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus previous_status = 3;
+   */
+  previousStatus?: InstalledPackageStatus;
+
+  constructor(data?: PartialMessage<WatchInstalledPackagesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     {
       no: 1,
-      name: "installed_package_summaries",
-      kind: "message",
-      T: InstalledPackageSummary,
-      repeated: true,
+      name: "event_type",
+      kind: "enum",
+      T: proto3.getEnumType(WatchInstalledPackagesResponse_EventType),
     },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "installed_package_summary", kind: "message", T: InstalledPackageSummary },
+    { no: 3, name: "previous_status", kind: "message", T: InstalledPackageStatus },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetInstalledPackageSummariesResponse {
-    return new GetInstalledPackageSummariesResponse().fromBinary(bytes, options);
+  ): WatchInstalledPackagesResponse {
+    return new WatchInstalledPackagesResponse().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageSummariesResponse {
-    return new GetInstalledPackageSummariesResponse().fromJson(jsonValue, options);
+  ): WatchInstalledPackagesResponse {
+    return new WatchInstalledPackagesResponse().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageSummariesResponse {
-    return new GetInstalledPackageSummariesResponse().fromJsonString(jsonString, options);
+  ): WatchInstalledPackagesResponse {
+    return new WatchInstalledPackagesResponse().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a:
-      | GetInstalledPackageSummariesResponse
-      | PlainMessage<GetInstalledPackageSummariesResponse>
-      | undefined,
-    b:
-      | GetInstalledPackageSummariesResponse
-      | PlainMessage<GetInstalledPackageSummariesResponse>
-      | undefined,
+    a: WatchInstalledPackagesResponse | PlainMessage<WatchInstalledPackagesResponse> | undefined,
+    b: WatchInstalledPackagesResponse | PlainMessage<WatchInstalledPackagesResponse> | undefined,
   ): boolean {
-    return proto3.util.equals(GetInstalledPackageSummariesResponse, a, b);
+    return proto3.util.equals(WatchInstalledPackagesResponse, a, b);
   }
 }
 
 /**
- * GetInstalledPackageDetailResponse
+ * EventType
  *
- * Response for GetInstalledPackageDetail
+ * The kind of change being notified for the installed package.
  *
- * TODO: add example for API docs
- * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
- *   example: '{"installed_package_detail": [{}]}'
- * };
+ * @generated from enum kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse.EventType
+ */
+export enum WatchInstalledPackagesResponse_EventType {
+  /**
+   * @generated from enum value: EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_MODIFIED = 2;
+   */
+  MODIFIED = 2,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_DELETED = 3;
+   */
+  DELETED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WatchInstalledPackagesResponse_EventType)
+proto3.util.setEnumType(
+  WatchInstalledPackagesResponse_EventType,
+  "kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse.EventType",
+  [
+    { no: 0, name: "EVENT_TYPE_UNSPECIFIED" },
+    { no: 1, name: "EVENT_TYPE_ADDED" },
+    { no: 2, name: "EVENT_TYPE_MODIFIED" },
+    { no: 3, name: "EVENT_TYPE_DELETED" },
+  ],
+);
+
+/**
+ * InstalledPackagesManifest
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
+ * A portable description of a set of installed packages, which can be
+ * imported in another cluster or namespace where the same package
+ * repositories are available.
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.InstalledPackagesManifest
  */
-export class GetInstalledPackageDetailResponse extends Message<GetInstalledPackageDetailResponse> {
+export class InstalledPackagesManifest extends Message<InstalledPackagesManifest> {
   /**
-   * InstalledPackageDetail
+   * The version of the manifest format, currently "kubeapps.dev/v1alpha1".
    *
-   * The requested InstalledPackageDetail
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * The installed packages of the manifest.
    *
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail installed_package_detail = 1;
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.InstalledPackagesManifestEntry installed_packages = 2;
    */
-  installedPackageDetail?: InstalledPackageDetail;
+  installedPackages: InstalledPackagesManifestEntry[] = [];
 
-  constructor(data?: PartialMessage<GetInstalledPackageDetailResponse>) {
+  constructor(data?: PartialMessage<InstalledPackagesManifest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.InstalledPackagesManifest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "installed_package_detail", kind: "message", T: InstalledPackageDetail },
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    {
+      no: 2,
+      name: "installed_packages",
+      kind: "message",
+      T: InstalledPackagesManifestEntry,
+      repeated: true,
+    },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetInstalledPackageDetailResponse {
-    return new GetInstalledPackageDetailResponse().fromBinary(bytes, options);
+  ): InstalledPackagesManifest {
+    return new InstalledPackagesManifest().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageDetailResponse {
-    return new GetInstalledPackageDetailResponse().fromJson(jsonValue, options);
+  ): InstalledPackagesManifest {
+    return new InstalledPackagesManifest().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageDetailResponse {
-    return new GetInstalledPackageDetailResponse().fromJsonString(jsonString, options);
+  ): InstalledPackagesManifest {
+    return new InstalledPackagesManifest().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a:
-      | GetInstalledPackageDetailResponse
-      | PlainMessage<GetInstalledPackageDetailResponse>
-      | undefined,
-    b:
-      | GetInstalledPackageDetailResponse
-      | PlainMessage<GetInstalledPackageDetailResponse>
-      | undefined,
+    a: InstalledPackagesManifest | PlainMessage<InstalledPackagesManifest> | undefined,
+    b: InstalledPackagesManifest | PlainMessage<InstalledPackagesManifest> | undefined,
   ): boolean {
-    return proto3.util.equals(GetInstalledPackageDetailResponse, a, b);
+    return proto3.util.equals(InstalledPackagesManifest, a, b);
   }
 }
 
-/**
- * CreateInstalledPackageResponse
- *
- * Response for CreateInstalledPackage
- *
- * TODO: add example for API docs
- * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
- *   example: '{"installed_package_ref": {}}'
- * };
- *
- * @generated from message kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
- */
-export class CreateInstalledPackageResponse extends Message<CreateInstalledPackageResponse> {
+/**
+ * InstalledPackagesManifestEntry
+ *
+ * An installed package within an InstalledPackagesManifest.
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.InstalledPackagesManifestEntry
+ */
+export class InstalledPackagesManifestEntry extends Message<InstalledPackagesManifestEntry> {
+  /**
+   * The name of the installed package.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * A reference to the available package, including its plugin and the
+   * context of its repository.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference available_package_ref = 2;
+   */
+  availablePackageRef?: AvailablePackageReference;
+
+  /**
+   * The version of the package, as requested when it was installed or, if
+   * none, the current version.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.VersionReference pkg_version_reference = 3;
+   */
+  pkgVersionReference?: VersionReference;
+
+  /**
+   * The values applied to the installed package, in the format expected by
+   * the plugin.
+   *
+   * @generated from field: string values = 4;
+   */
+  values = "";
+
+  /**
+   * The reconciliation options of the installed package, if any.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.ReconciliationOptions reconciliation_options = 5;
+   */
+  reconciliationOptions?: ReconciliationOptions;
+
   /**
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   * The namespace of the installed package when exported, used on import
+   * unless the request specifies a target namespace.
+   *
+   * @generated from field: string namespace = 6;
    */
-  installedPackageRef?: InstalledPackageReference;
+  namespace = "";
 
-  constructor(data?: PartialMessage<CreateInstalledPackageResponse>) {
+  constructor(data?: PartialMessage<InstalledPackagesManifestEntry>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.InstalledPackagesManifestEntry";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "available_package_ref", kind: "message", T: AvailablePackageReference },
+    { no: 3, name: "pkg_version_reference", kind: "message", T: VersionReference },
+    { no: 4, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reconciliation_options", kind: "message", T: ReconciliationOptions },
+    { no: 6, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): CreateInstalledPackageResponse {
-    return new CreateInstalledPackageResponse().fromBinary(bytes, options);
+  ): InstalledPackagesManifestEntry {
+    return new InstalledPackagesManifestEntry().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): CreateInstalledPackageResponse {
-    return new CreateInstalledPackageResponse().fromJson(jsonValue, options);
+  ): InstalledPackagesManifestEntry {
+    return new InstalledPackagesManifestEntry().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): CreateInstalledPackageResponse {
-    return new CreateInstalledPackageResponse().fromJsonString(jsonString, options);
+  ): InstalledPackagesManifestEntry {
+    return new InstalledPackagesManifestEntry().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a: CreateInstalledPackageResponse | PlainMessage<CreateInstalledPackageResponse> | undefined,
-    b: CreateInstalledPackageResponse | PlainMessage<CreateInstalledPackageResponse> | undefined,
+    a: InstalledPackagesManifestEntry | PlainMessage<InstalledPackagesManifestEntry> | undefined,
+    b: InstalledPackagesManifestEntry | PlainMessage<InstalledPackagesManifestEntry> | undefined,
   ): boolean {
-    return proto3.util.equals(CreateInstalledPackageResponse, a, b);
+    return proto3.util.equals(InstalledPackagesManifestEntry, a, b);
   }
 }
 
 /**
- * UpdateInstalledPackageResponse
- *
- * Response for UpdateInstalledPackage
+ * ImportInstalledPackageResult
  *
- * TODO: add example for API docs
- * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
- *   example: '{"installed_package_ref": {}}'
- * };
+ * The result of the import of a single installed package of a manifest.
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ImportInstalledPackageResult
  */
-export class UpdateInstalledPackageResponse extends Message<UpdateInstalledPackageResponse> {
+export class ImportInstalledPackageResult extends Message<ImportInstalledPackageResult> {
   /**
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   * The name of the installed package in the manifest.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * A reference to the installed package in the target context, which can
+   * have a different name when renamed.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 2;
    */
   installedPackageRef?: InstalledPackageReference;
 
-  constructor(data?: PartialMessage<UpdateInstalledPackageResponse>) {
+  /**
+   * The outcome of the import.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.ImportInstalledPackageResult.Result result = 3;
+   */
+  result = ImportInstalledPackageResult_Result.UNSPECIFIED;
+
+  /**
+   * The reason for which the import failed, if any.
+   *
+   * @generated from field: string error_message = 4;
+   */
+  errorMessage = "";
+
+  constructor(data?: PartialMessage<ImportInstalledPackageResult>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.ImportInstalledPackageResult";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    {
+      no: 3,
+      name: "result",
+      kind: "enum",
+      T: proto3.getEnumType(ImportInstalledPackageResult_Result),
+    },
+    { no: 4, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): UpdateInstalledPackageResponse {
-    return new UpdateInstalledPackageResponse().fromBinary(bytes, options);
+  ): ImportInstalledPackageResult {
+    return new ImportInstalledPackageResult().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): UpdateInstalledPackageResponse {
-    return new UpdateInstalledPackageResponse().fromJson(jsonValue, options);
+  ): ImportInstalledPackageResult {
+    return new ImportInstalledPackageResult().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): UpdateInstalledPackageResponse {
-    return new UpdateInstalledPackageResponse().fromJsonString(jsonString, options);
+  ): ImportInstalledPackageResult {
+    return new ImportInstalledPackageResult().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a: UpdateInstalledPackageResponse | PlainMessage<UpdateInstalledPackageResponse> | undefined,
-    b: UpdateInstalledPackageResponse | PlainMessage<UpdateInstalledPackageResponse> | undefined,
+    a: ImportInstalledPackageResult | PlainMessage<ImportInstalledPackageResult> | undefined,
+    b: ImportInstalledPackageResult | PlainMessage<ImportInstalledPackageResult> | undefined,
   ): boolean {
-    return proto3.util.equals(UpdateInstalledPackageResponse, a, b);
+    return proto3.util.equals(ImportInstalledPackageResult, a, b);
   }
 }
 
 /**
- * DeleteInstalledPackageResponse
+ * Result
  *
- * Response for DeleteInstalledPackage
+ * The outcome of the import of the installed package.
  *
- * For future extensibility only.
+ * @generated from enum kubeappsapis.core.packages.v1alpha1.ImportInstalledPackageResult.Result
+ */
+export enum ImportInstalledPackageResult_Result {
+  /**
+   * @generated from enum value: RESULT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The installed package was created.
+   *
+   * @generated from enum value: RESULT_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * The existing installed package was updated.
+   *
+   * @generated from enum value: RESULT_UPDATED = 2;
+   */
+  UPDATED = 2,
+
+  /**
+   * The existing installed package was left untouched.
+   *
+   * @generated from enum value: RESULT_SKIPPED = 3;
+   */
+  SKIPPED = 3,
+
+  /**
+   * The installed package could not be imported. See the error message.
+   *
+   * @generated from enum value: RESULT_FAILED = 4;
+   */
+  FAILED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ImportInstalledPackageResult_Result)
+proto3.util.setEnumType(
+  ImportInstalledPackageResult_Result,
+  "kubeappsapis.core.packages.v1alpha1.ImportInstalledPackageResult.Result",
+  [
+    { no: 0, name: "RESULT_UNSPECIFIED" },
+    { no: 1, name: "RESULT_CREATED" },
+    { no: 2, name: "RESULT_UPDATED" },
+    { no: 3, name: "RESULT_SKIPPED" },
+    { no: 4, name: "RESULT_FAILED" },
+  ],
+);
+
+/**
+ * ValuesSchemaViolation
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
+ * A violation of the values schema of a package.
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.ValuesSchemaViolation
  */
-export class DeleteInstalledPackageResponse extends Message<DeleteInstalledPackageResponse> {
-  constructor(data?: PartialMessage<DeleteInstalledPackageResponse>) {
+export class ValuesSchemaViolation extends Message<ValuesSchemaViolation> {
+  /**
+   * The path of the value violating the schema (eg. "image.tag"), or
+   * "(root)" for the values as a whole.
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * A human readable description of the violation.
+   *
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  constructor(data?: PartialMessage<ValuesSchemaViolation>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => []);
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.ValuesSchemaViolation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): DeleteInstalledPackageResponse {
-    return new DeleteInstalledPackageResponse().fromBinary(bytes, options);
+  ): ValuesSchemaViolation {
+    return new ValuesSchemaViolation().fromBinary(bytes, options);
   }
 
-  static fromJson(
-    jsonValue: JsonValue,
-    options?: Partial<JsonReadOptions>,
-  ): DeleteInstalledPackageResponse {
-    return new DeleteInstalledPackageResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValuesSchemaViolation {
+    return new ValuesSchemaViolation().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): DeleteInstalledPackageResponse {
-    return new DeleteInstalledPackageResponse().fromJsonString(jsonString, options);
+  ): ValuesSchemaViolation {
+    return new ValuesSchemaViolation().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a: DeleteInstalledPackageResponse | PlainMessage<DeleteInstalledPackageResponse> | undefined,
-    b: DeleteInstalledPackageResponse | PlainMessage<DeleteInstalledPackageResponse> | undefined,
+    a: ValuesSchemaViolation | PlainMessage<ValuesSchemaViolation> | undefined,
+    b: ValuesSchemaViolation | PlainMessage<ValuesSchemaViolation> | undefined,
   ): boolean {
-    return proto3.util.equals(DeleteInstalledPackageResponse, a, b);
+    return proto3.util.equals(ValuesSchemaViolation, a, b);
   }
 }
 
 /**
- * GetInstalledPackageResourceRefsResponse
+ * BulkUpdateInstalledPackageResult
  *
- * Response for GetInstalledPackageResourceRefs
+ * The result of the update of a single installed package within a bulk update.
  *
- * @generated from message kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsResponse
+ * @generated from message kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackageResult
  */
-export class GetInstalledPackageResourceRefsResponse extends Message<GetInstalledPackageResourceRefsResponse> {
+export class BulkUpdateInstalledPackageResult extends Message<BulkUpdateInstalledPackageResult> {
   /**
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.Context context = 1;
+   * A reference to the installed package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
    */
-  context?: Context;
+  installedPackageRef?: InstalledPackageReference;
 
   /**
-   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.ResourceRef resource_refs = 2;
+   * The version of the installed package before the update.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageAppVersion previous_version = 2;
    */
-  resourceRefs: ResourceRef[] = [];
+  previousVersion?: PackageAppVersion;
 
-  constructor(data?: PartialMessage<GetInstalledPackageResourceRefsResponse>) {
+  /**
+   * The version to which the installed package is updated, when a newer
+   * version is allowed by the upgrade policy.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageAppVersion target_version = 3;
+   */
+  targetVersion?: PackageAppVersion;
+
+  /**
+   * The outcome of the update.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackageResult.Result result = 4;
+   */
+  result = BulkUpdateInstalledPackageResult_Result.UNSPECIFIED;
+
+  /**
+   * The reason for which the update failed, if any.
+   *
+   * @generated from field: string error_message = 5;
+   */
+  errorMessage = "";
+
+  constructor(data?: PartialMessage<BulkUpdateInstalledPackageResult>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName =
-    "kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsResponse";
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackageResult";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "context", kind: "message", T: Context },
-    { no: 2, name: "resource_refs", kind: "message", T: ResourceRef, repeated: true },
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "previous_version", kind: "message", T: PackageAppVersion },
+    { no: 3, name: "target_version", kind: "message", T: PackageAppVersion },
+    {
+      no: 4,
+      name: "result",
+      kind: "enum",
+      T: proto3.getEnumType(BulkUpdateInstalledPackageResult_Result),
+    },
+    { no: 5, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): GetInstalledPackageResourceRefsResponse {
-    return new GetInstalledPackageResourceRefsResponse().fromBinary(bytes, options);
+  ): BulkUpdateInstalledPackageResult {
+    return new BulkUpdateInstalledPackageResult().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageResourceRefsResponse {
-    return new GetInstalledPackageResourceRefsResponse().fromJson(jsonValue, options);
+  ): BulkUpdateInstalledPackageResult {
+    return new BulkUpdateInstalledPackageResult().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): GetInstalledPackageResourceRefsResponse {
-    return new GetInstalledPackageResourceRefsResponse().fromJsonString(jsonString, options);
+  ): BulkUpdateInstalledPackageResult {
+    return new BulkUpdateInstalledPackageResult().fromJsonString(jsonString, options);
   }
 
   static equals(
     a:
-      | GetInstalledPackageResourceRefsResponse
-      | PlainMessage<GetInstalledPackageResourceRefsResponse>
+      | BulkUpdateInstalledPackageResult
+      | PlainMessage<BulkUpdateInstalledPackageResult>
       | undefined,
     b:
-      | GetInstalledPackageResourceRefsResponse
-      | PlainMessage<GetInstalledPackageResourceRefsResponse>
+      | BulkUpdateInstalledPackageResult
+      | PlainMessage<BulkUpdateInstalledPackageResult>
       | undefined,
   ): boolean {
-    return proto3.util.equals(GetInstalledPackageResourceRefsResponse, a, b);
+    return proto3.util.equals(BulkUpdateInstalledPackageResult, a, b);
   }
 }
 
+/**
+ * Result
+ *
+ * The outcome of the update of the installed package.
+ *
+ * @generated from enum kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackageResult.Result
+ */
+export enum BulkUpdateInstalledPackageResult_Result {
+  /**
+   * @generated from enum value: RESULT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The installed package was updated to the target version.
+   *
+   * @generated from enum value: RESULT_UPDATED = 1;
+   */
+  UPDATED = 1,
+
+  /**
+   * No newer version is allowed by the upgrade policy.
+   *
+   * @generated from enum value: RESULT_UP_TO_DATE = 2;
+   */
+  UP_TO_DATE = 2,
+
+  /**
+   * The installed package could not be updated. See the error message.
+   *
+   * @generated from enum value: RESULT_FAILED = 3;
+   */
+  FAILED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(BulkUpdateInstalledPackageResult_Result)
+proto3.util.setEnumType(
+  BulkUpdateInstalledPackageResult_Result,
+  "kubeappsapis.core.packages.v1alpha1.BulkUpdateInstalledPackageResult.Result",
+  [
+    { no: 0, name: "RESULT_UNSPECIFIED" },
+    { no: 1, name: "RESULT_UPDATED" },
+    { no: 2, name: "RESULT_UP_TO_DATE" },
+    { no: 3, name: "RESULT_FAILED" },
+  ],
+);
+
 /**
  * AvailablePackageSummary
  *
@@ -2109,6 +3593,17 @@ export class InstalledPackageDetail extends Message<InstalledPackageDetail> {
    */
   customDetail?: Any;
 
+  /**
+   * ServerSideValues
+   *
+   * Whether the plugin merges values which are not sent with an update request,
+   * such as values read from Secrets referenced by the installed package, in
+   * which case the values of the request cannot be validated on their own.
+   *
+   * @generated from field: bool server_side_values = 15;
+   */
+  serverSideValues = false;
+
   constructor(data?: PartialMessage<InstalledPackageDetail>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2129,6 +3624,7 @@ export class InstalledPackageDetail extends Message<InstalledPackageDetail> {
     { no: 10, name: "latest_matching_version", kind: "message", T: PackageAppVersion },
     { no: 11, name: "latest_version", kind: "message", T: PackageAppVersion },
     { no: 14, name: "custom_detail", kind: "message", T: Any },
+    { no: 15, name: "server_side_values", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(
@@ -3046,6 +4542,87 @@ export class PackageMetadata extends Message<PackageMetadata> {
   }
 }
 
+/**
+ * Rendered package
+ *
+ * A RenderedPackage contains the result of rendering a package for a dry-run
+ * install or update, without applying anything to the cluster.
+ *
+ * @generated from message kubeappsapis.core.packages.v1alpha1.RenderedPackage
+ */
+export class RenderedPackage extends Message<RenderedPackage> {
+  /**
+   * Manifest
+   *
+   * The fully rendered manifest, including the output of any post-renderers
+   * run by the plugin, as it would be applied to the cluster.
+   *
+   * @generated from field: string manifest = 1;
+   */
+  manifest = "";
+
+  /**
+   * Resource references
+   *
+   * The references for the Kubernetes resources in the rendered manifest.
+   *
+   * @generated from field: repeated kubeappsapis.core.packages.v1alpha1.ResourceRef resource_refs = 2;
+   */
+  resourceRefs: ResourceRef[] = [];
+
+  /**
+   * Current manifest
+   *
+   * For an update, the manifest currently deployed for the installed package,
+   * so that it can be compared with the rendered manifest.
+   *
+   * @generated from field: string current_manifest = 3;
+   */
+  currentManifest = "";
+
+  /**
+   * PostInstallationNotes
+   *
+   * Optional notes generated by the package and intended for the user post installation.
+   *
+   * @generated from field: string post_installation_notes = 4;
+   */
+  postInstallationNotes = "";
+
+  constructor(data?: PartialMessage<RenderedPackage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.core.packages.v1alpha1.RenderedPackage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "manifest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource_refs", kind: "message", T: ResourceRef, repeated: true },
+    { no: 3, name: "current_manifest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "post_installation_notes", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenderedPackage {
+    return new RenderedPackage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenderedPackage {
+    return new RenderedPackage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenderedPackage {
+    return new RenderedPackage().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: RenderedPackage | PlainMessage<RenderedPackage> | undefined,
+    b: RenderedPackage | PlainMessage<RenderedPackage> | undefined,
+  ): boolean {
+    return proto3.util.equals(RenderedPackage, a, b);
+  }
+}
+
 /**
  * Resource reference
  *
@@ -27,6 +27,22 @@ import {
   UpdateInstalledPackageResponse,
 } from "../../../../core/packages/v1alpha1/packages_pb";
 import { MethodKind } from "@bufbuild/protobuf";
+import {
+  GetInstalledPackageHistoryRequest,
+  GetInstalledPackageHistoryResponse,
+  ReconcileInstalledPackageRequest,
+  ReconcileInstalledPackageResponse,
+  ReconcilePackageRepositoryRequest,
+  ReconcilePackageRepositoryResponse,
+  ResumeInstalledPackageRequest,
+  ResumeInstalledPackageResponse,
+  ResumePackageRepositoryRequest,
+  ResumePackageRepositoryResponse,
+  SuspendInstalledPackageRequest,
+  SuspendInstalledPackageResponse,
+  SuspendPackageRepositoryRequest,
+  SuspendPackageRepositoryResponse,
+} from "./fluxv2_pb";
 import {
   AddPackageRepositoryRequest,
   AddPackageRepositoryResponse,
@@ -148,6 +164,53 @@ export const FluxV2PackagesService = {
       O: GetInstalledPackageResourceRefsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ReconcileInstalledPackage requests an immediate reconciliation of an installed
+     * package by flux, optionally waiting until it has been handled.
+     *
+     * @generated from rpc kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.ReconcileInstalledPackage
+     */
+    reconcileInstalledPackage: {
+      name: "ReconcileInstalledPackage",
+      I: ReconcileInstalledPackageRequest,
+      O: ReconcileInstalledPackageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SuspendInstalledPackage suspends the reconciliation of an installed package
+     * by flux, leaving the rest of its spec intact.
+     *
+     * @generated from rpc kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.SuspendInstalledPackage
+     */
+    suspendInstalledPackage: {
+      name: "SuspendInstalledPackage",
+      I: SuspendInstalledPackageRequest,
+      O: SuspendInstalledPackageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+     *
+     * @generated from rpc kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.ResumeInstalledPackage
+     */
+    resumeInstalledPackage: {
+      name: "ResumeInstalledPackage",
+      I: ResumeInstalledPackageRequest,
+      O: ResumeInstalledPackageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetInstalledPackageHistory returns the reconciliation history recorded by flux
+     * in the status of a HelmRelease, together with the related kubernetes events.
+     *
+     * @generated from rpc kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService.GetInstalledPackageHistory
+     */
+    getInstalledPackageHistory: {
+      name: "GetInstalledPackageHistory",
+      I: GetInstalledPackageHistoryRequest,
+      O: GetInstalledPackageHistoryResponse,
+      kind: MethodKind.Unary,
+    },
   },
 } as const;
 
@@ -214,5 +277,39 @@ export const FluxV2RepositoriesService = {
       O: GetPackageRepositoryPermissionsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ReconcilePackageRepository requests an immediate reconciliation of a package
+     * repository by flux, optionally waiting until it has been handled.
+     *
+     * @generated from rpc kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.ReconcilePackageRepository
+     */
+    reconcilePackageRepository: {
+      name: "ReconcilePackageRepository",
+      I: ReconcilePackageRepositoryRequest,
+      O: ReconcilePackageRepositoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SuspendPackageRepository suspends the reconciliation of a package repository by flux.
+     *
+     * @generated from rpc kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.SuspendPackageRepository
+     */
+    suspendPackageRepository: {
+      name: "SuspendPackageRepository",
+      I: SuspendPackageRepositoryRequest,
+      O: SuspendPackageRepositoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ResumePackageRepository resumes the reconciliation of a suspended package repository.
+     *
+     * @generated from rpc kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService.ResumePackageRepository
+     */
+    resumePackageRepository: {
+      name: "ResumePackageRepository",
+      I: ResumePackageRepositoryRequest,
+      O: ResumePackageRepositoryResponse,
+      kind: MethodKind.Unary,
+    },
   },
 } as const;
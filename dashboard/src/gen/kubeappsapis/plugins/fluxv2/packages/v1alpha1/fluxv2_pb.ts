@@ -14,74 +14,1815 @@ import type {
   PartialMessage,
   PlainMessage,
 } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import {
+  InstalledPackageReference,
+  InstalledPackageStatus,
+} from "../../../../core/packages/v1alpha1/packages_pb";
+import {
+  PackageRepositoryReference,
+  PackageRepositoryStatus,
+} from "../../../../core/packages/v1alpha1/repositories_pb";
+
+/**
+ * ReconcileInstalledPackageRequest
+ *
+ * Request for ReconcileInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcileInstalledPackageRequest
+ */
+export class ReconcileInstalledPackageRequest extends Message<ReconcileInstalledPackageRequest> {
+  /**
+   * A reference uniquely identifying the installed package.
+   * Required
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * Whether to wait until flux has handled the reconciliation request and
+   * observed the latest generation of the installed package. Optional
+   *
+   * @generated from field: bool wait = 2;
+   */
+  wait = false;
+
+  /**
+   * The number of seconds to wait for when wait is set. Defaults to the
+   * timeout configured for the plugin, or 5 minutes. Optional
+   *
+   * @generated from field: int32 timeout_seconds = 3;
+   */
+  timeoutSeconds = 0;
+
+  constructor(data?: PartialMessage<ReconcileInstalledPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcileInstalledPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "wait", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ReconcileInstalledPackageRequest {
+    return new ReconcileInstalledPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcileInstalledPackageRequest {
+    return new ReconcileInstalledPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcileInstalledPackageRequest {
+    return new ReconcileInstalledPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | ReconcileInstalledPackageRequest
+      | PlainMessage<ReconcileInstalledPackageRequest>
+      | undefined,
+    b:
+      | ReconcileInstalledPackageRequest
+      | PlainMessage<ReconcileInstalledPackageRequest>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(ReconcileInstalledPackageRequest, a, b);
+  }
+}
+
+/**
+ * ReconcileInstalledPackageResponse
+ *
+ * Response for ReconcileInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcileInstalledPackageResponse
+ */
+export class ReconcileInstalledPackageResponse extends Message<ReconcileInstalledPackageResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * The status of the installed package, after the reconciliation when waiting
+   * for it.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus status = 2;
+   */
+  status?: InstalledPackageStatus;
+
+  constructor(data?: PartialMessage<ReconcileInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcileInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "status", kind: "message", T: InstalledPackageStatus },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ReconcileInstalledPackageResponse {
+    return new ReconcileInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcileInstalledPackageResponse {
+    return new ReconcileInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcileInstalledPackageResponse {
+    return new ReconcileInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | ReconcileInstalledPackageResponse
+      | PlainMessage<ReconcileInstalledPackageResponse>
+      | undefined,
+    b:
+      | ReconcileInstalledPackageResponse
+      | PlainMessage<ReconcileInstalledPackageResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(ReconcileInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * SuspendInstalledPackageRequest
+ *
+ * Request for SuspendInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendInstalledPackageRequest
+ */
+export class SuspendInstalledPackageRequest extends Message<SuspendInstalledPackageRequest> {
+  /**
+   * A reference uniquely identifying the installed package.
+   * Required
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<SuspendInstalledPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendInstalledPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): SuspendInstalledPackageRequest {
+    return new SuspendInstalledPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendInstalledPackageRequest {
+    return new SuspendInstalledPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendInstalledPackageRequest {
+    return new SuspendInstalledPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: SuspendInstalledPackageRequest | PlainMessage<SuspendInstalledPackageRequest> | undefined,
+    b: SuspendInstalledPackageRequest | PlainMessage<SuspendInstalledPackageRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(SuspendInstalledPackageRequest, a, b);
+  }
+}
+
+/**
+ * SuspendInstalledPackageResponse
+ *
+ * Response for SuspendInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendInstalledPackageResponse
+ */
+export class SuspendInstalledPackageResponse extends Message<SuspendInstalledPackageResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<SuspendInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): SuspendInstalledPackageResponse {
+    return new SuspendInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendInstalledPackageResponse {
+    return new SuspendInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendInstalledPackageResponse {
+    return new SuspendInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: SuspendInstalledPackageResponse | PlainMessage<SuspendInstalledPackageResponse> | undefined,
+    b: SuspendInstalledPackageResponse | PlainMessage<SuspendInstalledPackageResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(SuspendInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * ResumeInstalledPackageRequest
+ *
+ * Request for ResumeInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumeInstalledPackageRequest
+ */
+export class ResumeInstalledPackageRequest extends Message<ResumeInstalledPackageRequest> {
+  /**
+   * A reference uniquely identifying the installed package.
+   * Required
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<ResumeInstalledPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumeInstalledPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ResumeInstalledPackageRequest {
+    return new ResumeInstalledPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ResumeInstalledPackageRequest {
+    return new ResumeInstalledPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ResumeInstalledPackageRequest {
+    return new ResumeInstalledPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ResumeInstalledPackageRequest | PlainMessage<ResumeInstalledPackageRequest> | undefined,
+    b: ResumeInstalledPackageRequest | PlainMessage<ResumeInstalledPackageRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(ResumeInstalledPackageRequest, a, b);
+  }
+}
+
+/**
+ * ResumeInstalledPackageResponse
+ *
+ * Response for ResumeInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumeInstalledPackageResponse
+ */
+export class ResumeInstalledPackageResponse extends Message<ResumeInstalledPackageResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<ResumeInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumeInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ResumeInstalledPackageResponse {
+    return new ResumeInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ResumeInstalledPackageResponse {
+    return new ResumeInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ResumeInstalledPackageResponse {
+    return new ResumeInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ResumeInstalledPackageResponse | PlainMessage<ResumeInstalledPackageResponse> | undefined,
+    b: ResumeInstalledPackageResponse | PlainMessage<ResumeInstalledPackageResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(ResumeInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * ReconcilePackageRepositoryRequest
+ *
+ * Request for ReconcilePackageRepository
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcilePackageRepositoryRequest
+ */
+export class ReconcilePackageRepositoryRequest extends Message<ReconcilePackageRepositoryRequest> {
+  /**
+   * A reference uniquely identifying the package repository.
+   * Required
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference package_repo_ref = 1;
+   */
+  packageRepoRef?: PackageRepositoryReference;
+
+  /**
+   * Whether to wait until flux has handled the reconciliation request and
+   * observed the latest generation of the package repository. Optional
+   *
+   * @generated from field: bool wait = 2;
+   */
+  wait = false;
+
+  /**
+   * The number of seconds to wait for when wait is set. Defaults to the
+   * timeout configured for the plugin, or 5 minutes. Optional
+   *
+   * @generated from field: int32 timeout_seconds = 3;
+   */
+  timeoutSeconds = 0;
+
+  constructor(data?: PartialMessage<ReconcilePackageRepositoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcilePackageRepositoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_repo_ref", kind: "message", T: PackageRepositoryReference },
+    { no: 2, name: "wait", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ReconcilePackageRepositoryRequest {
+    return new ReconcilePackageRepositoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcilePackageRepositoryRequest {
+    return new ReconcilePackageRepositoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcilePackageRepositoryRequest {
+    return new ReconcilePackageRepositoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | ReconcilePackageRepositoryRequest
+      | PlainMessage<ReconcilePackageRepositoryRequest>
+      | undefined,
+    b:
+      | ReconcilePackageRepositoryRequest
+      | PlainMessage<ReconcilePackageRepositoryRequest>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(ReconcilePackageRepositoryRequest, a, b);
+  }
+}
+
+/**
+ * ReconcilePackageRepositoryResponse
+ *
+ * Response for ReconcilePackageRepository
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcilePackageRepositoryResponse
+ */
+export class ReconcilePackageRepositoryResponse extends Message<ReconcilePackageRepositoryResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference package_repo_ref = 1;
+   */
+  packageRepoRef?: PackageRepositoryReference;
+
+  /**
+   * The status of the package repository, after the reconciliation when
+   * waiting for it.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageRepositoryStatus status = 2;
+   */
+  status?: PackageRepositoryStatus;
+
+  constructor(data?: PartialMessage<ReconcilePackageRepositoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ReconcilePackageRepositoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_repo_ref", kind: "message", T: PackageRepositoryReference },
+    { no: 2, name: "status", kind: "message", T: PackageRepositoryStatus },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ReconcilePackageRepositoryResponse {
+    return new ReconcilePackageRepositoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcilePackageRepositoryResponse {
+    return new ReconcilePackageRepositoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ReconcilePackageRepositoryResponse {
+    return new ReconcilePackageRepositoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | ReconcilePackageRepositoryResponse
+      | PlainMessage<ReconcilePackageRepositoryResponse>
+      | undefined,
+    b:
+      | ReconcilePackageRepositoryResponse
+      | PlainMessage<ReconcilePackageRepositoryResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(ReconcilePackageRepositoryResponse, a, b);
+  }
+}
+
+/**
+ * SuspendPackageRepositoryRequest
+ *
+ * Request for SuspendPackageRepository
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendPackageRepositoryRequest
+ */
+export class SuspendPackageRepositoryRequest extends Message<SuspendPackageRepositoryRequest> {
+  /**
+   * A reference uniquely identifying the package repository.
+   * Required
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference package_repo_ref = 1;
+   */
+  packageRepoRef?: PackageRepositoryReference;
+
+  constructor(data?: PartialMessage<SuspendPackageRepositoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendPackageRepositoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_repo_ref", kind: "message", T: PackageRepositoryReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): SuspendPackageRepositoryRequest {
+    return new SuspendPackageRepositoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendPackageRepositoryRequest {
+    return new SuspendPackageRepositoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendPackageRepositoryRequest {
+    return new SuspendPackageRepositoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: SuspendPackageRepositoryRequest | PlainMessage<SuspendPackageRepositoryRequest> | undefined,
+    b: SuspendPackageRepositoryRequest | PlainMessage<SuspendPackageRepositoryRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(SuspendPackageRepositoryRequest, a, b);
+  }
+}
+
+/**
+ * SuspendPackageRepositoryResponse
+ *
+ * Response for SuspendPackageRepository
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendPackageRepositoryResponse
+ */
+export class SuspendPackageRepositoryResponse extends Message<SuspendPackageRepositoryResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference package_repo_ref = 1;
+   */
+  packageRepoRef?: PackageRepositoryReference;
+
+  constructor(data?: PartialMessage<SuspendPackageRepositoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.SuspendPackageRepositoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_repo_ref", kind: "message", T: PackageRepositoryReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): SuspendPackageRepositoryResponse {
+    return new SuspendPackageRepositoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendPackageRepositoryResponse {
+    return new SuspendPackageRepositoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): SuspendPackageRepositoryResponse {
+    return new SuspendPackageRepositoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | SuspendPackageRepositoryResponse
+      | PlainMessage<SuspendPackageRepositoryResponse>
+      | undefined,
+    b:
+      | SuspendPackageRepositoryResponse
+      | PlainMessage<SuspendPackageRepositoryResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(SuspendPackageRepositoryResponse, a, b);
+  }
+}
+
+/**
+ * ResumePackageRepositoryRequest
+ *
+ * Request for ResumePackageRepository
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumePackageRepositoryRequest
+ */
+export class ResumePackageRepositoryRequest extends Message<ResumePackageRepositoryRequest> {
+  /**
+   * A reference uniquely identifying the package repository.
+   * Required
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference package_repo_ref = 1;
+   */
+  packageRepoRef?: PackageRepositoryReference;
+
+  constructor(data?: PartialMessage<ResumePackageRepositoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumePackageRepositoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_repo_ref", kind: "message", T: PackageRepositoryReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ResumePackageRepositoryRequest {
+    return new ResumePackageRepositoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ResumePackageRepositoryRequest {
+    return new ResumePackageRepositoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ResumePackageRepositoryRequest {
+    return new ResumePackageRepositoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ResumePackageRepositoryRequest | PlainMessage<ResumePackageRepositoryRequest> | undefined,
+    b: ResumePackageRepositoryRequest | PlainMessage<ResumePackageRepositoryRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(ResumePackageRepositoryRequest, a, b);
+  }
+}
+
+/**
+ * ResumePackageRepositoryResponse
+ *
+ * Response for ResumePackageRepository
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumePackageRepositoryResponse
+ */
+export class ResumePackageRepositoryResponse extends Message<ResumePackageRepositoryResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageRepositoryReference package_repo_ref = 1;
+   */
+  packageRepoRef?: PackageRepositoryReference;
+
+  constructor(data?: PartialMessage<ResumePackageRepositoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.ResumePackageRepositoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package_repo_ref", kind: "message", T: PackageRepositoryReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): ResumePackageRepositoryResponse {
+    return new ResumePackageRepositoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): ResumePackageRepositoryResponse {
+    return new ResumePackageRepositoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): ResumePackageRepositoryResponse {
+    return new ResumePackageRepositoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ResumePackageRepositoryResponse | PlainMessage<ResumePackageRepositoryResponse> | undefined,
+    b: ResumePackageRepositoryResponse | PlainMessage<ResumePackageRepositoryResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(ResumePackageRepositoryResponse, a, b);
+  }
+}
 
 /**
  * Flux PackageRepositoryCustomDetail
  *
- * Custom details for a Flux Package repository
+ * Custom details for a Flux Package repository
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxPackageRepositoryCustomDetail
+ */
+export class FluxPackageRepositoryCustomDetail extends Message<FluxPackageRepositoryCustomDetail> {
+  /**
+   * optional field that allows specifying an OIDC provider used for authentication purposes
+   * Supported options are:
+   *  - generic
+   *  - aws
+   *  - azure
+   *  - gcp
+   * The provider field is supported only for Helm OCI repositories, buckets and
+   * OCI repositories. The repository type must be set to "oci", "bucket" or
+   * "ocirepository"
+   * ref https://fluxcd.io/flux/components/source/helmrepositories/#provider
+   * ref https://fluxcd.io/flux/components/source/buckets/#provider
+   * ref https://fluxcd.io/flux/components/source/ocirepositories/#provider
+   *
+   * @generated from field: string provider = 1;
+   */
+  provider = "";
+
+  /**
+   * optional field with the git reference to checkout when the repository type
+   * is "git". Defaults to the "master" branch, same as flux
+   * ref https://fluxcd.io/flux/components/source/gitrepositories/#reference
+   *
+   * @generated from field: kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxGitRepositoryRef git_ref = 2;
+   */
+  gitRef?: FluxGitRepositoryRef;
+
+  /**
+   * name of the bucket, required when the repository type is "bucket"
+   * ref https://fluxcd.io/flux/components/source/buckets/#bucket-name
+   *
+   * @generated from field: string bucket_name = 3;
+   */
+  bucketName = "";
+
+  /**
+   * optional field with the region of the bucket when the repository type
+   * is "bucket"
+   * ref https://fluxcd.io/flux/components/source/buckets/#region
+   *
+   * @generated from field: string bucket_region = 4;
+   */
+  bucketRegion = "";
+
+  /**
+   * optional field with the reference of the chart artifact to pull when the
+   * repository type is "ocirepository". Defaults to the "latest" tag, same as flux
+   * ref https://fluxcd.io/flux/components/source/ocirepositories/#reference
+   *
+   * @generated from field: kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxOCIRepositoryRef oci_ref = 5;
+   */
+  ociRef?: FluxOCIRepositoryRef;
+
+  constructor(data?: PartialMessage<FluxPackageRepositoryCustomDetail>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxPackageRepositoryCustomDetail";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "git_ref", kind: "message", T: FluxGitRepositoryRef },
+    { no: 3, name: "bucket_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "bucket_region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "oci_ref", kind: "message", T: FluxOCIRepositoryRef },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): FluxPackageRepositoryCustomDetail {
+    return new FluxPackageRepositoryCustomDetail().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): FluxPackageRepositoryCustomDetail {
+    return new FluxPackageRepositoryCustomDetail().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxPackageRepositoryCustomDetail {
+    return new FluxPackageRepositoryCustomDetail().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | FluxPackageRepositoryCustomDetail
+      | PlainMessage<FluxPackageRepositoryCustomDetail>
+      | undefined,
+    b:
+      | FluxPackageRepositoryCustomDetail
+      | PlainMessage<FluxPackageRepositoryCustomDetail>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxPackageRepositoryCustomDetail, a, b);
+  }
+}
+
+/**
+ * Flux GitRepositoryRef
+ *
+ * The git reference of a Flux GitRepository. At most one of the fields
+ * should be set, with the same precedence as flux: commit, semver, tag, branch.
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxGitRepositoryRef
+ */
+export class FluxGitRepositoryRef extends Message<FluxGitRepositoryRef> {
+  /**
+   * @generated from field: string branch = 1;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: string tag = 2;
+   */
+  tag = "";
+
+  /**
+   * @generated from field: string semver = 3;
+   */
+  semver = "";
+
+  /**
+   * @generated from field: string commit = 4;
+   */
+  commit = "";
+
+  constructor(data?: PartialMessage<FluxGitRepositoryRef>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxGitRepositoryRef";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "semver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "commit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxGitRepositoryRef {
+    return new FluxGitRepositoryRef().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxGitRepositoryRef {
+    return new FluxGitRepositoryRef().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxGitRepositoryRef {
+    return new FluxGitRepositoryRef().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxGitRepositoryRef | PlainMessage<FluxGitRepositoryRef> | undefined,
+    b: FluxGitRepositoryRef | PlainMessage<FluxGitRepositoryRef> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxGitRepositoryRef, a, b);
+  }
+}
+
+/**
+ * Flux OCIRepositoryRef
+ *
+ * The reference of the artifact of a Flux OCIRepository. At most one of the
+ * fields should be set, with the same precedence as flux: digest, semver, tag.
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxOCIRepositoryRef
+ */
+export class FluxOCIRepositoryRef extends Message<FluxOCIRepositoryRef> {
+  /**
+   * @generated from field: string tag = 1;
+   */
+  tag = "";
+
+  /**
+   * @generated from field: string semver = 2;
+   */
+  semver = "";
+
+  /**
+   * @generated from field: string digest = 3;
+   */
+  digest = "";
+
+  constructor(data?: PartialMessage<FluxOCIRepositoryRef>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxOCIRepositoryRef";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "semver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "digest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxOCIRepositoryRef {
+    return new FluxOCIRepositoryRef().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxOCIRepositoryRef {
+    return new FluxOCIRepositoryRef().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxOCIRepositoryRef {
+    return new FluxOCIRepositoryRef().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxOCIRepositoryRef | PlainMessage<FluxOCIRepositoryRef> | undefined,
+    b: FluxOCIRepositoryRef | PlainMessage<FluxOCIRepositoryRef> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxOCIRepositoryRef, a, b);
+  }
+}
+
+/**
+ * Flux KustomizationCustomDetail
+ *
+ * Custom details for a Flux Kustomization installed package. When specified as
+ * the custom_detail of a CreateInstalledPackageRequest, a Kustomization is
+ * created instead of a HelmRelease, applying the manifests found at the given
+ * path of the GitRepository or Bucket named by the available package reference
+ * identifier. The identifiers of the Kustomization installed packages are
+ * prefixed with "kustomization_" to tell them apart from HelmReleases.
+ * ref https://fluxcd.io/flux/components/kustomize/kustomizations/
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxKustomizationCustomDetail
+ */
+export class FluxKustomizationCustomDetail extends Message<FluxKustomizationCustomDetail> {
+  /**
+   * path to the directory containing the kustomization.yaml file, or the set
+   * of plain manifests, relative to the root of the source artifact.
+   * Defaults to the root of the artifact
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * whether flux garbage collects the resources removed from the source
+   *
+   * @generated from field: bool prune = 2;
+   */
+  prune = false;
+
+  /**
+   * optional namespace overriding the namespace of all the resources
+   *
+   * @generated from field: string target_namespace = 3;
+   */
+  targetNamespace = "";
+
+  constructor(data?: PartialMessage<FluxKustomizationCustomDetail>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxKustomizationCustomDetail";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "prune", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "target_namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): FluxKustomizationCustomDetail {
+    return new FluxKustomizationCustomDetail().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): FluxKustomizationCustomDetail {
+    return new FluxKustomizationCustomDetail().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxKustomizationCustomDetail {
+    return new FluxKustomizationCustomDetail().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxKustomizationCustomDetail | PlainMessage<FluxKustomizationCustomDetail> | undefined,
+    b: FluxKustomizationCustomDetail | PlainMessage<FluxKustomizationCustomDetail> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxKustomizationCustomDetail, a, b);
+  }
+}
+
+/**
+ * Flux HelmReleaseCustomDetail
+ *
+ * Custom details for a Flux HelmRelease installed package, used to model the
+ * ordering of the releases and how flux handles their failures. It can be
+ * specified as the custom_detail of a Create or UpdateInstalledPackageRequest
+ * and is returned in the InstalledPackageDetail when any of the fields are set.
+ * On update, the fields replace the current ones only when the custom_detail
+ * is present.
+ * ref https://fluxcd.io/flux/components/helm/helmreleases/
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseCustomDetail
+ */
+export class FluxHelmReleaseCustomDetail extends Message<FluxHelmReleaseCustomDetail> {
+  /**
+   * HelmReleases that must be ready before this one is reconciled
+   * ref https://fluxcd.io/flux/components/helm/helmreleases/#dependencies
+   *
+   * @generated from field: repeated kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxDependencyReference depends_on = 1;
+   */
+  dependsOn: FluxDependencyReference[] = [];
+
+  /**
+   * optional configuration of the helm install action
+   *
+   * @generated from field: kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseInstall install = 2;
+   */
+  install?: FluxHelmReleaseInstall;
+
+  /**
+   * optional configuration of the helm upgrade action
+   *
+   * @generated from field: kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseUpgrade upgrade = 3;
+   */
+  upgrade?: FluxHelmReleaseUpgrade;
+
+  constructor(data?: PartialMessage<FluxHelmReleaseCustomDetail>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseCustomDetail";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "depends_on", kind: "message", T: FluxDependencyReference, repeated: true },
+    { no: 2, name: "install", kind: "message", T: FluxHelmReleaseInstall },
+    { no: 3, name: "upgrade", kind: "message", T: FluxHelmReleaseUpgrade },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): FluxHelmReleaseCustomDetail {
+    return new FluxHelmReleaseCustomDetail().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): FluxHelmReleaseCustomDetail {
+    return new FluxHelmReleaseCustomDetail().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxHelmReleaseCustomDetail {
+    return new FluxHelmReleaseCustomDetail().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxHelmReleaseCustomDetail | PlainMessage<FluxHelmReleaseCustomDetail> | undefined,
+    b: FluxHelmReleaseCustomDetail | PlainMessage<FluxHelmReleaseCustomDetail> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxHelmReleaseCustomDetail, a, b);
+  }
+}
+
+/**
+ * Flux DependencyReference
+ *
+ * A reference to a HelmRelease the installed package depends on.
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxDependencyReference
+ */
+export class FluxDependencyReference extends Message<FluxDependencyReference> {
+  /**
+   * name of the HelmRelease, required
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * optional namespace of the HelmRelease. Defaults to the namespace of the
+   * installed package
+   *
+   * @generated from field: string namespace = 2;
+   */
+  namespace = "";
+
+  constructor(data?: PartialMessage<FluxDependencyReference>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxDependencyReference";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): FluxDependencyReference {
+    return new FluxDependencyReference().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): FluxDependencyReference {
+    return new FluxDependencyReference().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxDependencyReference {
+    return new FluxDependencyReference().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxDependencyReference | PlainMessage<FluxDependencyReference> | undefined,
+    b: FluxDependencyReference | PlainMessage<FluxDependencyReference> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxDependencyReference, a, b);
+  }
+}
+
+/**
+ * Flux HelmReleaseInstall
+ *
+ * ref https://fluxcd.io/flux/components/helm/helmreleases/#install-configuration
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseInstall
+ */
+export class FluxHelmReleaseInstall extends Message<FluxHelmReleaseInstall> {
+  /**
+   * policy applied to the CRDs of the chart, one of "Skip", "Create" or
+   * "CreateReplace". Flux defaults to "Create" on install
+   *
+   * @generated from field: string crds = 1;
+   */
+  crds = "";
+
+  /**
+   * number of retries after a failed install. A negative value means
+   * unlimited retries. Defaults to 0, i.e. no retries
+   *
+   * @generated from field: int32 remediation_retries = 2;
+   */
+  remediationRetries = 0;
+
+  constructor(data?: PartialMessage<FluxHelmReleaseInstall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseInstall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "crds", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "remediation_retries", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): FluxHelmReleaseInstall {
+    return new FluxHelmReleaseInstall().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): FluxHelmReleaseInstall {
+    return new FluxHelmReleaseInstall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxHelmReleaseInstall {
+    return new FluxHelmReleaseInstall().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxHelmReleaseInstall | PlainMessage<FluxHelmReleaseInstall> | undefined,
+    b: FluxHelmReleaseInstall | PlainMessage<FluxHelmReleaseInstall> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxHelmReleaseInstall, a, b);
+  }
+}
+
+/**
+ * Flux HelmReleaseUpgrade
+ *
+ * ref https://fluxcd.io/flux/components/helm/helmreleases/#upgrade-configuration
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseUpgrade
+ */
+export class FluxHelmReleaseUpgrade extends Message<FluxHelmReleaseUpgrade> {
+  /**
+   * policy applied to the CRDs of the chart, one of "Skip", "Create" or
+   * "CreateReplace". Flux defaults to "Skip" on upgrade
+   *
+   * @generated from field: string crds = 1;
+   */
+  crds = "";
+
+  /**
+   * number of retries after a failed upgrade. A negative value means
+   * unlimited retries. Defaults to 0, i.e. no retries
+   *
+   * @generated from field: int32 remediation_retries = 2;
+   */
+  remediationRetries = 0;
+
+  /**
+   * whether the new resources created during a failed upgrade are deleted
+   *
+   * @generated from field: bool cleanup_on_fail = 3;
+   */
+  cleanupOnFail = false;
+
+  constructor(data?: PartialMessage<FluxHelmReleaseUpgrade>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxHelmReleaseUpgrade";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "crds", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "remediation_retries", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "cleanup_on_fail", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): FluxHelmReleaseUpgrade {
+    return new FluxHelmReleaseUpgrade().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): FluxHelmReleaseUpgrade {
+    return new FluxHelmReleaseUpgrade().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxHelmReleaseUpgrade {
+    return new FluxHelmReleaseUpgrade().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxHelmReleaseUpgrade | PlainMessage<FluxHelmReleaseUpgrade> | undefined,
+    b: FluxHelmReleaseUpgrade | PlainMessage<FluxHelmReleaseUpgrade> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxHelmReleaseUpgrade, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageHistoryRequest
+ *
+ * Request for GetInstalledPackageHistory
  *
- * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxPackageRepositoryCustomDetail
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.GetInstalledPackageHistoryRequest
  */
-export class FluxPackageRepositoryCustomDetail extends Message<FluxPackageRepositoryCustomDetail> {
+export class GetInstalledPackageHistoryRequest extends Message<GetInstalledPackageHistoryRequest> {
   /**
-   * optional field that allows specifying an OIDC provider used for authentication purposes
-   * Supported options are:
-   *  - generic
-   *  - aws
-   *  - azure
-   *  - gcp
-   * The provider field is supported only for Helm OCI repositories. The repository type must
-   * be set to "oci"
-   * ref https://fluxcd.io/flux/components/source/helmrepositories/#provider
+   * reference to the installed package, which must be a HelmRelease
    *
-   * @generated from field: string provider = 1;
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
    */
-  provider = "";
+  installedPackageRef?: InstalledPackageReference;
 
-  constructor(data?: PartialMessage<FluxPackageRepositoryCustomDetail>) {
+  constructor(data?: PartialMessage<GetInstalledPackageHistoryRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName =
-    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxPackageRepositoryCustomDetail";
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.GetInstalledPackageHistoryRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): FluxPackageRepositoryCustomDetail {
-    return new FluxPackageRepositoryCustomDetail().fromBinary(bytes, options);
+  ): GetInstalledPackageHistoryRequest {
+    return new GetInstalledPackageHistoryRequest().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): FluxPackageRepositoryCustomDetail {
-    return new FluxPackageRepositoryCustomDetail().fromJson(jsonValue, options);
+  ): GetInstalledPackageHistoryRequest {
+    return new GetInstalledPackageHistoryRequest().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): FluxPackageRepositoryCustomDetail {
-    return new FluxPackageRepositoryCustomDetail().fromJsonString(jsonString, options);
+  ): GetInstalledPackageHistoryRequest {
+    return new GetInstalledPackageHistoryRequest().fromJsonString(jsonString, options);
   }
 
   static equals(
     a:
-      | FluxPackageRepositoryCustomDetail
-      | PlainMessage<FluxPackageRepositoryCustomDetail>
+      | GetInstalledPackageHistoryRequest
+      | PlainMessage<GetInstalledPackageHistoryRequest>
       | undefined,
     b:
-      | FluxPackageRepositoryCustomDetail
-      | PlainMessage<FluxPackageRepositoryCustomDetail>
+      | GetInstalledPackageHistoryRequest
+      | PlainMessage<GetInstalledPackageHistoryRequest>
       | undefined,
   ): boolean {
-    return proto3.util.equals(FluxPackageRepositoryCustomDetail, a, b);
+    return proto3.util.equals(GetInstalledPackageHistoryRequest, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageHistoryResponse
+ *
+ * Response for GetInstalledPackageHistory
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.GetInstalledPackageHistoryResponse
+ */
+export class GetInstalledPackageHistoryResponse extends Message<GetInstalledPackageHistoryResponse> {
+  /**
+   * the helm releases performed by flux up to the last successful one, most
+   * recent first
+   * ref https://fluxcd.io/flux/components/helm/helmreleases/#history
+   *
+   * @generated from field: repeated kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxReleaseSnapshot history = 1;
+   */
+  history: FluxReleaseSnapshot[] = [];
+
+  /**
+   * the current conditions of the HelmRelease, whose reasons and messages
+   * explain the outcome of the last reconciliation
+   *
+   * @generated from field: repeated kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxCondition conditions = 2;
+   */
+  conditions: FluxCondition[] = [];
+
+  /**
+   * the helm action of the last reconciliation, "install" or "upgrade"
+   *
+   * @generated from field: string last_attempted_release_action = 3;
+   */
+  lastAttemptedReleaseAction = "";
+
+  /**
+   * the chart version of the last reconciliation
+   *
+   * @generated from field: string last_attempted_revision = 4;
+   */
+  lastAttemptedRevision = "";
+
+  /**
+   * the number of failed reconciliations since the last success
+   *
+   * @generated from field: int64 failures = 5;
+   */
+  failures = protoInt64.zero;
+
+  /**
+   * the number of failed installs since the last success
+   *
+   * @generated from field: int64 install_failures = 6;
+   */
+  installFailures = protoInt64.zero;
+
+  /**
+   * the number of failed upgrades since the last success
+   *
+   * @generated from field: int64 upgrade_failures = 7;
+   */
+  upgradeFailures = protoInt64.zero;
+
+  /**
+   * the kubernetes events of the HelmRelease and of its HelmChart, most
+   * recent first
+   *
+   * @generated from field: repeated kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxEvent events = 8;
+   */
+  events: FluxEvent[] = [];
+
+  constructor(data?: PartialMessage<GetInstalledPackageHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.fluxv2.packages.v1alpha1.GetInstalledPackageHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "history", kind: "message", T: FluxReleaseSnapshot, repeated: true },
+    { no: 2, name: "conditions", kind: "message", T: FluxCondition, repeated: true },
+    { no: 3, name: "last_attempted_release_action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "last_attempted_revision", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "failures", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "install_failures", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "upgrade_failures", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "events", kind: "message", T: FluxEvent, repeated: true },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetInstalledPackageHistoryResponse {
+    return new GetInstalledPackageHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageHistoryResponse {
+    return new GetInstalledPackageHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageHistoryResponse {
+    return new GetInstalledPackageHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetInstalledPackageHistoryResponse
+      | PlainMessage<GetInstalledPackageHistoryResponse>
+      | undefined,
+    b:
+      | GetInstalledPackageHistoryResponse
+      | PlainMessage<GetInstalledPackageHistoryResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetInstalledPackageHistoryResponse, a, b);
+  }
+}
+
+/**
+ * Flux ReleaseSnapshot
+ *
+ * A helm release performed by flux for a HelmRelease
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxReleaseSnapshot
+ */
+export class FluxReleaseSnapshot extends Message<FluxReleaseSnapshot> {
+  /**
+   * the helm release revision
+   *
+   * @generated from field: int32 version = 1;
+   */
+  version = 0;
+
+  /**
+   * the helm release status, e.g. "deployed", "failed" or "superseded"
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string chart_name = 3;
+   */
+  chartName = "";
+
+  /**
+   * @generated from field: string chart_version = 4;
+   */
+  chartVersion = "";
+
+  /**
+   * digest of the values of the release
+   *
+   * @generated from field: string config_digest = 5;
+   */
+  configDigest = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp first_deployed = 6;
+   */
+  firstDeployed?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_deployed = 7;
+   */
+  lastDeployed?: Timestamp;
+
+  /**
+   * only set when the release has been uninstalled
+   *
+   * @generated from field: google.protobuf.Timestamp deleted = 8;
+   */
+  deleted?: Timestamp;
+
+  constructor(data?: PartialMessage<FluxReleaseSnapshot>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxReleaseSnapshot";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "chart_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "chart_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "config_digest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "first_deployed", kind: "message", T: Timestamp },
+    { no: 7, name: "last_deployed", kind: "message", T: Timestamp },
+    { no: 8, name: "deleted", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxReleaseSnapshot {
+    return new FluxReleaseSnapshot().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxReleaseSnapshot {
+    return new FluxReleaseSnapshot().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): FluxReleaseSnapshot {
+    return new FluxReleaseSnapshot().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxReleaseSnapshot | PlainMessage<FluxReleaseSnapshot> | undefined,
+    b: FluxReleaseSnapshot | PlainMessage<FluxReleaseSnapshot> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxReleaseSnapshot, a, b);
+  }
+}
+
+/**
+ * Flux Condition
+ *
+ * A status condition of a flux object
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxCondition
+ */
+export class FluxCondition extends Message<FluxCondition> {
+  /**
+   * e.g. "Ready", "Released" or "Remediated"
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * one of "True", "False" or "Unknown"
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_transition_time = 5;
+   */
+  lastTransitionTime?: Timestamp;
+
+  constructor(data?: PartialMessage<FluxCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "last_transition_time", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxCondition {
+    return new FluxCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxCondition {
+    return new FluxCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxCondition {
+    return new FluxCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxCondition | PlainMessage<FluxCondition> | undefined,
+    b: FluxCondition | PlainMessage<FluxCondition> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxCondition, a, b);
+  }
+}
+
+/**
+ * Flux Event
+ *
+ * A kubernetes event related to an installed package
+ *
+ * @generated from message kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxEvent
+ */
+export class FluxEvent extends Message<FluxEvent> {
+  /**
+   * "Normal" or "Warning"
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  /**
+   * the kind of the object the event is about, e.g. "HelmRelease"
+   *
+   * @generated from field: string involved_object_kind = 4;
+   */
+  involvedObjectKind = "";
+
+  /**
+   * @generated from field: string involved_object_name = 5;
+   */
+  involvedObjectName = "";
+
+  /**
+   * the controller that emitted the event
+   *
+   * @generated from field: string source = 6;
+   */
+  source = "";
+
+  /**
+   * the number of times the event has occurred
+   *
+   * @generated from field: int32 count = 7;
+   */
+  count = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp first_timestamp = 8;
+   */
+  firstTimestamp?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_timestamp = 9;
+   */
+  lastTimestamp?: Timestamp;
+
+  constructor(data?: PartialMessage<FluxEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "involved_object_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "involved_object_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "first_timestamp", kind: "message", T: Timestamp },
+    { no: 9, name: "last_timestamp", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxEvent {
+    return new FluxEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxEvent {
+    return new FluxEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxEvent {
+    return new FluxEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: FluxEvent | PlainMessage<FluxEvent> | undefined,
+    b: FluxEvent | PlainMessage<FluxEvent> | undefined,
+  ): boolean {
+    return proto3.util.equals(FluxEvent, a, b);
   }
 }
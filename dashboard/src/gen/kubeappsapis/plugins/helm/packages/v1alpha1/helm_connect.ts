@@ -27,7 +27,22 @@ import {
   UpdateInstalledPackageResponse,
 } from "../../../../core/packages/v1alpha1/packages_pb";
 import { MethodKind } from "@bufbuild/protobuf";
-import { RollbackInstalledPackageRequest, RollbackInstalledPackageResponse } from "./helm_pb";
+import {
+  AdoptInstalledPackageRequest,
+  AdoptInstalledPackageResponse,
+  GetInstalledPackageDriftRequest,
+  GetInstalledPackageDriftResponse,
+  GetInstalledPackageRevisionsDiffRequest,
+  GetInstalledPackageRevisionsDiffResponse,
+  GetInstalledPackageRevisionsRequest,
+  GetInstalledPackageRevisionsResponse,
+  InstallPackageFromTarballRequest,
+  InstallPackageFromTarballResponse,
+  RollbackInstalledPackageRequest,
+  RollbackInstalledPackageResponse,
+  RunInstalledPackageTestsRequest,
+  RunInstalledPackageTestsResponse,
+} from "./helm_pb";
 import {
   AddPackageRepositoryRequest,
   AddPackageRepositoryResponse,
@@ -148,6 +163,83 @@ export const HelmPackagesService = {
       O: RollbackInstalledPackageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * AdoptInstalledPackage associates an installed package, such as a Helm release
+     * installed outside Kubeapps, with the available package from which it was installed,
+     * so that it can be updated using the versions of that available package.
+     *
+     * @generated from rpc kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.AdoptInstalledPackage
+     */
+    adoptInstalledPackage: {
+      name: "AdoptInstalledPackage",
+      I: AdoptInstalledPackageRequest,
+      O: AdoptInstalledPackageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RunInstalledPackageTests runs the tests of an installed package (the Helm
+     * release test hooks) one at a time, streaming the status of each test as it
+     * starts and completes, together with the logs of its pod.
+     *
+     * @generated from rpc kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.RunInstalledPackageTests
+     */
+    runInstalledPackageTests: {
+      name: "RunInstalledPackageTests",
+      I: RunInstalledPackageTestsRequest,
+      O: RunInstalledPackageTestsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * InstallPackageFromTarball installs a chart archive uploaded with the request,
+     * such as a chart under development, or upgrades the installed package with the
+     * same name in the target context if it exists. The installed package is reported
+     * as locally sourced, without an available package.
+     *
+     * @generated from rpc kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.InstallPackageFromTarball
+     */
+    installPackageFromTarball: {
+      name: "InstallPackageFromTarball",
+      I: InstallPackageFromTarballRequest,
+      O: InstallPackageFromTarballResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetInstalledPackageRevisions returns the revisions (the Helm release history)
+     * of an installed package, from the most recent one.
+     *
+     * @generated from rpc kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageRevisions
+     */
+    getInstalledPackageRevisions: {
+      name: "GetInstalledPackageRevisions",
+      I: GetInstalledPackageRevisionsRequest,
+      O: GetInstalledPackageRevisionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetInstalledPackageRevisionsDiff returns the differences, in both the user-supplied
+     * values and the rendered manifests, between two revisions of an installed package.
+     *
+     * @generated from rpc kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageRevisionsDiff
+     */
+    getInstalledPackageRevisionsDiff: {
+      name: "GetInstalledPackageRevisionsDiff",
+      I: GetInstalledPackageRevisionsDiffRequest,
+      O: GetInstalledPackageRevisionsDiffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetInstalledPackageDrift returns the differences between the resources of the
+     * current revision of an installed package and their live state in the cluster,
+     * such as hand-edited fields, optionally re-applying the revision to repair them.
+     *
+     * @generated from rpc kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService.GetInstalledPackageDrift
+     */
+    getInstalledPackageDrift: {
+      name: "GetInstalledPackageDrift",
+      I: GetInstalledPackageDriftRequest,
+      O: GetInstalledPackageDriftResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
      * an installed package.
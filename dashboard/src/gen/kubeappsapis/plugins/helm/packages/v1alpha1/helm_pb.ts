@@ -14,79 +14,1627 @@ import type {
   PartialMessage,
   PlainMessage,
 } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
-import { InstalledPackageReference } from "../../../../core/packages/v1alpha1/packages_pb";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import {
+  AvailablePackageReference,
+  Context,
+  InstalledPackageReference,
+  InstalledPackageStatus,
+  PackageAppVersion,
+  ResourceRef,
+} from "../../../../core/packages/v1alpha1/packages_pb";
 import { DockerCredentials } from "../../../../core/packages/v1alpha1/repositories_pb";
 
+/**
+ * DiffChangeType
+ *
+ * The kind of change found when comparing two revisions.
+ *
+ * @generated from enum kubeappsapis.plugins.helm.packages.v1alpha1.DiffChangeType
+ */
+export enum DiffChangeType {
+  /**
+   * @generated from enum value: DIFF_CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DIFF_CHANGE_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: DIFF_CHANGE_TYPE_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * @generated from enum value: DIFF_CHANGE_TYPE_CHANGED = 3;
+   */
+  CHANGED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DiffChangeType)
+proto3.util.setEnumType(
+  DiffChangeType,
+  "kubeappsapis.plugins.helm.packages.v1alpha1.DiffChangeType",
+  [
+    { no: 0, name: "DIFF_CHANGE_TYPE_UNSPECIFIED" },
+    { no: 1, name: "DIFF_CHANGE_TYPE_ADDED" },
+    { no: 2, name: "DIFF_CHANGE_TYPE_REMOVED" },
+    { no: 3, name: "DIFF_CHANGE_TYPE_CHANGED" },
+  ],
+);
+
 /**
  * InstalledPackageDetailCustomDataHelm
  *
- * InstalledPackageDetailCustomDataHelm is a message type used for the
- * InstalledPackageDetail.CustomDetail field by the helm plugin.
+ * InstalledPackageDetailCustomDataHelm is a message type used for the
+ * InstalledPackageDetail.CustomDetail field by the helm plugin.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageDetailCustomDataHelm
+ */
+export class InstalledPackageDetailCustomDataHelm extends Message<InstalledPackageDetailCustomDataHelm> {
+  /**
+   * ReleaseRevision
+   *
+   * A number identifying the Helm revision
+   *
+   * @generated from field: int32 release_revision = 2;
+   */
+  releaseRevision = 0;
+
+  /**
+   * LastTestResults
+   *
+   * The results of the last run of each test of the release, if any, without
+   * their logs.
+   *
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageTestResult last_test_results = 3;
+   */
+  lastTestResults: InstalledPackageTestResult[] = [];
+
+  /**
+   * LocallySourced
+   *
+   * Whether the release has been installed or upgraded from an uploaded chart
+   * archive, in which case it is only associated with an available package
+   * once adopted.
+   *
+   * @generated from field: bool locally_sourced = 4;
+   */
+  locallySourced = false;
+
+  constructor(data?: PartialMessage<InstalledPackageDetailCustomDataHelm>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageDetailCustomDataHelm";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 2, name: "release_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    {
+      no: 3,
+      name: "last_test_results",
+      kind: "message",
+      T: InstalledPackageTestResult,
+      repeated: true,
+    },
+    { no: 4, name: "locally_sourced", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): InstalledPackageDetailCustomDataHelm {
+    return new InstalledPackageDetailCustomDataHelm().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageDetailCustomDataHelm {
+    return new InstalledPackageDetailCustomDataHelm().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageDetailCustomDataHelm {
+    return new InstalledPackageDetailCustomDataHelm().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | InstalledPackageDetailCustomDataHelm
+      | PlainMessage<InstalledPackageDetailCustomDataHelm>
+      | undefined,
+    b:
+      | InstalledPackageDetailCustomDataHelm
+      | PlainMessage<InstalledPackageDetailCustomDataHelm>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(InstalledPackageDetailCustomDataHelm, a, b);
+  }
+}
+
+/**
+ * InstalledPackageRequestCustomDataHelm
+ *
+ * InstalledPackageRequestCustomDataHelm is a message type used for the
+ * CreateInstalledPackageRequest.CustomDetail and UpdateInstalledPackageRequest.CustomDetail
+ * fields by the helm plugin.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageRequestCustomDataHelm
+ */
+export class InstalledPackageRequestCustomDataHelm extends Message<InstalledPackageRequestCustomDataHelm> {
+  /**
+   * Wait
+   *
+   * Whether to wait until the resources of the release are ready, up to the
+   * configured timeout, before the request is considered successful.
+   *
+   * @generated from field: bool wait = 1;
+   */
+  wait = false;
+
+  /**
+   * WaitForJobs
+   *
+   * Whether to also wait until the jobs of the release are completed. Implies wait.
+   *
+   * @generated from field: bool wait_for_jobs = 2;
+   */
+  waitForJobs = false;
+
+  /**
+   * Atomic
+   *
+   * Whether to roll back an upgrade which fails or does not become ready before
+   * the timeout. Implies wait. A failed installation is always uninstalled.
+   *
+   * @generated from field: bool atomic = 3;
+   */
+  atomic = false;
+
+  /**
+   * OCI registry secret
+   *
+   * The name of a docker-config secret, in the namespace of the available
+   * package, with the credentials for the registry of a package installed from
+   * a raw OCI reference (an identifier like `oci://registry/repo/chart:version`)
+   * rather than from an AppRepository. It is stored with the installed package
+   * so that later updates can use it too.
+   *
+   * @generated from field: string oci_registry_secret = 4;
+   */
+  ociRegistrySecret = "";
+
+  /**
+   * Values from
+   *
+   * References to keys of Secrets and ConfigMaps, in the namespace of the
+   * installed package, holding values for the release, so that sensitive values
+   * like passwords do not need to be sent inline. They are read with the
+   * credentials of the user and merged, in order, over the default values of the
+   * chart, the inline values taking precedence over them.
+   * The references, but not their values, are stored with the installed package
+   * so that an update which does not set them keeps using them.
+   *
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.ValuesReference values_from = 5;
+   */
+  valuesFrom: ValuesReference[] = [];
+
+  /**
+   * Clear values from
+   *
+   * Whether an update stops using the references stored with the installed
+   * package when values_from is empty.
+   *
+   * @generated from field: bool clear_values_from = 6;
+   */
+  clearValuesFrom = false;
+
+  constructor(data?: PartialMessage<InstalledPackageRequestCustomDataHelm>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageRequestCustomDataHelm";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "wait", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "wait_for_jobs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "atomic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "oci_registry_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "values_from", kind: "message", T: ValuesReference, repeated: true },
+    { no: 6, name: "clear_values_from", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): InstalledPackageRequestCustomDataHelm {
+    return new InstalledPackageRequestCustomDataHelm().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageRequestCustomDataHelm {
+    return new InstalledPackageRequestCustomDataHelm().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageRequestCustomDataHelm {
+    return new InstalledPackageRequestCustomDataHelm().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | InstalledPackageRequestCustomDataHelm
+      | PlainMessage<InstalledPackageRequestCustomDataHelm>
+      | undefined,
+    b:
+      | InstalledPackageRequestCustomDataHelm
+      | PlainMessage<InstalledPackageRequestCustomDataHelm>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(InstalledPackageRequestCustomDataHelm, a, b);
+  }
+}
+
+/**
+ * ValuesReference
+ *
+ * A reference to the key of a Secret or ConfigMap holding values for a release.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.ValuesReference
+ */
+export class ValuesReference extends Message<ValuesReference> {
+  /**
+   * Kind
+   *
+   * The kind of the referenced object, either `Secret` or `ConfigMap`.
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * Name
+   *
+   * The name of the referenced object, in the namespace of the installed package.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Values key
+   *
+   * The key of the referenced object holding the values. Defaults to `values.yaml`.
+   *
+   * @generated from field: string values_key = 3;
+   */
+  valuesKey = "";
+
+  /**
+   * Target path
+   *
+   * An optional dot-separated path, like `postgresql.auth.password`, at which the
+   * content of the key is set as a string. If not set, the content of the key is
+   * parsed as a YAML document of values.
+   *
+   * @generated from field: string target_path = 4;
+   */
+  targetPath = "";
+
+  /**
+   * Optional
+   *
+   * Whether a missing object or key is ignored rather than failing the request.
+   *
+   * @generated from field: bool optional = 5;
+   */
+  optional = false;
+
+  constructor(data?: PartialMessage<ValuesReference>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.helm.packages.v1alpha1.ValuesReference";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "values_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "optional", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValuesReference {
+    return new ValuesReference().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValuesReference {
+    return new ValuesReference().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValuesReference {
+    return new ValuesReference().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ValuesReference | PlainMessage<ValuesReference> | undefined,
+    b: ValuesReference | PlainMessage<ValuesReference> | undefined,
+  ): boolean {
+    return proto3.util.equals(ValuesReference, a, b);
+  }
+}
+
+/**
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageRequest
+ */
+export class RollbackInstalledPackageRequest extends Message<RollbackInstalledPackageRequest> {
+  /**
+   * Installed package reference
+   *
+   * A reference uniquely identifying the installed package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * ReleaseRevision
+   *
+   * A number identifying the Helm revision to which to rollback.
+   *
+   * @generated from field: int32 release_revision = 2;
+   */
+  releaseRevision = 0;
+
+  constructor(data?: PartialMessage<RollbackInstalledPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "release_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): RollbackInstalledPackageRequest {
+    return new RollbackInstalledPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): RollbackInstalledPackageRequest {
+    return new RollbackInstalledPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): RollbackInstalledPackageRequest {
+    return new RollbackInstalledPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: RollbackInstalledPackageRequest | PlainMessage<RollbackInstalledPackageRequest> | undefined,
+    b: RollbackInstalledPackageRequest | PlainMessage<RollbackInstalledPackageRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(RollbackInstalledPackageRequest, a, b);
+  }
+}
+
+/**
+ * RollbackInstalledPackageResponse
+ *
+ * Response for RollbackInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageResponse
+ */
+export class RollbackInstalledPackageResponse extends Message<RollbackInstalledPackageResponse> {
+  /**
+   * TODO: add example for API docs
+   * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
+   *   example: '{"installed_package_ref": {}}'
+   * };
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<RollbackInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): RollbackInstalledPackageResponse {
+    return new RollbackInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): RollbackInstalledPackageResponse {
+    return new RollbackInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): RollbackInstalledPackageResponse {
+    return new RollbackInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | RollbackInstalledPackageResponse
+      | PlainMessage<RollbackInstalledPackageResponse>
+      | undefined,
+    b:
+      | RollbackInstalledPackageResponse
+      | PlainMessage<RollbackInstalledPackageResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(RollbackInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * AdoptInstalledPackageRequest
+ *
+ * Request for AdoptInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.AdoptInstalledPackageRequest
+ */
+export class AdoptInstalledPackageRequest extends Message<AdoptInstalledPackageRequest> {
+  /**
+   * Installed package reference
+   *
+   * A reference uniquely identifying the installed package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * Available package reference
+   *
+   * A reference to the available package (repository and chart) from which
+   * the installed package was installed. The association is persisted with
+   * the release and takes precedence over the chart matching the release.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference available_package_ref = 2;
+   */
+  availablePackageRef?: AvailablePackageReference;
+
+  constructor(data?: PartialMessage<AdoptInstalledPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.AdoptInstalledPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "available_package_ref", kind: "message", T: AvailablePackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): AdoptInstalledPackageRequest {
+    return new AdoptInstalledPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): AdoptInstalledPackageRequest {
+    return new AdoptInstalledPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): AdoptInstalledPackageRequest {
+    return new AdoptInstalledPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: AdoptInstalledPackageRequest | PlainMessage<AdoptInstalledPackageRequest> | undefined,
+    b: AdoptInstalledPackageRequest | PlainMessage<AdoptInstalledPackageRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(AdoptInstalledPackageRequest, a, b);
+  }
+}
+
+/**
+ * AdoptInstalledPackageResponse
+ *
+ * Response for AdoptInstalledPackage
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.AdoptInstalledPackageResponse
+ */
+export class AdoptInstalledPackageResponse extends Message<AdoptInstalledPackageResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  constructor(data?: PartialMessage<AdoptInstalledPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.AdoptInstalledPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): AdoptInstalledPackageResponse {
+    return new AdoptInstalledPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): AdoptInstalledPackageResponse {
+    return new AdoptInstalledPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): AdoptInstalledPackageResponse {
+    return new AdoptInstalledPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: AdoptInstalledPackageResponse | PlainMessage<AdoptInstalledPackageResponse> | undefined,
+    b: AdoptInstalledPackageResponse | PlainMessage<AdoptInstalledPackageResponse> | undefined,
+  ): boolean {
+    return proto3.util.equals(AdoptInstalledPackageResponse, a, b);
+  }
+}
+
+/**
+ * InstallPackageFromTarballRequest
+ *
+ * Request for InstallPackageFromTarball
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.InstallPackageFromTarballRequest
+ */
+export class InstallPackageFromTarballRequest extends Message<InstallPackageFromTarballRequest> {
+  /**
+   * Target context
+   *
+   * The cluster and namespace in which to install or upgrade the package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.Context target_context = 1;
+   */
+  targetContext?: Context;
+
+  /**
+   * Name
+   *
+   * The name of the installed package.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Chart tarball
+   *
+   * The gzipped archive of the chart, as created by `helm package`.
+   *
+   * @generated from field: bytes chart_tarball = 3;
+   */
+  chartTarball = new Uint8Array(0);
+
+  /**
+   * Values
+   *
+   * The values, in yaml or json, with which to install the chart.
+   *
+   * @generated from field: string values = 4;
+   */
+  values = "";
+
+  constructor(data?: PartialMessage<InstallPackageFromTarballRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.InstallPackageFromTarballRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "target_context", kind: "message", T: Context },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "chart_tarball", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): InstallPackageFromTarballRequest {
+    return new InstallPackageFromTarballRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): InstallPackageFromTarballRequest {
+    return new InstallPackageFromTarballRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): InstallPackageFromTarballRequest {
+    return new InstallPackageFromTarballRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | InstallPackageFromTarballRequest
+      | PlainMessage<InstallPackageFromTarballRequest>
+      | undefined,
+    b:
+      | InstallPackageFromTarballRequest
+      | PlainMessage<InstallPackageFromTarballRequest>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(InstallPackageFromTarballRequest, a, b);
+  }
+}
+
+/**
+ * InstallPackageFromTarballResponse
+ *
+ * Response for InstallPackageFromTarball
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.InstallPackageFromTarballResponse
+ */
+export class InstallPackageFromTarballResponse extends Message<InstallPackageFromTarballResponse> {
+  /**
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * Upgraded
+   *
+   * Whether an existing installed package was upgraded rather than installed.
+   *
+   * @generated from field: bool upgraded = 2;
+   */
+  upgraded = false;
+
+  constructor(data?: PartialMessage<InstallPackageFromTarballResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.InstallPackageFromTarballResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "upgraded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): InstallPackageFromTarballResponse {
+    return new InstallPackageFromTarballResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): InstallPackageFromTarballResponse {
+    return new InstallPackageFromTarballResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): InstallPackageFromTarballResponse {
+    return new InstallPackageFromTarballResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | InstallPackageFromTarballResponse
+      | PlainMessage<InstallPackageFromTarballResponse>
+      | undefined,
+    b:
+      | InstallPackageFromTarballResponse
+      | PlainMessage<InstallPackageFromTarballResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(InstallPackageFromTarballResponse, a, b);
+  }
+}
+
+/**
+ * RunInstalledPackageTestsRequest
+ *
+ * Request for RunInstalledPackageTests
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.RunInstalledPackageTestsRequest
+ */
+export class RunInstalledPackageTestsRequest extends Message<RunInstalledPackageTestsRequest> {
+  /**
+   * Installed package reference
+   *
+   * A reference uniquely identifying the installed package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * TestNames
+   *
+   * The names of the tests to run. Optional, all the tests are run by default.
+   *
+   * @generated from field: repeated string test_names = 2;
+   */
+  testNames: string[] = [];
+
+  constructor(data?: PartialMessage<RunInstalledPackageTestsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.RunInstalledPackageTestsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "test_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): RunInstalledPackageTestsRequest {
+    return new RunInstalledPackageTestsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): RunInstalledPackageTestsRequest {
+    return new RunInstalledPackageTestsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): RunInstalledPackageTestsRequest {
+    return new RunInstalledPackageTestsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: RunInstalledPackageTestsRequest | PlainMessage<RunInstalledPackageTestsRequest> | undefined,
+    b: RunInstalledPackageTestsRequest | PlainMessage<RunInstalledPackageTestsRequest> | undefined,
+  ): boolean {
+    return proto3.util.equals(RunInstalledPackageTestsRequest, a, b);
+  }
+}
+
+/**
+ * RunInstalledPackageTestsResponse
+ *
+ * Response for RunInstalledPackageTests. A response is streamed when each
+ * test starts and when it completes.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.RunInstalledPackageTestsResponse
+ */
+export class RunInstalledPackageTestsResponse extends Message<RunInstalledPackageTestsResponse> {
+  /**
+   * TestResult
+   *
+   * The current result of one of the tests.
+   *
+   * @generated from field: kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageTestResult test_result = 1;
+   */
+  testResult?: InstalledPackageTestResult;
+
+  constructor(data?: PartialMessage<RunInstalledPackageTestsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.RunInstalledPackageTestsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_result", kind: "message", T: InstalledPackageTestResult },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): RunInstalledPackageTestsResponse {
+    return new RunInstalledPackageTestsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): RunInstalledPackageTestsResponse {
+    return new RunInstalledPackageTestsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): RunInstalledPackageTestsResponse {
+    return new RunInstalledPackageTestsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | RunInstalledPackageTestsResponse
+      | PlainMessage<RunInstalledPackageTestsResponse>
+      | undefined,
+    b:
+      | RunInstalledPackageTestsResponse
+      | PlainMessage<RunInstalledPackageTestsResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(RunInstalledPackageTestsResponse, a, b);
+  }
+}
+
+/**
+ * InstalledPackageTestResult
+ *
+ * The result of a test (a Helm release test hook) of an installed package.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageTestResult
+ */
+export class InstalledPackageTestResult extends Message<InstalledPackageTestResult> {
+  /**
+   * Name
+   *
+   * The name of the test, which is also the name of its pod.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Phase
+   *
+   * The phase of the test.
+   *
+   * @generated from field: kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageTestResult.Phase phase = 2;
+   */
+  phase = InstalledPackageTestResult_Phase.UNSPECIFIED;
+
+  /**
+   * StartedAt
+   *
+   * When the test was started.
+   *
+   * @generated from field: google.protobuf.Timestamp started_at = 3;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * CompletedAt
+   *
+   * When the test was completed, if so.
+   *
+   * @generated from field: google.protobuf.Timestamp completed_at = 4;
+   */
+  completedAt?: Timestamp;
+
+  /**
+   * Logs
+   *
+   * The logs of the pod of the test, once completed.
+   *
+   * @generated from field: string logs = 5;
+   */
+  logs = "";
+
+  /**
+   * ErrorMessage
+   *
+   * The reason for which the test failed, if known.
+   *
+   * @generated from field: string error_message = 6;
+   */
+  errorMessage = "";
+
+  constructor(data?: PartialMessage<InstalledPackageTestResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageTestResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "phase", kind: "enum", T: proto3.getEnumType(InstalledPackageTestResult_Phase) },
+    { no: 3, name: "started_at", kind: "message", T: Timestamp },
+    { no: 4, name: "completed_at", kind: "message", T: Timestamp },
+    { no: 5, name: "logs", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): InstalledPackageTestResult {
+    return new InstalledPackageTestResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageTestResult {
+    return new InstalledPackageTestResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageTestResult {
+    return new InstalledPackageTestResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: InstalledPackageTestResult | PlainMessage<InstalledPackageTestResult> | undefined,
+    b: InstalledPackageTestResult | PlainMessage<InstalledPackageTestResult> | undefined,
+  ): boolean {
+    return proto3.util.equals(InstalledPackageTestResult, a, b);
+  }
+}
+
+/**
+ * Phase
+ *
+ * The phase of a test.
+ *
+ * @generated from enum kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageTestResult.Phase
+ */
+export enum InstalledPackageTestResult_Phase {
+  /**
+   * @generated from enum value: PHASE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PHASE_RUNNING = 1;
+   */
+  RUNNING = 1,
+
+  /**
+   * @generated from enum value: PHASE_SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * @generated from enum value: PHASE_FAILED = 3;
+   */
+  FAILED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(InstalledPackageTestResult_Phase)
+proto3.util.setEnumType(
+  InstalledPackageTestResult_Phase,
+  "kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageTestResult.Phase",
+  [
+    { no: 0, name: "PHASE_UNSPECIFIED" },
+    { no: 1, name: "PHASE_RUNNING" },
+    { no: 2, name: "PHASE_SUCCEEDED" },
+    { no: 3, name: "PHASE_FAILED" },
+  ],
+);
+
+/**
+ * GetInstalledPackageRevisionsRequest
+ *
+ * Request for GetInstalledPackageRevisions
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsRequest
+ */
+export class GetInstalledPackageRevisionsRequest extends Message<GetInstalledPackageRevisionsRequest> {
+  /**
+   * Installed package reference
+   *
+   * A reference uniquely identifying the installed package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * MaxRevisions
+   *
+   * An optional maximum number of revisions to return, starting from the
+   * most recent one. All revisions are returned when unset.
+   *
+   * @generated from field: int32 max_revisions = 2;
+   */
+  maxRevisions = 0;
+
+  constructor(data?: PartialMessage<GetInstalledPackageRevisionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "max_revisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetInstalledPackageRevisionsRequest {
+    return new GetInstalledPackageRevisionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageRevisionsRequest {
+    return new GetInstalledPackageRevisionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageRevisionsRequest {
+    return new GetInstalledPackageRevisionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetInstalledPackageRevisionsRequest
+      | PlainMessage<GetInstalledPackageRevisionsRequest>
+      | undefined,
+    b:
+      | GetInstalledPackageRevisionsRequest
+      | PlainMessage<GetInstalledPackageRevisionsRequest>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetInstalledPackageRevisionsRequest, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageRevisionsResponse
+ *
+ * Response for GetInstalledPackageRevisions
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsResponse
+ */
+export class GetInstalledPackageRevisionsResponse extends Message<GetInstalledPackageRevisionsResponse> {
+  /**
+   * Revisions
+   *
+   * The revisions of the installed package, from the most recent one.
+   *
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageRevision revisions = 1;
+   */
+  revisions: InstalledPackageRevision[] = [];
+
+  constructor(data?: PartialMessage<GetInstalledPackageRevisionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revisions", kind: "message", T: InstalledPackageRevision, repeated: true },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetInstalledPackageRevisionsResponse {
+    return new GetInstalledPackageRevisionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageRevisionsResponse {
+    return new GetInstalledPackageRevisionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageRevisionsResponse {
+    return new GetInstalledPackageRevisionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetInstalledPackageRevisionsResponse
+      | PlainMessage<GetInstalledPackageRevisionsResponse>
+      | undefined,
+    b:
+      | GetInstalledPackageRevisionsResponse
+      | PlainMessage<GetInstalledPackageRevisionsResponse>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetInstalledPackageRevisionsResponse, a, b);
+  }
+}
+
+/**
+ * InstalledPackageRevision
+ *
+ * A single revision of an installed package, as stored by Helm.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageRevision
+ */
+export class InstalledPackageRevision extends Message<InstalledPackageRevision> {
+  /**
+   * ReleaseRevision
+   *
+   * A number identifying the Helm revision, which can be used to rollback.
+   *
+   * @generated from field: int32 release_revision = 1;
+   */
+  releaseRevision = 0;
+
+  /**
+   * Version
+   *
+   * The package and app versions deployed with this revision.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.PackageAppVersion version = 2;
+   */
+  version?: PackageAppVersion;
+
+  /**
+   * Status
+   *
+   * The status of the installed package when at this revision.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus status = 3;
+   */
+  status?: InstalledPackageStatus;
+
+  /**
+   * LastDeployed
+   *
+   * When this revision was deployed.
+   *
+   * @generated from field: google.protobuf.Timestamp last_deployed = 4;
+   */
+  lastDeployed?: Timestamp;
+
+  /**
+   * Description
+   *
+   * The Helm description of the revision, such as "Upgrade complete".
+   *
+   * @generated from field: string description = 5;
+   */
+  description = "";
+
+  /**
+   * ValuesApplied
+   *
+   * The values supplied by the user for this revision.
+   *
+   * @generated from field: string values_applied = 6;
+   */
+  valuesApplied = "";
+
+  constructor(data?: PartialMessage<InstalledPackageRevision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageRevision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "release_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "version", kind: "message", T: PackageAppVersion },
+    { no: 3, name: "status", kind: "message", T: InstalledPackageStatus },
+    { no: 4, name: "last_deployed", kind: "message", T: Timestamp },
+    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "values_applied", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): InstalledPackageRevision {
+    return new InstalledPackageRevision().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageRevision {
+    return new InstalledPackageRevision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): InstalledPackageRevision {
+    return new InstalledPackageRevision().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: InstalledPackageRevision | PlainMessage<InstalledPackageRevision> | undefined,
+    b: InstalledPackageRevision | PlainMessage<InstalledPackageRevision> | undefined,
+  ): boolean {
+    return proto3.util.equals(InstalledPackageRevision, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageRevisionsDiffRequest
+ *
+ * Request for GetInstalledPackageRevisionsDiff
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsDiffRequest
+ */
+export class GetInstalledPackageRevisionsDiffRequest extends Message<GetInstalledPackageRevisionsDiffRequest> {
+  /**
+   * Installed package reference
+   *
+   * A reference uniquely identifying the installed package.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   */
+  installedPackageRef?: InstalledPackageReference;
+
+  /**
+   * FromRevision
+   *
+   * The Helm revision used as the base of the comparison. Required.
+   *
+   * @generated from field: int32 from_revision = 2;
+   */
+  fromRevision = 0;
+
+  /**
+   * ToRevision
+   *
+   * The Helm revision compared with the base one. The current revision
+   * is used when unset.
+   *
+   * @generated from field: int32 to_revision = 3;
+   */
+  toRevision = 0;
+
+  constructor(data?: PartialMessage<GetInstalledPackageRevisionsDiffRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName =
+    "kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsDiffRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 2, name: "from_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "to_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(
+    bytes: Uint8Array,
+    options?: Partial<BinaryReadOptions>,
+  ): GetInstalledPackageRevisionsDiffRequest {
+    return new GetInstalledPackageRevisionsDiffRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(
+    jsonValue: JsonValue,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageRevisionsDiffRequest {
+    return new GetInstalledPackageRevisionsDiffRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(
+    jsonString: string,
+    options?: Partial<JsonReadOptions>,
+  ): GetInstalledPackageRevisionsDiffRequest {
+    return new GetInstalledPackageRevisionsDiffRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a:
+      | GetInstalledPackageRevisionsDiffRequest
+      | PlainMessage<GetInstalledPackageRevisionsDiffRequest>
+      | undefined,
+    b:
+      | GetInstalledPackageRevisionsDiffRequest
+      | PlainMessage<GetInstalledPackageRevisionsDiffRequest>
+      | undefined,
+  ): boolean {
+    return proto3.util.equals(GetInstalledPackageRevisionsDiffRequest, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageRevisionsDiffResponse
+ *
+ * Response for GetInstalledPackageRevisionsDiff
  *
- * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageDetailCustomDataHelm
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsDiffResponse
  */
-export class InstalledPackageDetailCustomDataHelm extends Message<InstalledPackageDetailCustomDataHelm> {
+export class GetInstalledPackageRevisionsDiffResponse extends Message<GetInstalledPackageRevisionsDiffResponse> {
   /**
-   * ReleaseRevision
+   * ValuesDiff
    *
-   * A number identifying the Helm revision
+   * The differences between the user-supplied values of both revisions.
    *
-   * @generated from field: int32 release_revision = 2;
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.RevisionDiffEntry values_diff = 1;
    */
-  releaseRevision = 0;
+  valuesDiff: RevisionDiffEntry[] = [];
 
-  constructor(data?: PartialMessage<InstalledPackageDetailCustomDataHelm>) {
+  /**
+   * ManifestDiff
+   *
+   * The resources of the rendered manifests which differ between both revisions.
+   *
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.ResourceDiff manifest_diff = 2;
+   */
+  manifestDiff: ResourceDiff[] = [];
+
+  constructor(data?: PartialMessage<GetInstalledPackageRevisionsDiffResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName =
-    "kubeappsapis.plugins.helm.packages.v1alpha1.InstalledPackageDetailCustomDataHelm";
+    "kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageRevisionsDiffResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 2, name: "release_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "values_diff", kind: "message", T: RevisionDiffEntry, repeated: true },
+    { no: 2, name: "manifest_diff", kind: "message", T: ResourceDiff, repeated: true },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): InstalledPackageDetailCustomDataHelm {
-    return new InstalledPackageDetailCustomDataHelm().fromBinary(bytes, options);
+  ): GetInstalledPackageRevisionsDiffResponse {
+    return new GetInstalledPackageRevisionsDiffResponse().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): InstalledPackageDetailCustomDataHelm {
-    return new InstalledPackageDetailCustomDataHelm().fromJson(jsonValue, options);
+  ): GetInstalledPackageRevisionsDiffResponse {
+    return new GetInstalledPackageRevisionsDiffResponse().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): InstalledPackageDetailCustomDataHelm {
-    return new InstalledPackageDetailCustomDataHelm().fromJsonString(jsonString, options);
+  ): GetInstalledPackageRevisionsDiffResponse {
+    return new GetInstalledPackageRevisionsDiffResponse().fromJsonString(jsonString, options);
   }
 
   static equals(
     a:
-      | InstalledPackageDetailCustomDataHelm
-      | PlainMessage<InstalledPackageDetailCustomDataHelm>
+      | GetInstalledPackageRevisionsDiffResponse
+      | PlainMessage<GetInstalledPackageRevisionsDiffResponse>
       | undefined,
     b:
-      | InstalledPackageDetailCustomDataHelm
-      | PlainMessage<InstalledPackageDetailCustomDataHelm>
+      | GetInstalledPackageRevisionsDiffResponse
+      | PlainMessage<GetInstalledPackageRevisionsDiffResponse>
       | undefined,
   ): boolean {
-    return proto3.util.equals(InstalledPackageDetailCustomDataHelm, a, b);
+    return proto3.util.equals(GetInstalledPackageRevisionsDiffResponse, a, b);
   }
 }
 
 /**
- * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageRequest
+ * RevisionDiffEntry
+ *
+ * A single difference, at a given YAML path, between two revisions.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.RevisionDiffEntry
  */
-export class RollbackInstalledPackageRequest extends Message<RollbackInstalledPackageRequest> {
+export class RevisionDiffEntry extends Message<RevisionDiffEntry> {
+  /**
+   * Path
+   *
+   * The YAML path of the difference, such as "image.tag" or "spec.ports[0].port".
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * Change
+   *
+   * Whether the path was added, removed or changed in the compared revision.
+   *
+   * @generated from field: kubeappsapis.plugins.helm.packages.v1alpha1.DiffChangeType change = 2;
+   */
+  change = DiffChangeType.UNSPECIFIED;
+
+  /**
+   * FromValue
+   *
+   * The JSON-encoded value in the base revision, unset when added.
+   *
+   * @generated from field: string from_value = 3;
+   */
+  fromValue = "";
+
+  /**
+   * ToValue
+   *
+   * The JSON-encoded value in the compared revision, unset when removed.
+   *
+   * @generated from field: string to_value = 4;
+   */
+  toValue = "";
+
+  constructor(data?: PartialMessage<RevisionDiffEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.helm.packages.v1alpha1.RevisionDiffEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "change", kind: "enum", T: proto3.getEnumType(DiffChangeType) },
+    { no: 3, name: "from_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevisionDiffEntry {
+    return new RevisionDiffEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevisionDiffEntry {
+    return new RevisionDiffEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevisionDiffEntry {
+    return new RevisionDiffEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: RevisionDiffEntry | PlainMessage<RevisionDiffEntry> | undefined,
+    b: RevisionDiffEntry | PlainMessage<RevisionDiffEntry> | undefined,
+  ): boolean {
+    return proto3.util.equals(RevisionDiffEntry, a, b);
+  }
+}
+
+/**
+ * ResourceDiff
+ *
+ * The differences of a single resource of the rendered manifests.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.ResourceDiff
+ */
+export class ResourceDiff extends Message<ResourceDiff> {
+  /**
+   * ResourceRef
+   *
+   * The reference of the resource which differs.
+   *
+   * @generated from field: kubeappsapis.core.packages.v1alpha1.ResourceRef resource_ref = 1;
+   */
+  resourceRef?: ResourceRef;
+
+  /**
+   * Change
+   *
+   * Whether the resource was added, removed or changed in the compared revision.
+   *
+   * @generated from field: kubeappsapis.plugins.helm.packages.v1alpha1.DiffChangeType change = 2;
+   */
+  change = DiffChangeType.UNSPECIFIED;
+
+  /**
+   * Entries
+   *
+   * The differences within the resource, only present when it was changed.
+   *
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.RevisionDiffEntry entries = 3;
+   */
+  entries: RevisionDiffEntry[] = [];
+
+  constructor(data?: PartialMessage<ResourceDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.helm.packages.v1alpha1.ResourceDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource_ref", kind: "message", T: ResourceRef },
+    { no: 2, name: "change", kind: "enum", T: proto3.getEnumType(DiffChangeType) },
+    { no: 3, name: "entries", kind: "message", T: RevisionDiffEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceDiff {
+    return new ResourceDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceDiff {
+    return new ResourceDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceDiff {
+    return new ResourceDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ResourceDiff | PlainMessage<ResourceDiff> | undefined,
+    b: ResourceDiff | PlainMessage<ResourceDiff> | undefined,
+  ): boolean {
+    return proto3.util.equals(ResourceDiff, a, b);
+  }
+}
+
+/**
+ * GetInstalledPackageDriftRequest
+ *
+ * Request for GetInstalledPackageDrift
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageDriftRequest
+ */
+export class GetInstalledPackageDriftRequest extends Message<GetInstalledPackageDriftRequest> {
   /**
    * Installed package reference
    *
@@ -97,118 +1645,134 @@ export class RollbackInstalledPackageRequest extends Message<RollbackInstalledPa
   installedPackageRef?: InstalledPackageReference;
 
   /**
-   * ReleaseRevision
+   * Repair
    *
-   * A number identifying the Helm revision to which to rollback.
+   * Whether to re-apply the current revision when drift is found, reverting the
+   * live changes of the fields set in its manifest and recreating the missing
+   * resources. The repair creates a new revision, as a rollback does.
    *
-   * @generated from field: int32 release_revision = 2;
+   * @generated from field: bool repair = 2;
    */
-  releaseRevision = 0;
+  repair = false;
 
-  constructor(data?: PartialMessage<RollbackInstalledPackageRequest>) {
+  constructor(data?: PartialMessage<GetInstalledPackageDriftRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName =
-    "kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageRequest";
+    "kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageDriftRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
-    { no: 2, name: "release_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "repair", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): RollbackInstalledPackageRequest {
-    return new RollbackInstalledPackageRequest().fromBinary(bytes, options);
+  ): GetInstalledPackageDriftRequest {
+    return new GetInstalledPackageDriftRequest().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): RollbackInstalledPackageRequest {
-    return new RollbackInstalledPackageRequest().fromJson(jsonValue, options);
+  ): GetInstalledPackageDriftRequest {
+    return new GetInstalledPackageDriftRequest().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): RollbackInstalledPackageRequest {
-    return new RollbackInstalledPackageRequest().fromJsonString(jsonString, options);
+  ): GetInstalledPackageDriftRequest {
+    return new GetInstalledPackageDriftRequest().fromJsonString(jsonString, options);
   }
 
   static equals(
-    a: RollbackInstalledPackageRequest | PlainMessage<RollbackInstalledPackageRequest> | undefined,
-    b: RollbackInstalledPackageRequest | PlainMessage<RollbackInstalledPackageRequest> | undefined,
+    a: GetInstalledPackageDriftRequest | PlainMessage<GetInstalledPackageDriftRequest> | undefined,
+    b: GetInstalledPackageDriftRequest | PlainMessage<GetInstalledPackageDriftRequest> | undefined,
   ): boolean {
-    return proto3.util.equals(RollbackInstalledPackageRequest, a, b);
+    return proto3.util.equals(GetInstalledPackageDriftRequest, a, b);
   }
 }
 
 /**
- * RollbackInstalledPackageResponse
+ * GetInstalledPackageDriftResponse
  *
- * Response for RollbackInstalledPackage
+ * Response for GetInstalledPackageDrift
  *
- * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageResponse
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageDriftResponse
  */
-export class RollbackInstalledPackageResponse extends Message<RollbackInstalledPackageResponse> {
+export class GetInstalledPackageDriftResponse extends Message<GetInstalledPackageDriftResponse> {
   /**
-   * TODO: add example for API docs
-   * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
-   *   example: '{"installed_package_ref": {}}'
-   * };
+   * ResourcesDrift
    *
-   * @generated from field: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference installed_package_ref = 1;
+   * The resources whose live state differs from the manifest of the current
+   * revision: changed when fields of the manifest differ, removed when the
+   * resource no longer exists. The entries compare the manifest (from) with
+   * the live state (to). Fields not set in the manifest, such as the status or
+   * the fields defaulted by the cluster, are ignored and the data of Secrets is
+   * redacted.
+   *
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.ResourceDiff resources_drift = 1;
    */
-  installedPackageRef?: InstalledPackageReference;
+  resourcesDrift: ResourceDiff[] = [];
 
-  constructor(data?: PartialMessage<RollbackInstalledPackageResponse>) {
+  /**
+   * RepairedRevision
+   *
+   * The revision created by the repair, unset when not repaired.
+   *
+   * @generated from field: int32 repaired_revision = 2;
+   */
+  repairedRevision = 0;
+
+  constructor(data?: PartialMessage<GetInstalledPackageDriftResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName =
-    "kubeappsapis.plugins.helm.packages.v1alpha1.RollbackInstalledPackageResponse";
+    "kubeappsapis.plugins.helm.packages.v1alpha1.GetInstalledPackageDriftResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "installed_package_ref", kind: "message", T: InstalledPackageReference },
+    { no: 1, name: "resources_drift", kind: "message", T: ResourceDiff, repeated: true },
+    { no: 2, name: "repaired_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(
     bytes: Uint8Array,
     options?: Partial<BinaryReadOptions>,
-  ): RollbackInstalledPackageResponse {
-    return new RollbackInstalledPackageResponse().fromBinary(bytes, options);
+  ): GetInstalledPackageDriftResponse {
+    return new GetInstalledPackageDriftResponse().fromBinary(bytes, options);
   }
 
   static fromJson(
     jsonValue: JsonValue,
     options?: Partial<JsonReadOptions>,
-  ): RollbackInstalledPackageResponse {
-    return new RollbackInstalledPackageResponse().fromJson(jsonValue, options);
+  ): GetInstalledPackageDriftResponse {
+    return new GetInstalledPackageDriftResponse().fromJson(jsonValue, options);
   }
 
   static fromJsonString(
     jsonString: string,
     options?: Partial<JsonReadOptions>,
-  ): RollbackInstalledPackageResponse {
-    return new RollbackInstalledPackageResponse().fromJsonString(jsonString, options);
+  ): GetInstalledPackageDriftResponse {
+    return new GetInstalledPackageDriftResponse().fromJsonString(jsonString, options);
   }
 
   static equals(
     a:
-      | RollbackInstalledPackageResponse
-      | PlainMessage<RollbackInstalledPackageResponse>
+      | GetInstalledPackageDriftResponse
+      | PlainMessage<GetInstalledPackageDriftResponse>
       | undefined,
     b:
-      | RollbackInstalledPackageResponse
-      | PlainMessage<RollbackInstalledPackageResponse>
+      | GetInstalledPackageDriftResponse
+      | PlainMessage<GetInstalledPackageDriftResponse>
       | undefined,
   ): boolean {
-    return proto3.util.equals(RollbackInstalledPackageResponse, a, b);
+    return proto3.util.equals(GetInstalledPackageDriftResponse, a, b);
   }
 }
 
@@ -240,6 +1804,15 @@ export class ImagesPullSecret extends Message<ImagesPullSecret> {
       }
     | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * The name of the secret created by Kubeapps for the docker credentials, as
+   * returned for an existing repository. When updating the repository, it
+   * identifies the secret from which redacted credentials are kept.
+   *
+   * @generated from field: string secret_name = 3;
+   */
+  secretName = "";
+
   constructor(data?: PartialMessage<ImagesPullSecret>) {
     super();
     proto3.util.initPartial(data, this);
@@ -262,6 +1835,7 @@ export class ImagesPullSecret extends Message<ImagesPullSecret> {
       T: DockerCredentials,
       oneof: "docker_registry_credential_one_of",
     },
+    { no: 3, name: "secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImagesPullSecret {
@@ -293,7 +1867,8 @@ export class ImagesPullSecret extends Message<ImagesPullSecret> {
  */
 export class HelmPackageRepositoryCustomDetail extends Message<HelmPackageRepositoryCustomDetail> {
   /**
-   * docker registry credentials for pull secrets
+   * docker registry credentials for pull secrets.
+   * Deprecated: use images_pull_secrets, of which it is the first item.
    *
    * @generated from field: kubeappsapis.plugins.helm.packages.v1alpha1.ImagesPullSecret images_pull_secret = 1;
    */
@@ -348,6 +1923,21 @@ export class HelmPackageRepositoryCustomDetail extends Message<HelmPackageReposi
    */
   securityContext?: PodSecurityContext;
 
+  /**
+   * verification of the charts of the repository before they are installed
+   *
+   * @generated from field: kubeappsapis.plugins.helm.packages.v1alpha1.ChartVerification chart_verification = 9;
+   */
+  chartVerification?: ChartVerification;
+
+  /**
+   * docker registry credentials for pull secrets, one per registry, used
+   * instead of images_pull_secret when set
+   *
+   * @generated from field: repeated kubeappsapis.plugins.helm.packages.v1alpha1.ImagesPullSecret images_pull_secrets = 10;
+   */
+  imagesPullSecrets: ImagesPullSecret[] = [];
+
   constructor(data?: PartialMessage<HelmPackageRepositoryCustomDetail>) {
     super();
     proto3.util.initPartial(data, this);
@@ -377,6 +1967,8 @@ export class HelmPackageRepositoryCustomDetail extends Message<HelmPackageReposi
     },
     { no: 7, name: "tolerations", kind: "message", T: Toleration, repeated: true },
     { no: 8, name: "security_context", kind: "message", T: PodSecurityContext },
+    { no: 9, name: "chart_verification", kind: "message", T: ChartVerification },
+    { no: 10, name: "images_pull_secrets", kind: "message", T: ImagesPullSecret, repeated: true },
   ]);
 
   static fromBinary(
@@ -414,6 +2006,111 @@ export class HelmPackageRepositoryCustomDetail extends Message<HelmPackageReposi
   }
 }
 
+/**
+ * ChartVerification
+ *
+ * Verification of the charts of a repository before they are installed or
+ * upgraded: against their Helm provenance files, using a GPG keyring, for a
+ * Helm repository, or against their cosign signatures, using a PEM public
+ * key, for an OCI registry.
+ *
+ * @generated from message kubeappsapis.plugins.helm.packages.v1alpha1.ChartVerification
+ */
+export class ChartVerification extends Message<ChartVerification> {
+  /**
+   * the verification policy
+   *
+   * @generated from field: kubeappsapis.plugins.helm.packages.v1alpha1.ChartVerification.Policy policy = 1;
+   */
+  policy = ChartVerification_Policy.UNSPECIFIED;
+
+  /**
+   * name of a secret in the namespace of the repository with the public keys
+   *
+   * @generated from field: string secret_ref = 2;
+   */
+  secretRef = "";
+
+  /**
+   * key of the secret with the public keys
+   *
+   * @generated from field: string secret_key = 3;
+   */
+  secretKey = "";
+
+  constructor(data?: PartialMessage<ChartVerification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kubeappsapis.plugins.helm.packages.v1alpha1.ChartVerification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "enum", T: proto3.getEnumType(ChartVerification_Policy) },
+    { no: 2, name: "secret_ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "secret_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartVerification {
+    return new ChartVerification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChartVerification {
+    return new ChartVerification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChartVerification {
+    return new ChartVerification().fromJsonString(jsonString, options);
+  }
+
+  static equals(
+    a: ChartVerification | PlainMessage<ChartVerification> | undefined,
+    b: ChartVerification | PlainMessage<ChartVerification> | undefined,
+  ): boolean {
+    return proto3.util.equals(ChartVerification, a, b);
+  }
+}
+
+/**
+ * Policy
+ *
+ * What to do with a chart failing the verification.
+ *
+ * @generated from enum kubeappsapis.plugins.helm.packages.v1alpha1.ChartVerification.Policy
+ */
+export enum ChartVerification_Policy {
+  /**
+   * Same as POLICY_ENFORCE.
+   *
+   * @generated from enum value: POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Refuse to install or upgrade the chart.
+   *
+   * @generated from enum value: POLICY_ENFORCE = 1;
+   */
+  ENFORCE = 1,
+
+  /**
+   * Only log a warning.
+   *
+   * @generated from enum value: POLICY_WARN = 2;
+   */
+  WARN = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ChartVerification_Policy)
+proto3.util.setEnumType(
+  ChartVerification_Policy,
+  "kubeappsapis.plugins.helm.packages.v1alpha1.ChartVerification.Policy",
+  [
+    { no: 0, name: "POLICY_UNSPECIFIED" },
+    { no: 1, name: "POLICY_ENFORCE" },
+    { no: 2, name: "POLICY_WARN" },
+  ],
+);
+
 /**
  * RepositoryFilterRule
  *
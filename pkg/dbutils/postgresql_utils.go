@@ -55,15 +55,23 @@ type PostgresAssetManager struct {
 
 // NewPGManager creates an asset manager for PG
 func NewPGManager(config Config, globalPackagingNamespace string) (*PostgresAssetManager, error) {
+	connStr, err := PostgresConnectionString(config)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresAssetManager{connStr, nil, globalPackagingNamespace}, nil
+}
+
+// PostgresConnectionString returns the connection string of the PG database of the config
+func PostgresConnectionString(config Config) (string, error) {
 	url := strings.Split(config.URL, ":")
 	if len(url) != 2 {
-		return nil, fmt.Errorf("can't parse database URL: %s", config.URL)
+		return "", fmt.Errorf("can't parse database URL: %s", config.URL)
 	}
-	connStr := fmt.Sprintf(
+	return fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		url[0], url[1], config.Username, config.Password, config.Database,
-	)
-	return &PostgresAssetManager{connStr, nil, globalPackagingNamespace}, nil
+	), nil
 }
 
 // Init connects to PG
@@ -23,6 +23,22 @@ func Test_NewPGManager(t *testing.T) {
 	}
 }
 
+func Test_PostgresConnectionString(t *testing.T) {
+	config := Config{URL: "10.11.12.13:5432", Database: "assets", Username: "postgres", Password: "123"}
+	connStr, err := PostgresConnectionString(config)
+	if err != nil {
+		t.Errorf("Found error %v", err)
+	}
+	expectedConnStr := "host=10.11.12.13 port=5432 user=postgres password=123 dbname=assets sslmode=disable"
+	if connStr != expectedConnStr {
+		t.Errorf("Expected %s got %s", expectedConnStr, connStr)
+	}
+
+	if _, err := PostgresConnectionString(Config{URL: "10.11.12.13"}); err == nil {
+		t.Errorf("Expected an error for a URL without port")
+	}
+}
+
 func Test_Close(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+
+	"github.com/vmware-tanzu/kubeapps/pkg/helm"
 )
 
 // OCIPuller implements the ChartPuller interface
@@ -14,6 +16,7 @@ type OCIPuller struct {
 	ExpectedName string
 	Content      map[string]*bytes.Buffer
 	Checksum     string
+	Signatures   []helm.Signature
 	Err          error
 }
 
@@ -26,3 +29,8 @@ func (f *OCIPuller) PullOCIChart(ociFullName string) (*bytes.Buffer, string, err
 	}
 	return f.Content[tag], f.Checksum, f.Err
 }
+
+// PullOCISignatures returns the fake signatures
+func (f *OCIPuller) PullOCISignatures(ociFullName, manifestDigest string) ([]helm.Signature, error) {
+	return f.Signatures, f.Err
+}
@@ -6,6 +6,7 @@ package helm
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"strings"
@@ -24,6 +25,7 @@ type (
 	// ChartPuller interface to pull a chart from an OCI registry
 	ChartPuller interface {
 		PullOCIChart(ociFullName string) (*bytes.Buffer, string, error)
+		PullOCISignatures(ociFullName, manifestDigest string) ([]Signature, error)
 	}
 
 	// OCIPuller implements ChartPuller
@@ -107,6 +109,57 @@ func (p *OCIPuller) PullOCIChart(ref string) (*bytes.Buffer, string, error) {
 	return bytes.NewBuffer(chartData), manifest.Digest.String(), nil
 }
 
+const (
+	// cosignSignatureMediaType is the media type of the layers storing the
+	// payloads signed by cosign.
+	cosignSignatureMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// cosignSignatureAnnotation is the annotation of a cosign signature layer
+	// with the base64 encoded signature of its payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+// PullOCISignatures returns the cosign signatures of the manifest with the given
+// digest in the repository of the reference. Cosign stores the signatures as the
+// layers of an artifact tagged after the digest of the signed manifest.
+func (p *OCIPuller) PullOCISignatures(ref, manifestDigest string) ([]Signature, error) {
+	parsedRef, err := parseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+	parsedRef.Reference = strings.Replace(manifestDigest, ":", "-", 1) + ".sig"
+
+	memoryStore := content.NewMemory()
+	registryStore := content.Registry{Resolver: p.Resolver}
+	var layers []ocispec.Descriptor
+	context := orascontext.WithLoggerFromWriter(context.Background(), os.Stdout)
+	_, err = oras.Copy(context, registryStore, parsedRef.String(), memoryStore, "",
+		oras.WithPullEmptyNameAllowed(),
+		oras.WithAllowedMediaTypes([]string{cosignSignatureMediaType}),
+		oras.WithLayerDescriptors(func(l []ocispec.Descriptor) {
+			layers = l
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to pull the signatures %s: %w", parsedRef.String(), err)
+	}
+
+	signatures := []Signature{}
+	for _, layer := range layers {
+		if layer.MediaType != cosignSignatureMediaType {
+			continue
+		}
+		_, payload, ok := memoryStore.Get(layer)
+		if !ok {
+			return nil, fmt.Errorf("unable to retrieve blob with digest %s", layer.Digest)
+		}
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature in the layer %s: %w", layer.Digest, err)
+		}
+		signatures = append(signatures, Signature{Payload: payload, Signature: signature})
+	}
+	return signatures, nil
+}
+
 // Code from Helm Registry Client. Copied here since it belonged to a internal package.
 // TODO(agamez): Some adaptations on the Kubeapps/Helm side are still required to be fully able to
 // use the Helm code a library instead
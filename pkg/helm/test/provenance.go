@@ -0,0 +1,19 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package test
+
+import (
+	_ "embed"
+)
+
+// A chart signed with the test key of Helm, along with its provenance file and
+// the keyring with the public key, to test the verification of charts.
+var (
+	//go:embed testdata/hashtest-1.2.3.tgz
+	ProvenanceChart []byte
+	//go:embed testdata/hashtest-1.2.3.tgz.prov
+	ProvenanceFile []byte
+	//go:embed testdata/helm-test-key.pub
+	ProvenanceKeyring []byte
+)
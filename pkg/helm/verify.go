@@ -0,0 +1,137 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/provenance"
+)
+
+// ErrChartVerificationFailed is returned when a chart fails its verification.
+var ErrChartVerificationFailed = errors.New("chart verification failed")
+
+// Signature is a cosign signature of an OCI artifact: a payload identifying
+// the signed manifest and the signature of that payload.
+type Signature struct {
+	Payload   []byte
+	Signature []byte
+}
+
+// cosignPayload is the part of the simple signing payload of cosign
+// identifying the signed manifest.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifyProvenance verifies a chart tarball against its Helm provenance file,
+// which must be signed by a key of the binary GPG keyring, as used by
+// `helm verify`, and include the digest of the tarball for the given file name.
+func VerifyProvenance(chartData []byte, chartFileName string, provenanceData []byte, keyring []byte) error {
+	// the helm signatory only verifies files, so these are written to a
+	// temporary directory
+	dir, err := os.MkdirTemp("", "kubeapps-provenance-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	chartFileName = filepath.Base(chartFileName)
+	keyringPath := filepath.Join(dir, "keyring.gpg")
+	chartPath := filepath.Join(dir, chartFileName)
+	provenancePath := chartPath + ".prov"
+	for path, data := range map[string][]byte{keyringPath: keyring, chartPath: chartData, provenancePath: provenanceData} {
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			return err
+		}
+	}
+
+	signatory, err := provenance.NewFromKeyring(keyringPath, "")
+	if err != nil {
+		return fmt.Errorf("unable to read the keyring: %w", err)
+	}
+	if _, err := signatory.Verify(chartPath, provenancePath); err != nil {
+		return fmt.Errorf("%w: unable to verify %q against its provenance file: %v", ErrChartVerificationFailed, chartFileName, err)
+	}
+	return nil
+}
+
+// VerifyCosignSignatures verifies that at least one of the cosign signatures
+// is a valid signature, for the PEM public key, of a payload identifying the
+// manifest with the given digest.
+func VerifyCosignSignatures(signatures []Signature, manifestDigest string, publicKeyPEM []byte) error {
+	publicKey, err := parsePublicKey(publicKeyPEM)
+	if err != nil {
+		return fmt.Errorf("unable to read the public key: %w", err)
+	}
+	if len(signatures) == 0 {
+		return fmt.Errorf("%w: no signature found for %q", ErrChartVerificationFailed, manifestDigest)
+	}
+
+	errs := []string{}
+	for _, s := range signatures {
+		if err := verifySignature(publicKey, s.Payload, s.Signature); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		payload := &cosignPayload{}
+		if err := json.Unmarshal(s.Payload, payload); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid signature payload: %v", err))
+			continue
+		}
+		if signed := payload.Critical.Image.DockerManifestDigest; signed != manifestDigest {
+			errs = append(errs, fmt.Sprintf("the signature is for %q", signed))
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: no valid signature found for %q: %s", ErrChartVerificationFailed, manifestDigest, strings.Join(errs, "; "))
+}
+
+// parsePublicKey parses a PEM encoded public key, as generated by cosign.
+func parsePublicKey(publicKeyPEM []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// verifySignature verifies the signature of a payload with a public key.
+func verifySignature(publicKey crypto.PublicKey, payload, signature []byte) error {
+	digest := sha256.Sum256(payload)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("invalid RSA signature: %v", err)
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, payload, signature) {
+			return fmt.Errorf("invalid ED25519 signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestVerifyProvenance(t *testing.T) {
+	chartData, err := os.ReadFile("test/testdata/hashtest-1.2.3.tgz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	provenanceData, err := os.ReadFile("test/testdata/hashtest-1.2.3.tgz.prov")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyring, err := os.ReadFile("test/testdata/helm-test-key.pub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKeyring, err := os.ReadFile("testdata/valid-index.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name              string
+		chartData         []byte
+		chartFileName     string
+		provenanceData    []byte
+		keyring           []byte
+		expectedErr       bool
+		expectedFailedErr bool
+	}{
+		{
+			name:           "it verifies a chart signed by a key of the keyring",
+			chartData:      chartData,
+			chartFileName:  "hashtest-1.2.3.tgz",
+			provenanceData: provenanceData,
+			keyring:        keyring,
+		},
+		{
+			name:              "it fails for a tampered chart",
+			chartData:         append([]byte{0}, chartData...),
+			chartFileName:     "hashtest-1.2.3.tgz",
+			provenanceData:    provenanceData,
+			keyring:           keyring,
+			expectedErr:       true,
+			expectedFailedErr: true,
+		},
+		{
+			name:              "it fails for a chart file missing from the provenance file",
+			chartData:         chartData,
+			chartFileName:     "other-1.2.3.tgz",
+			provenanceData:    provenanceData,
+			keyring:           keyring,
+			expectedErr:       true,
+			expectedFailedErr: true,
+		},
+		{
+			name:              "it fails for a provenance file without signature",
+			chartData:         chartData,
+			chartFileName:     "hashtest-1.2.3.tgz",
+			provenanceData:    []byte("files:\n  hashtest-1.2.3.tgz: sha256:0000\n"),
+			keyring:           keyring,
+			expectedErr:       true,
+			expectedFailedErr: true,
+		},
+		{
+			name:           "it returns an error for an invalid keyring",
+			chartData:      chartData,
+			chartFileName:  "hashtest-1.2.3.tgz",
+			provenanceData: provenanceData,
+			keyring:        otherKeyring,
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyProvenance(tc.chartData, tc.chartFileName, tc.provenanceData, tc.keyring)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := errors.Is(err, ErrChartVerificationFailed), tc.expectedFailedErr; got != want {
+				t.Errorf("got: %t, want: %t, err: %+v", got, want, err)
+			}
+		})
+	}
+}
+
+func TestVerifyCosignSignatures(t *testing.T) {
+	const manifestDigest = "sha256:0123456789abcdef"
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})
+
+	sign := func(payload string) Signature {
+		digest := sha256.Sum256([]byte(payload))
+		signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Signature{Payload: []byte(payload), Signature: signature}
+	}
+	payloadFor := func(digest string) string {
+		return `{"critical":{"identity":{"docker-reference":"registry/chart"},"image":{"docker-manifest-digest":"` + digest + `"},"type":"cosign container image signature"},"optional":null}`
+	}
+	tampered := sign(payloadFor(manifestDigest))
+	tampered.Payload = []byte(payloadFor("sha256:other"))
+
+	testCases := []struct {
+		name              string
+		signatures        []Signature
+		publicKey         []byte
+		expectedErr       bool
+		expectedFailedErr bool
+	}{
+		{
+			name:       "it verifies a valid signature of the manifest",
+			signatures: []Signature{sign(payloadFor("sha256:other")), sign(payloadFor(manifestDigest))},
+			publicKey:  publicKeyPEM,
+		},
+		{
+			name:              "it fails without signatures",
+			publicKey:         publicKeyPEM,
+			expectedErr:       true,
+			expectedFailedErr: true,
+		},
+		{
+			name:              "it fails for a signature of another manifest",
+			signatures:        []Signature{sign(payloadFor("sha256:other"))},
+			publicKey:         publicKeyPEM,
+			expectedErr:       true,
+			expectedFailedErr: true,
+		},
+		{
+			name:              "it fails for a tampered payload",
+			signatures:        []Signature{tampered},
+			publicKey:         publicKeyPEM,
+			expectedErr:       true,
+			expectedFailedErr: true,
+		},
+		{
+			name:        "it returns an error for an invalid public key",
+			signatures:  []Signature{sign(payloadFor(manifestDigest))},
+			publicKey:   []byte("not a key"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyCosignSignatures(tc.signatures, manifestDigest, tc.publicKey)
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %+v, want error: %t", err, want)
+			}
+			if got, want := errors.Is(err, ErrChartVerificationFailed), tc.expectedFailedErr; got != want {
+				t.Errorf("got: %t, want: %t, err: %+v", got, want, err)
+			}
+		})
+	}
+}